@@ -0,0 +1,45 @@
+# Example 1 - Pause a Capacity
+# terraform apply -invoke=action.fabric_capacity_state.pause
+action "fabric_capacity_state" "pause" {
+  config {
+    azure_resource_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-rg/providers/Microsoft.Fabric/capacities/examplecapacity"
+    state             = "Paused"
+  }
+}
+
+# Example 2 - Keep a non-production Capacity active during business hours only
+# Invoke on a schedule (e.g. hourly from a pipeline): terraform apply -invoke=action.fabric_capacity_state.business_hours
+action "fabric_capacity_state" "business_hours" {
+  config {
+    azure_resource_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-rg/providers/Microsoft.Fabric/capacities/devcapacity"
+    schedule = {
+      time_zone = "Europe/Paris"
+      active_windows = [
+        {
+          days  = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+          start = "07:00"
+          end   = "20:00"
+        },
+      ]
+    }
+  }
+}
+
+# Example 3 - Resume the Capacity before the workspace is deployed
+resource "terraform_data" "deployment" {
+  input = "v1"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.fabric_capacity_state.resume]
+    }
+  }
+}
+
+action "fabric_capacity_state" "resume" {
+  config {
+    azure_resource_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-rg/providers/Microsoft.Fabric/capacities/examplecapacity"
+    state             = "Active"
+  }
+}
@@ -0,0 +1,37 @@
+# Example 1 - Stop the mirroring of a Mirrored Database
+# terraform apply -invoke=action.fabric_mirrored_database_mirroring.stop
+action "fabric_mirrored_database_mirroring" "stop" {
+  config {
+    workspace_id         = "00000000-0000-0000-0000-000000000000"
+    mirrored_database_id = "11111111-1111-1111-1111-111111111111"
+    state                = "Stopped"
+  }
+}
+
+# Example 2 - Start the mirroring once the Mirrored Database is created
+resource "fabric_mirrored_database" "example" {
+  workspace_id              = "00000000-0000-0000-0000-000000000000"
+  display_name              = "example"
+  format                    = "Default"
+  definition_update_enabled = true
+  definition = {
+    "mirroring.json" = {
+      source = "${path.module}/mirroring.json.tmpl"
+    }
+  }
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.fabric_mirrored_database_mirroring.start]
+    }
+  }
+}
+
+action "fabric_mirrored_database_mirroring" "start" {
+  config {
+    workspace_id         = fabric_mirrored_database.example.workspace_id
+    mirrored_database_id = fabric_mirrored_database.example.id
+    state                = "Running"
+  }
+}
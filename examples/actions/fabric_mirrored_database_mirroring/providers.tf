@@ -0,0 +1,11 @@
+terraform {
+  required_version = ">= 1.14, < 2.0"
+  required_providers {
+    fabric = {
+      source  = "microsoft/fabric"
+      version = "0.0.0" # Check for the latest version on the Terraform Registry
+    }
+  }
+}
+
+provider "fabric" {}
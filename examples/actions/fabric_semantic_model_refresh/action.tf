@@ -0,0 +1,45 @@
+# Example 1 - Refresh a Semantic Model
+# terraform apply -invoke=action.fabric_semantic_model_refresh.calculate
+action "fabric_semantic_model_refresh" "calculate" {
+  config {
+    workspace_id      = "00000000-0000-0000-0000-000000000000"
+    semantic_model_id = "11111111-1111-1111-1111-111111111111"
+    type              = "Calculate"
+  }
+}
+
+# Example 2 - Refresh the Semantic Model each time a new definition is deployed
+resource "fabric_semantic_model" "example" {
+  display_name              = "example"
+  workspace_id              = "00000000-0000-0000-0000-000000000000"
+  definition_update_enabled = true
+  format                    = "TMSL"
+  definition = {
+    "model.bim" = {
+      source = "${local.path}/model.bim.tmpl"
+    }
+    "definition.pbism" = {
+      source = "${local.path}/definition.pbism"
+    }
+  }
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.fabric_semantic_model_refresh.full]
+    }
+  }
+}
+
+action "fabric_semantic_model_refresh" "full" {
+  config {
+    workspace_id      = fabric_semantic_model.example.workspace_id
+    semantic_model_id = fabric_semantic_model.example.id
+    type              = "Full"
+    retry_count       = 2
+  }
+}
+
+locals {
+  path = "${path.module}/semantic_model"
+}
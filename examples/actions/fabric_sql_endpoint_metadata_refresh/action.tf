@@ -0,0 +1,43 @@
+# Example 1 - Refresh the SQL Endpoint metadata
+# terraform apply -invoke=action.fabric_sql_endpoint_metadata_refresh.example
+action "fabric_sql_endpoint_metadata_refresh" "example" {
+  config {
+    workspace_id    = "00000000-0000-0000-0000-000000000000"
+    sql_endpoint_id = "11111111-1111-1111-1111-111111111111"
+  }
+}
+
+# Example 2 - Sync the SQL Endpoint of a lakehouse before the semantic model that reads from it is deployed
+resource "fabric_semantic_model" "example" {
+  display_name              = "example"
+  workspace_id              = "00000000-0000-0000-0000-000000000000"
+  definition_update_enabled = true
+  format                    = "TMSL"
+  definition = {
+    "model.bim" = {
+      source = "${local.path}/model.bim.tmpl"
+    }
+    "definition.pbism" = {
+      source = "${local.path}/definition.pbism"
+    }
+  }
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.fabric_sql_endpoint_metadata_refresh.recreate]
+    }
+  }
+}
+
+action "fabric_sql_endpoint_metadata_refresh" "recreate" {
+  config {
+    workspace_id    = "00000000-0000-0000-0000-000000000000"
+    sql_endpoint_id = "11111111-1111-1111-1111-111111111111"
+    recreate_tables = true
+  }
+}
+
+locals {
+  path = "${path.module}/semantic_model"
+}
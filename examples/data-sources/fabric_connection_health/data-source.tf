@@ -0,0 +1,15 @@
+data "fabric_connection_health" "example" {
+  connection_id = "00000000-0000-0000-0000-000000000000"
+}
+
+# Warn on every plan when the Connection cannot be reached, e.g. because its credential expired.
+check "connection_health" {
+  data "fabric_connection_health" "check" {
+    connection_id = "00000000-0000-0000-0000-000000000000"
+  }
+
+  assert {
+    condition     = data.fabric_connection_health.check.status == "Online"
+    error_message = "Connection ${data.fabric_connection_health.check.display_name} is ${data.fabric_connection_health.check.status}: ${join("; ", data.fabric_connection_health.check.errors[*].message)}"
+  }
+}
@@ -0,0 +1,3 @@
+output "example" {
+  value = data.fabric_connection_health.example
+}
@@ -0,0 +1,5 @@
+data "fabric_item_permissions" "example" {
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+  item_id      = "11111111-1111-1111-1111-111111111111"
+  item_type    = "SemanticModel"
+}
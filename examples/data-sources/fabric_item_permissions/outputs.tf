@@ -0,0 +1,8 @@
+output "example" {
+  value = data.fabric_item_permissions.example
+}
+
+# Principals allowed to reshare the item, e.g. to review permissions granted in the portal.
+output "example_resharers" {
+  value = [for p in data.fabric_item_permissions.example.values : p.display_name if contains(p.permissions, "Reshare")]
+}
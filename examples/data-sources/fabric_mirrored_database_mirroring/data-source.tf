@@ -0,0 +1,22 @@
+data "fabric_mirrored_database_mirroring" "example" {
+  workspace_id         = "00000000-0000-0000-0000-000000000000"
+  mirrored_database_id = "11111111-1111-1111-1111-111111111111"
+}
+
+# Warn on every plan when the mirror is not running or a table fails to replicate.
+check "mirroring_health" {
+  data "fabric_mirrored_database_mirroring" "check" {
+    workspace_id         = "00000000-0000-0000-0000-000000000000"
+    mirrored_database_id = "11111111-1111-1111-1111-111111111111"
+  }
+
+  assert {
+    condition     = data.fabric_mirrored_database_mirroring.check.status == "Running"
+    error_message = "Mirroring is ${data.fabric_mirrored_database_mirroring.check.status}."
+  }
+
+  assert {
+    condition     = alltrue([for t in data.fabric_mirrored_database_mirroring.check.tables : t.status != "Failed"])
+    error_message = "Failed tables: ${join(", ", [for t in data.fabric_mirrored_database_mirroring.check.tables : "${t.source_schema_name}.${t.source_table_name} (${try(t.error.message, "")})" if t.status == "Failed"])}"
+  }
+}
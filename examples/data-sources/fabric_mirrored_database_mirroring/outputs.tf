@@ -0,0 +1,3 @@
+output "example" {
+  value = data.fabric_mirrored_database_mirroring.example
+}
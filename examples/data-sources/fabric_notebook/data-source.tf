@@ -29,6 +29,25 @@ output "example_definition_content_object" {
   value = provider::fabric::content_decode(data.fabric_notebook.example_definition.definition["notebook-content.ipynb"].content).cells[0].source
 }
 
+# Write the definition parts to a local directory
+data "fabric_notebook" "example_definition_directory" {
+  id               = "11111111-1111-1111-1111-111111111111"
+  workspace_id     = "00000000-0000-0000-0000-000000000000"
+  format           = "ipynb"
+  output_directory = "${path.module}/notebook"
+}
+
+# Manage another Notebook with the same definition
+resource "fabric_notebook" "example_from_definition_directory" {
+  display_name = "example"
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+  format       = "ipynb"
+  definition_directory = {
+    path            = data.fabric_notebook.example_definition_directory.output_directory
+    processing_mode = "None"
+  }
+}
+
 # This is an invalid data source
 # Do not specify `id` and `display_name` in the same data source block
 # data "fabric_notebook" "example" {
@@ -0,0 +1,7 @@
+output "example_by_id" {
+  value = data.fabric_sql_endpoint.example_by_id
+}
+
+output "example_by_name" {
+  value = data.fabric_sql_endpoint.example_by_name
+}
@@ -0,0 +1,7 @@
+output "example" {
+  value = data.fabric_workspace_git_status.example
+}
+
+output "example_changed_items" {
+  value = [for change in data.fabric_workspace_git_status.example.changes : change.display_name]
+}
@@ -0,0 +1,3 @@
+data "fabric_workspace_item_imports" "example" {
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+}
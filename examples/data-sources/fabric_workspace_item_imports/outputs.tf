@@ -0,0 +1,17 @@
+output "example" {
+  value = data.fabric_workspace_item_imports.example
+}
+
+# Render the import blocks of the supported items, save them to a file and run
+# `terraform plan -generate-config-out=generated.tf` to generate the matching resources.
+output "example_import_blocks" {
+  value = join("\n", [
+    for item in data.fabric_workspace_item_imports.example.values :
+    "import {\n  to = ${item.resource_address}\n  id = \"${item.import_id}\"\n}\n" if item.supported
+  ])
+}
+
+# Items that cannot be managed by the provider and must be migrated manually.
+output "example_unsupported_items" {
+  value = [for item in data.fabric_workspace_item_imports.example.values : "${item.display_name} (${item.type})" if !item.supported]
+}
@@ -0,0 +1,7 @@
+list "fabric_ml_model" "example" {
+  provider = fabric
+
+  config {
+    workspace_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
@@ -0,0 +1,3 @@
+list "fabric_workspace" "example" {
+  provider = fabric
+}
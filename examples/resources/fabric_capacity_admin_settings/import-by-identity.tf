@@ -0,0 +1,6 @@
+import {
+  to = fabric_capacity_admin_settings.example
+  identity = {
+    id = "00000000-0000-0000-0000-000000000000"
+  }
+}
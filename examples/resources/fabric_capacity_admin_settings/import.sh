@@ -0,0 +1,2 @@
+# terraform import fabric_capacity_admin_settings.example "<CapacityID>"
+terraform import fabric_capacity_admin_settings.example "00000000-0000-0000-0000-000000000000"
@@ -0,0 +1,7 @@
+output "example1" {
+  value = fabric_capacity_admin_settings.example1
+}
+
+output "example2" {
+  value = fabric_capacity_admin_settings.example2
+}
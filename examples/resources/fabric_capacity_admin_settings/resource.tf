@@ -0,0 +1,29 @@
+# Example 1 - Capacity delegated tenant setting overrides
+resource "fabric_capacity_admin_settings" "example1" {
+  capacity_id = "00000000-0000-0000-0000-000000000000"
+
+  tenant_setting_overrides = [
+    {
+      setting_name = "ServicePrincipalAccess"
+      enabled      = true
+      enabled_security_group_ids = [
+        "11111111-1111-1111-1111-111111111111",
+      ]
+    },
+    {
+      setting_name          = "WorkspaceBlockInboundAccess"
+      enabled               = false
+      delegate_to_workspace = true
+    },
+  ]
+}
+
+# Example 2 - Capacity admins and SKU managed through Azure Resource Manager
+resource "fabric_capacity_admin_settings" "example2" {
+  capacity_id       = "00000000-0000-0000-0000-000000000000"
+  azure_resource_id = "/subscriptions/22222222-2222-2222-2222-222222222222/resourceGroups/example-rg/providers/Microsoft.Fabric/capacities/examplecapacity"
+  sku               = "F8"
+  admins = [
+    "admin@contoso.com",
+  ]
+}
@@ -0,0 +1,3 @@
+output "example" {
+  value = fabric_capacity_workspace_assignments.example
+}
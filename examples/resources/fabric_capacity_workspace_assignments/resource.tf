@@ -0,0 +1,9 @@
+resource "fabric_capacity_workspace_assignments" "example" {
+  capacity_id = "00000000-0000-0000-0000-000000000000"
+  workspace_ids = [
+    "11111111-1111-1111-1111-111111111111",
+    "22222222-2222-2222-2222-222222222222",
+    "33333333-3333-3333-3333-333333333333",
+  ]
+  max_concurrency = 10
+}
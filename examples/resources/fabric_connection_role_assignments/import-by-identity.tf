@@ -0,0 +1,6 @@
+import {
+  to = fabric_connection_role_assignments.example
+  identity = {
+    connection_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
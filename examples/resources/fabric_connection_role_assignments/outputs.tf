@@ -0,0 +1,3 @@
+output "example" {
+  value = fabric_connection_role_assignments.example
+}
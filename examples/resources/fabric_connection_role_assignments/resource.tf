@@ -0,0 +1,24 @@
+resource "fabric_connection_role_assignments" "example" {
+  connection_id = "00000000-0000-0000-0000-000000000000"
+  assignments = [
+    {
+      principal = {
+        id   = "11111111-1111-1111-1111-111111111111"
+        type = "User"
+      }
+      role = "Owner"
+    },
+    {
+      principal = {
+        id   = "22222222-2222-2222-2222-222222222222"
+        type = "Group"
+      }
+      role = "User"
+    },
+  ]
+
+  # Break-glass administrators managed outside of Terraform.
+  ignored_principal_ids = [
+    "99999999-9999-9999-9999-999999999999",
+  ]
+}
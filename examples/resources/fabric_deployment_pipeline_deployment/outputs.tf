@@ -0,0 +1,7 @@
+output "example" {
+  value = fabric_deployment_pipeline_deployment.example
+}
+
+output "example_selective" {
+  value = fabric_deployment_pipeline_deployment.example_selective
+}
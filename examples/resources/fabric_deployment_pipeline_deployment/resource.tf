@@ -0,0 +1,40 @@
+# Example of deploying all supported items from the first to the second stage
+resource "fabric_deployment_pipeline_deployment" "example" {
+  deployment_pipeline_id = "00000000-0000-0000-0000-000000000000"
+  source_stage_id        = "11111111-1111-1111-1111-111111111111"
+  target_stage_id        = "22222222-2222-2222-2222-222222222222"
+  note                   = "Release 1.0"
+
+  # Change any value to run a new deployment
+  triggers = {
+    release = "1.0"
+  }
+}
+
+# Example of deploying selected items to a stage without an assigned workspace
+resource "fabric_deployment_pipeline_deployment" "example_selective" {
+  deployment_pipeline_id = "00000000-0000-0000-0000-000000000000"
+  source_stage_id        = "22222222-2222-2222-2222-222222222222"
+  target_stage_id        = "33333333-3333-3333-3333-333333333333"
+  note                   = "Release 1.0"
+
+  items = [
+    {
+      source_item_id = "44444444-4444-4444-4444-444444444444"
+      item_type      = "Notebook"
+    },
+    {
+      source_item_id = "55555555-5555-5555-5555-555555555555"
+      item_type      = "Lakehouse"
+    }
+  ]
+
+  options = {
+    allow_cross_region_deployment = true
+  }
+
+  new_workspace = {
+    name        = "Production"
+    capacity_id = "66666666-6666-6666-6666-666666666666"
+  }
+}
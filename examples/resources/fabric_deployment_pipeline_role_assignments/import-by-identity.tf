@@ -0,0 +1,6 @@
+import {
+  to = fabric_deployment_pipeline_role_assignments.example
+  identity = {
+    deployment_pipeline_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
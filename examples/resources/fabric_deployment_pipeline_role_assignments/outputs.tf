@@ -0,0 +1,3 @@
+output "example" {
+  value = fabric_deployment_pipeline_role_assignments.example
+}
@@ -0,0 +1,24 @@
+resource "fabric_deployment_pipeline_role_assignments" "example" {
+  deployment_pipeline_id = "00000000-0000-0000-0000-000000000000"
+  assignments = [
+    {
+      principal = {
+        id   = "11111111-1111-1111-1111-111111111111"
+        type = "User"
+      }
+      role = "Admin"
+    },
+    {
+      principal = {
+        id   = "22222222-2222-2222-2222-222222222222"
+        type = "Group"
+      }
+      role = "Admin"
+    },
+  ]
+
+  # Break-glass administrators managed outside of Terraform.
+  ignored_principal_ids = [
+    "99999999-9999-9999-9999-999999999999",
+  ]
+}
@@ -8,6 +8,15 @@ resource "fabric_domain" "child" {
   parent_domain_id = fabric_domain.parent.id
 }
 
+# Domain that refuses to be deleted while workspaces not managed by Terraform are assigned to it.
+# Set force = true and apply before destroying it anyway.
+resource "fabric_domain" "safeguarded" {
+  display_name = "example safeguarded"
+  delete_safeguards = {
+    force = false
+  }
+}
+
 #domain update example
 # resource "fabric_domain" "example_update" {
 #   display_name     = "example child"
@@ -9,6 +9,16 @@ resource "fabric_folder" "example_subfolder" {
   parent_folder_id = "11111111-1111-1111-1111-111111111111"
 }
 
+# Folder that refuses to be deleted while it contains items or folders not managed by Terraform.
+# Set force = true and apply before destroying it anyway.
+resource "fabric_folder" "example_safeguarded" {
+  display_name = "example safeguarded"
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+  delete_safeguards = {
+    force = false
+  }
+}
+
 #changing the parent_folder_id will move the folder to a different parent folder
 # resource "fabric_folder" "example_subfolder" {
 #   display_name     = "example"
@@ -0,0 +1,6 @@
+import {
+  to = fabric_gateway_role_assignments.example
+  identity = {
+    gateway_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
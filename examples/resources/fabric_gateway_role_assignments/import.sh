@@ -0,0 +1,2 @@
+# terraform import fabric_gateway_role_assignments.example "<GatewayID>"
+terraform import fabric_gateway_role_assignments.example "00000000-0000-0000-0000-000000000000"
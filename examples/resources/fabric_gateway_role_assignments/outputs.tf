@@ -0,0 +1,3 @@
+output "example" {
+  value = fabric_gateway_role_assignments.example
+}
@@ -0,0 +1,7 @@
+output "example_notebook" {
+  value = fabric_item_job_instance.example_notebook
+}
+
+output "example_pipeline" {
+  value = fabric_item_job_instance.example_pipeline
+}
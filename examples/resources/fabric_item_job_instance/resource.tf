@@ -0,0 +1,44 @@
+# Example of running a bootstrap notebook once the lakehouse is created
+resource "fabric_item_job_instance" "example_notebook" {
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+  item_id      = "11111111-1111-1111-1111-111111111111"
+  job_type     = "RunNotebook"
+
+  execution_data = jsonencode({
+    parameters = {
+      lakehouse_name = {
+        value = "bronze"
+        type  = "string"
+      }
+    }
+  })
+
+  # Change any value to run the notebook again
+  triggers = {
+    lakehouse_id = "22222222-2222-2222-2222-222222222222"
+  }
+}
+
+# Example of running a smoke-test pipeline with typed parameters
+resource "fabric_item_job_instance" "example_pipeline" {
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+  item_id      = "33333333-3333-3333-3333-333333333333"
+  job_type     = "Pipeline"
+
+  parameters = [
+    {
+      name  = "row_count"
+      type  = "Integer"
+      value = "100"
+    },
+    {
+      name  = "strict"
+      type  = "Boolean"
+      value = "true"
+    }
+  ]
+
+  timeouts = {
+    create = "2h"
+  }
+}
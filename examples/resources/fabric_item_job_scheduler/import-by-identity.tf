@@ -0,0 +1,9 @@
+import {
+  to = fabric_item_job_scheduler.example
+  identity = {
+    workspace_id = "00000000-0000-0000-0000-000000000000"
+    item_id      = "11111111-1111-1111-1111-111111111111"
+    job_type     = "Execute"
+    id           = "22222222-2222-2222-2222-222222222222"
+  }
+}
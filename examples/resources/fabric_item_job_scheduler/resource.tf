@@ -74,3 +74,49 @@ resource "fabric_item_job_scheduler" "monthly_configuration_ordinal_weekday_exam
     }
   }
 }
+
+resource "fabric_item_job_scheduler" "notebook_execution_data_example" {
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+  item_id      = "22222222-2222-2222-2222-222222222222"
+  job_type     = "RunNotebook"
+  enabled      = true
+  configuration = {
+    start_date_time = "2025-11-11T10:00:00Z"
+    end_date_time   = "2025-11-12T10:00:00Z"
+    type            = "Daily"
+    times           = ["06:00"]
+  }
+  execution_data = {
+    notebook = {
+      parameters = {
+        batch_size = {
+          type  = "Int"
+          value = "100"
+        }
+        full_reload = {
+          type  = "Bool"
+          value = "false"
+        }
+      }
+      default_lakehouse_id = "33333333-3333-3333-3333-333333333333"
+    }
+  }
+}
+
+resource "fabric_item_job_scheduler" "spark_job_definition_execution_data_example" {
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+  item_id      = "44444444-4444-4444-4444-444444444444"
+  job_type     = "sparkjob"
+  enabled      = true
+  configuration = {
+    start_date_time = "2025-11-11T10:00:00Z"
+    end_date_time   = "2025-11-12T10:00:00Z"
+    type            = "Cron"
+    interval        = 60
+  }
+  execution_data = {
+    spark_job_definition = {
+      command_line_arguments = "--mode incremental"
+    }
+  }
+}
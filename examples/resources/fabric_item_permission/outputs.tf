@@ -0,0 +1,3 @@
+output "example" {
+  value = fabric_item_permission.example
+}
@@ -0,0 +1,9 @@
+resource "fabric_item_permission" "example" {
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+  item_id      = "11111111-1111-1111-1111-111111111111"
+  principal = {
+    id   = "22222222-2222-2222-2222-222222222222"
+    type = "Group"
+  }
+  permission = "ReadReshare"
+}
@@ -0,0 +1,7 @@
+output "example_load" {
+  value = fabric_lakehouse_table.example_load
+}
+
+output "example_maintenance" {
+  value = fabric_lakehouse_table.example_maintenance
+}
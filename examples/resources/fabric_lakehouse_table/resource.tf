@@ -0,0 +1,43 @@
+# Load CSV files from the Files area into a table and optimize it
+resource "fabric_lakehouse_table" "example_load" {
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+  lakehouse_id = "11111111-1111-1111-1111-111111111111"
+  name         = "sales"
+
+  load = {
+    relative_path  = "Files/sales"
+    path_type      = "Folder"
+    file_extension = "csv"
+    format         = "Csv"
+    mode           = "Overwrite"
+    header         = true
+    delimiter      = ","
+  }
+
+  maintenance = {
+    optimize = {
+      v_order    = true
+      z_order_by = ["region"]
+    }
+  }
+}
+
+# Run the table maintenance on an existing table, again whenever the trigger changes
+resource "fabric_lakehouse_table" "example_maintenance" {
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+  lakehouse_id = "11111111-1111-1111-1111-111111111111"
+  name         = "orders"
+
+  maintenance = {
+    optimize = {
+      v_order = true
+    }
+    vacuum = {
+      retention_period = "7:00:00:00"
+    }
+  }
+
+  triggers = {
+    run = "2026-10-18"
+  }
+}
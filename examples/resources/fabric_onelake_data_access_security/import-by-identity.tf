@@ -0,0 +1,8 @@
+import {
+  to = fabric_onelake_data_access_security.example
+  identity = {
+    workspace_id = "00000000-0000-0000-0000-000000000000"
+    item_id      = "11111111-1111-1111-1111-111111111111"
+    role_name    = "example"
+  }
+}
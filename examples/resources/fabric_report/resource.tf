@@ -150,3 +150,14 @@ resource "fabric_report" "example_parameters" {
     }
   }
 }
+
+# Report with all definition parts from a directory (e.g. a Git export of the Power BI project)
+resource "fabric_report" "example_directory" {
+  display_name = "example from directory"
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+  format       = "PBIR"
+  definition_directory = {
+    path            = "${path.module}/MyReport.Report"
+    processing_mode = "None"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = fabric_semantic_model.example
+  identity = {
+    workspace_id = "00000000-0000-0000-0000-000000000000"
+    id           = "11111111-1111-1111-1111-111111111111"
+  }
+}
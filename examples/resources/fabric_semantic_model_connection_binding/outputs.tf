@@ -0,0 +1,3 @@
+output "example" {
+  value = fabric_semantic_model_connection_binding.example
+}
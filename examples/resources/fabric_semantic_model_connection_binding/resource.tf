@@ -0,0 +1,27 @@
+# Example of binding a SQL data source reference of a semantic model to a shareable cloud connection
+resource "fabric_semantic_model_connection_binding" "example" {
+  workspace_id      = "00000000-0000-0000-0000-000000000000"
+  semantic_model_id = "11111111-1111-1111-1111-111111111111"
+
+  connection_details = {
+    type = "SQL"
+    path = "example.database.windows.net;exampledb"
+  }
+
+  connectivity_type = "ShareableCloud"
+  connection_id     = "22222222-2222-2222-2222-222222222222"
+}
+
+# Example of binding a data source reference of a semantic model to an on-premises gateway connection
+resource "fabric_semantic_model_connection_binding" "example_gateway" {
+  workspace_id      = "00000000-0000-0000-0000-000000000000"
+  semantic_model_id = "11111111-1111-1111-1111-111111111111"
+
+  connection_details = {
+    type = "SQL"
+    path = "sqlserver01;exampledb"
+  }
+
+  connectivity_type = "OnPremisesGateway"
+  connection_id     = "33333333-3333-3333-3333-333333333333"
+}
@@ -0,0 +1,8 @@
+resource "fabric_semantic_model_refresh_schedule" "example" {
+  workspace_id      = "00000000-0000-0000-0000-000000000000"
+  semantic_model_id = "11111111-1111-1111-1111-111111111111"
+
+  days               = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+  times              = ["06:00", "12:30"]
+  local_time_zone_id = "W. Europe Standard Time"
+}
@@ -0,0 +1,9 @@
+import {
+  to = fabric_shortcut.example
+  identity = {
+    workspace_id = "00000000-0000-0000-0000-000000000000"
+    item_id      = "11111111-1111-1111-1111-111111111111"
+    path         = "MyShortcutPath"
+    name         = "MyShortcutName"
+  }
+}
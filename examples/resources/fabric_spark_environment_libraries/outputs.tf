@@ -0,0 +1,3 @@
+output "example" {
+  value = fabric_spark_environment_libraries.example
+}
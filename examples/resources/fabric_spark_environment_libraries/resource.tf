@@ -0,0 +1,17 @@
+resource "fabric_spark_environment_libraries" "example" {
+  workspace_id   = "00000000-0000-0000-0000-000000000000"
+  environment_id = "11111111-1111-1111-1111-111111111111"
+
+  custom_libraries = {
+    "mylib-1.0.0-py3-none-any.whl" = {
+      source = "${path.module}/libs/mylib-1.0.0-py3-none-any.whl"
+    }
+    "myconnector.jar" = {
+      source = "${path.module}/libs/myconnector.jar"
+    }
+  }
+
+  environment_yml = {
+    source = "${path.module}/environment.yml"
+  }
+}
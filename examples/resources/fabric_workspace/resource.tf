@@ -35,3 +35,13 @@ resource "fabric_workspace" "example4" {
     type = "SystemAssigned"
   }
 }
+
+# Workspace that refuses to be deleted while it contains items not managed by Terraform
+# or uncommitted Git changes. Set force = true and apply before destroying it anyway.
+resource "fabric_workspace" "example5" {
+  display_name = "example5"
+  description  = "Example Workspace 5"
+  delete_safeguards = {
+    check_git_status = true
+  }
+}
@@ -0,0 +1,7 @@
+output "example" {
+  value = fabric_workspace_git_commit.example
+}
+
+output "example_selective" {
+  value = fabric_workspace_git_commit.example_selective
+}
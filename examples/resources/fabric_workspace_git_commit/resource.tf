@@ -0,0 +1,28 @@
+# Example of committing all the uncommitted changes of the workspace
+resource "fabric_workspace_git_commit" "example" {
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+  mode         = "All"
+  comment      = "Release 1.0"
+
+  # Change any value to run a new commit
+  triggers = {
+    release = "1.0"
+  }
+}
+
+# Example of committing the changes of selected items only
+resource "fabric_workspace_git_commit" "example_selective" {
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+  mode         = "Selective"
+  comment      = "Update the notebook"
+
+  items = [
+    {
+      object_id = "11111111-1111-1111-1111-111111111111"
+    },
+    {
+      # Use the logical ID for items deleted from the workspace
+      logical_id = "22222222-2222-2222-2222-222222222222"
+    }
+  ]
+}
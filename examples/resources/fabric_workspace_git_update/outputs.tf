@@ -0,0 +1,7 @@
+output "example" {
+  value = fabric_workspace_git_update.example
+}
+
+output "example_conflicts" {
+  value = fabric_workspace_git_update.example_conflicts
+}
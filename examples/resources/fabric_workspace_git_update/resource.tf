@@ -0,0 +1,19 @@
+# Example of updating the workspace with the latest commit of the remote branch
+resource "fabric_workspace_git_update" "example" {
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+
+  # Change any value to run a new update
+  triggers = {
+    release = "1.0"
+  }
+}
+
+# Example of updating the workspace, resolving conflicts with the remote content
+resource "fabric_workspace_git_update" "example_conflicts" {
+  workspace_id               = "00000000-0000-0000-0000-000000000000"
+  conflict_resolution_policy = "PreferRemote"
+
+  options = {
+    allow_override_items = true
+  }
+}
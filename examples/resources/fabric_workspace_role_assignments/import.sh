@@ -0,0 +1,2 @@
+# terraform import fabric_workspace_role_assignments.example "<WorkspaceID>"
+terraform import fabric_workspace_role_assignments.example "00000000-0000-0000-0000-000000000000"
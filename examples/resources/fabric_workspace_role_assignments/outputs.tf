@@ -0,0 +1,3 @@
+output "example" {
+  value = fabric_workspace_role_assignments.example
+}
@@ -14,6 +14,8 @@ const (
 	ErrorDataSourceConfigType         = "Unexpected Data Source Configure Type"
 	ErrorResourceConfigType           = "Unexpected Resource Configure Type"
 	ErrorEphemeralResourceConfigType  = "Unexpected Ephemeral Resource Configure Type"
+	ErrorListResourceConfigType       = "Unexpected List Resource Configure Type"
+	ErrorActionConfigType             = "Unexpected Action Configure Type"
 	ErrorModelConversion              = "Data Model Conversion Error"
 	ErrorCreateHeader                 = "Create operation"
 	ErrorCreateDetails                = "Could not create resource"
@@ -39,7 +41,30 @@ const (
 	ErrorBase64GzipEncodeHeader       = "Base64 Gzip encode operation"
 	ErrorJSONNormalizeHeader          = "JSON normalize operation"
 	ErrorFileReadHeader               = "File read operation"
+	ErrorFileWriteHeader              = "File write operation"
 	ErrorTmplParseHeader              = "Template parse operation"
+	ErrorDefinitionDirectoryHeader    = "Definition directory"
+	ErrorDefinitionDirectoryEmpty     = "Directory '%s' does not contain any definition part source files."
+	ErrorDefinitionDirectoryPaths     = "Files in directory '%s' do not match any of the accepted definition part paths (%s): %s"
 	ErrorPreviewModeHeader            = "Preview mode not enabled"
 	ErrorPreviewModeDetails           = "'%s' is not available without explicitly opt-in to the preview mode on the provider level configuration."
+	ErrorDeploymentStepHeader         = "Deployment step failed"
+	ErrorDeploymentStepDetails        = "Step %d '%s' (%s %s): %s"
+	ErrorGitConflictsHeader           = "Git conflicts"
+	ErrorGitConflictsDetails          = "The Workspace has items in conflict with the remote branch, set a conflict resolution policy to update it: %s"
+	ErrorItemJobHeader                = "Item job not completed"
+	ErrorItemJobDetails               = "Job instance %s finished with status '%s': %s"
+	ErrorEnvironmentPublishHeader     = "Environment publish not completed"
+	ErrorEnvironmentPublishDetails    = "Environment %s publish finished with state '%s'"
+	ErrorSQLEndpointRefreshHeader     = "SQL Endpoint metadata refresh not completed"
+	ErrorSQLEndpointRefreshDetails    = "SQL Endpoint %s failed to sync %d table(s): %s"
+	ErrorDeleteSafeguardHeader        = "Delete safeguard"
+	ErrorDeleteSafeguardDetails       = "The %s %s still contains %s not managed by Terraform: %s. Remove them, or set delete_safeguards.force to true and apply before deleting the %s."
+	ErrorDeleteSafeguardGitDetails    = "The Workspace %s is connected to Git and has uncommitted changes: %s. Commit or undo them, or set delete_safeguards.force to true and apply before deleting the Workspace."
+	ErrorAzureResourceManagerHeader   = "Azure Resource Manager operation"
+	ErrorPowerBIHeader                = "Power BI REST API operation"
+	ErrorSemanticModelRefreshHeader   = "Semantic Model refresh not completed"
+	ErrorSemanticModelRefreshDetails  = "Refresh %s of Semantic Model %s finished with status '%s': %s"
+	ErrorCapacityRegionHeader         = "Capacity region mismatch"
+	ErrorCapacityRegionDetails        = "Workspace %s is assigned to a Capacity in the '%s' region, but the Capacity %s is in the '%s' region. Workspaces containing Fabric items cannot be moved across regions."
 )
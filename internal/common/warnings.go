@@ -4,8 +4,20 @@
 package common //revive:disable-line:package-naming
 
 const (
-	WarningItemDefinitionUpdateHeader  = "Fabric Item definition update"
-	WarningItemDefinitionUpdateDetails = "%s definition update operation will overwrite the existing definition on the Fabric side."
-	WarningPreviewModeHeader           = "'%s' preview mode"
-	WarningPreviewModeDetails          = "The '%s' behavior may change in future releases without notice or compatibility guarantees."
+	WarningItemDefinitionUpdateHeader      = "Fabric Item definition update"
+	WarningItemDefinitionUpdateDetails     = "%s definition update operation will overwrite the existing definition on the Fabric side."
+	WarningItemDefinitionDriftHeader       = "Fabric Item definition drift"
+	WarningItemDefinitionDriftDetails      = "%s definition parts were changed outside of Terraform: %s. The next apply will restore the configured content."
+	WarningPreviewModeHeader               = "'%s' preview mode"
+	WarningPreviewModeDetails              = "The '%s' behavior may change in future releases without notice or compatibility guarantees."
+	WarningCapacityAssignmentHeader        = "Workspace capacity assignment not completed"
+	WarningCapacityAssignmentDetails       = "Workspace %s could not be assigned to the Capacity %s: %s. The assignment will be retried on the next apply."
+	WarningRoleAssignmentCallerKeptHeader  = "Role assignment of the caller kept"
+	WarningRoleAssignmentCallerKeptDetails = "Principal %s is the identity running Terraform, its %s role assignment was not removed to keep the access Terraform needs to manage the role assignments. Declare it, or remove it manually if it is no longer needed."
+	WarningGitNothingToCommitHeader        = "Nothing to commit"
+	WarningGitNothingToCommitDetails       = "The Workspace %s has no uncommitted changes, no commit was made."
+	WarningGitNothingToUpdateHeader        = "Nothing to update"
+	WarningGitNothingToUpdateDetails       = "The Workspace %s is already synced to the latest remote commit, no update was made."
+	WarningItemJobNotCancelledHeader       = "Item job not cancelled"
+	WarningItemJobNotCancelledDetails      = "Job instance %s did not finish in time and could not be cancelled, it may still be running: %s"
 )
@@ -0,0 +1,72 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package planmodifiers
+
+import (
+	"context"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+var _ planmodifier.String = (*fileContentSha256)(nil)
+
+func FileContentSha256(sourceAttr path.Expression) planmodifier.String {
+	return &fileContentSha256{
+		source: sourceAttr,
+	}
+}
+
+type fileContentSha256 struct {
+	source path.Expression
+}
+
+func (pm *fileContentSha256) Description(_ context.Context) string {
+	return "Generate SHA256 hash of the raw content of the file."
+}
+
+func (pm *fileContentSha256) MarkdownDescription(ctx context.Context) string {
+	return pm.Description(ctx)
+}
+
+func (pm *fileContentSha256) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// An unknown config value defers this resource, so the file at `source` may still be
+	// rewritten by another resource before the plan is re-evaluated during apply.
+	if !req.Config.Raw.IsFullyKnown() {
+		resp.PlanValue = types.StringUnknown()
+
+		return
+	}
+
+	sourcePlanPaths, diags := req.Plan.PathMatches(ctx, req.PathExpression.Merge(pm.source))
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	var source types.String
+
+	if resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, sourcePlanPaths[0], &source)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if source.IsNull() || source.IsUnknown() {
+		resp.PlanValue = types.StringUnknown()
+
+		return
+	}
+
+	content, err := os.ReadFile(source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(sourcePlanPaths[0], common.ErrorFileReadHeader, err.Error())
+
+		return
+	}
+
+	resp.PlanValue = types.StringValue(utils.Sha256(content))
+}
@@ -0,0 +1,125 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package planmodifiers_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/planmodifiers"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func testFileSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"file": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"source":                schema.StringAttribute{Required: true},
+					"source_content_sha256": schema.StringAttribute{Computed: true},
+				},
+			},
+		},
+	}
+}
+
+func testFileRaw(ctx context.Context, t *testing.T, source, sha tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	rootType, ok := testFileSchema().Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+
+	fileType, ok := rootType.AttributeTypes["file"].(tftypes.Object)
+	require.True(t, ok)
+
+	return tftypes.NewValue(rootType, map[string]tftypes.Value{
+		"file": tftypes.NewValue(fileType, map[string]tftypes.Value{
+			"source":                source,
+			"source_content_sha256": sha,
+		}),
+	})
+}
+
+func TestUnit_FileContentSha256(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	content := []byte{0x50, 0x4b, 0x03, 0x04, 0xff}
+	sourcePath := filepath.Join(t.TempDir(), "library.whl")
+	require.NoError(t, os.WriteFile(sourcePath, content, 0o600))
+
+	pm := planmodifiers.FileContentSha256(path.MatchRelative().AtParent().AtName("source"))
+
+	nullValue := tftypes.NewValue(tftypes.String, nil)
+	unknownValue := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	type testCase struct {
+		source     tftypes.Value
+		expUnknown bool
+		expError   bool
+	}
+
+	testCases := map[string]testCase{
+		"source known": {
+			source:     tftypes.NewValue(tftypes.String, sourcePath),
+			expUnknown: false,
+		},
+		"source unknown": {
+			source:     unknownValue,
+			expUnknown: true,
+		},
+		"source missing": {
+			source:   tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "missing.whl")),
+			expError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := planmodifier.StringRequest{
+				Path:           path.Root("file").AtName("source_content_sha256"),
+				PathExpression: path.MatchRoot("file").AtName("source_content_sha256"),
+				Config: tfsdk.Config{
+					Schema: testFileSchema(),
+					Raw:    testFileRaw(ctx, t, testCase.source, nullValue),
+				},
+				Plan: tfsdk.Plan{
+					Schema: testFileSchema(),
+					Raw:    testFileRaw(ctx, t, testCase.source, unknownValue),
+				},
+				PlanValue: types.StringUnknown(),
+			}
+
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+
+			pm.PlanModifyString(ctx, req, resp)
+
+			require.Equal(t, testCase.expError, resp.Diagnostics.HasError())
+
+			if testCase.expError {
+				return
+			}
+
+			require.Equal(t, testCase.expUnknown, resp.PlanValue.IsUnknown())
+
+			if !testCase.expUnknown {
+				require.Equal(t, utils.Sha256(content), resp.PlanValue.ValueString())
+			}
+		})
+	}
+}
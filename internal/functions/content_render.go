@@ -0,0 +1,184 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+	"encoding/base64"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/params"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/transforms"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+var _ function.Function = (*functionContentRender)(nil)
+
+func NewFunctionContentRender() function.Function {
+	return &functionContentRender{}
+}
+
+type functionContentRender struct{}
+
+func (f *functionContentRender) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "content_render"
+}
+
+func (f *functionContentRender) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Render a Definition part source.",
+		MarkdownDescription: "Given a source file of a Definition part, will process tokens/parameters the same way as the provider does before uploading the Definition part, " +
+			"and return an object with the rendered `content`, the `content_base64` payload and the `content_sha256` hash (the same as `source_content_sha256` of the Definition part). " +
+			"For binary files `content` is null.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				MarkdownDescription: "Path to the file with source of the definition part.",
+				Name:                "source",
+			},
+			function.StringParameter{
+				MarkdownDescription: "Processing mode of the tokens/parameters. Possible values: " +
+					utils.ConvertStringSlicesToString(transforms.PossibleProcessingModeValues(), true, true) + ". If null, `" + transforms.ProcessingModeGoTemplate + "` is used.",
+				Name:           "processing_mode",
+				AllowNullValue: true,
+			},
+			function.MapParameter{
+				MarkdownDescription: "A map of key/value pairs of tokens substitutes in the source. Used with the `" + transforms.ProcessingModeGoTemplate + "` processing mode.",
+				Name:                "tokens",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+			},
+			function.ListParameter{
+				MarkdownDescription: "The list of parameters (objects with `type`, `find` and `value` attributes) to be processed in the source content. Used with the `" +
+					transforms.ProcessingModeParameters + "` processing mode. Possible `type` values: " +
+					utils.ConvertStringSlicesToString(transforms.PossibleParameterTypeValues(), true, true) + ".",
+				Name: "parameters",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"type":  types.StringType,
+						"find":  types.StringType,
+						"value": types.StringType,
+					},
+				},
+				AllowNullValue: true,
+			},
+			function.StringParameter{
+				MarkdownDescription: "The delimiter for the tokens in the source content. Possible values: " +
+					utils.ConvertStringSlicesToString(transforms.PossibleTokensDelimiterValues(), true, true) + ". If null, `" + transforms.TokensDelimiterCurlyBraces + "` is used.",
+				Name:           "tokens_delimiter",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"content":        types.StringType,
+				"content_base64": types.StringType,
+				"content_sha256": types.StringType,
+			},
+		},
+	}
+}
+
+func (f *functionContentRender) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	tflog.Debug(ctx, "CONTENT RENDER", map[string]any{
+		"action": "start",
+	})
+	tflog.Trace(ctx, "CONTENT RENDER", map[string]any{
+		"arguments": req.Arguments,
+	})
+
+	var (
+		inputSource          string
+		inputProcessingMode  types.String
+		inputTokens          map[string]string
+		inputParameters      []params.ParametersModel
+		inputTokensDelimiter types.String
+	)
+
+	if resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &inputSource, &inputProcessingMode, &inputTokens, &inputParameters, &inputTokensDelimiter)); resp.Error != nil {
+		return
+	}
+
+	if inputSource == "" {
+		resp.Error = function.NewArgumentFuncError(0, "Parameter 'source' is required")
+
+		return
+	}
+
+	processingMode := transforms.ProcessingModeGoTemplate
+	if !inputProcessingMode.IsNull() {
+		processingMode = inputProcessingMode.ValueString()
+	}
+
+	if !slices.Contains(transforms.PossibleProcessingModeValues(), processingMode) {
+		resp.Error = function.NewArgumentFuncError(1, "Parameter 'processing_mode' must be one of: "+utils.ConvertStringSlicesToString(transforms.PossibleProcessingModeValues(), false, true))
+
+		return
+	}
+
+	tokensDelimiter := transforms.TokensDelimiterCurlyBraces
+	if !inputTokensDelimiter.IsNull() {
+		tokensDelimiter = inputTokensDelimiter.ValueString()
+	}
+
+	if !slices.Contains(transforms.PossibleTokensDelimiterValues(), tokensDelimiter) {
+		resp.Error = function.NewArgumentFuncError(4, "Parameter 'tokens_delimiter' must be one of: "+utils.ConvertStringSlicesToString(transforms.PossibleTokensDelimiterValues(), false, true))
+
+		return
+	}
+
+	parameters := make([]*params.ParametersModel, 0, len(inputParameters))
+	for i := range inputParameters {
+		parameters = append(parameters, &inputParameters[i])
+	}
+
+	payloadB64, contentSha256, diags := transforms.SourceFileToPayload(inputSource, processingMode, inputTokens, parameters, tokensDelimiter)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+
+		return
+	}
+
+	contentBytes, err := base64.StdEncoding.DecodeString(payloadB64)
+	if err != nil {
+		resp.Error = function.NewFuncError("Failed to decode content: " + err.Error())
+
+		return
+	}
+
+	// Binary content cannot be represented as a string value
+	content := types.StringNull()
+	if utf8.Valid(contentBytes) {
+		content = types.StringValue(string(contentBytes))
+	}
+
+	result, d := types.ObjectValue(
+		map[string]attr.Type{
+			"content":        types.StringType,
+			"content_base64": types.StringType,
+			"content_sha256": types.StringType,
+		},
+		map[string]attr.Value{
+			"content":        content,
+			"content_base64": types.StringValue(payloadB64),
+			"content_sha256": types.StringValue(contentSha256),
+		},
+	)
+	if d.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, d)
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+
+	tflog.Debug(ctx, "CONTENT RENDER", map[string]any{
+		"action": "end",
+	})
+}
@@ -0,0 +1,123 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package functions_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testFunctionContentRenderHeader = testhelp.FunctionHeader("fabric", "content_render")
+
+func TestUnit_ContentRenderFunction(t *testing.T) {
+	srcDir := t.TempDir()
+
+	srcJSONPath := filepath.ToSlash(filepath.Join(srcDir, "source.json"))
+	if err := os.WriteFile(srcJSONPath, []byte(`{ "name": "{{ .Name }}", "value": 1 }`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	srcTextPath := filepath.ToSlash(filepath.Join(srcDir, "source.txt"))
+	if err := os.WriteFile(srcTextPath, []byte("Lorem <<.Word>> dolor"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	const renderedJSON = `{"name":"value1","value":1}`
+
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, nil, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// -- Happy path tests --
+		// GoTemplate (default) processing mode with JSON normalization
+		{
+			Config: fmt.Sprintf(`
+				output "test" {
+					value = %s("%s", null, { Name = "value1" }, null, null)
+				}
+			`, testFunctionContentRenderHeader, srcJSONPath),
+			ConfigStateChecks: []statecheck.StateCheck{
+				statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+					"content":        knownvalue.StringExact(renderedJSON),
+					"content_base64": knownvalue.NotNull(),
+					"content_sha256": knownvalue.StringExact(utils.Sha256(renderedJSON)),
+				})),
+			},
+		},
+		// Parameters processing mode
+		{
+			Config: fmt.Sprintf(`
+				output "test" {
+					value = %s("%s", "Parameters", null, [
+						{ type = "TextReplace", find = "{{ .Name }}", value = "value1" },
+						{ type = "JsonPathReplace", find = "$.value", value = "2" },
+					], null).content
+				}
+			`, testFunctionContentRenderHeader, srcJSONPath),
+			ConfigStateChecks: []statecheck.StateCheck{
+				statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact(`{"name":"value1","value":"2"}`)),
+			},
+		},
+		// Custom tokens delimiter
+		{
+			Config: fmt.Sprintf(`
+				output "test" {
+					value = %s("%s", "GoTemplate", { Word = "ipsum" }, null, "<<>>").content
+				}
+			`, testFunctionContentRenderHeader, srcTextPath),
+			ConfigStateChecks: []statecheck.StateCheck{
+				statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("Lorem ipsum dolor")),
+			},
+		},
+		// -- Error path tests --
+		{
+			Config: fmt.Sprintf(`
+				output "test" {
+					value = %s("", null, null, null, null)
+				}
+			`, testFunctionContentRenderHeader),
+			ExpectError: regexp.MustCompile(`Error in function call|Invalid function argument`),
+		},
+		{
+			Config: fmt.Sprintf(`
+				output "test" {
+					value = %s("%s", "Invalid", null, null, null)
+				}
+			`, testFunctionContentRenderHeader, srcJSONPath),
+			ExpectError: regexp.MustCompile(`Error in function call|Invalid function argument`),
+		},
+		{
+			Config: fmt.Sprintf(`
+				output "test" {
+					value = %s("%s", null, null, null, "[[]]")
+				}
+			`, testFunctionContentRenderHeader, srcJSONPath),
+			ExpectError: regexp.MustCompile(`Error in function call|Invalid function argument`),
+		},
+		{
+			Config: fmt.Sprintf(`
+				output "test" {
+					value = %s("%s/missing.json", null, null, null, null)
+				}
+			`, testFunctionContentRenderHeader, filepath.ToSlash(srcDir)),
+			ExpectError: regexp.MustCompile(`Error in function call|Invalid function argument`),
+		},
+		{
+			Config: fmt.Sprintf(`
+				output "test" {
+					value = %s("%s")
+				}
+			`, testFunctionContentRenderHeader, srcJSONPath),
+			ExpectError: regexp.MustCompile("Not enough function arguments"),
+		},
+	}))
+}
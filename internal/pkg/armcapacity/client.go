@@ -0,0 +1,203 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package armcapacity
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+const (
+	moduleName = "terraform-provider-fabric"
+	apiVersion = "2023-11-01"
+
+	// SKUTier is the tier of the Microsoft Fabric capacity SKUs.
+	SKUTier = "Fabric"
+)
+
+// State is the state of a Microsoft Fabric capacity as reported by Azure Resource Manager.
+type State string
+
+const (
+	StateActive     State = "Active"
+	StatePaused     State = "Paused"
+	StatePausing    State = "Pausing"
+	StateResuming   State = "Resuming"
+	StateSuspended  State = "Suspended"
+	StateSuspending State = "Suspending"
+)
+
+// ResourceIDRegex matches the Azure resource ID of a Microsoft Fabric capacity.
+var ResourceIDRegex = regexp.MustCompile( //nolint:gochecknoglobals
+	`(?i)^/subscriptions/[0-9a-f-]{36}/resourceGroups/[-\w._()]+/providers/Microsoft\.Fabric/capacities/[a-z][a-z0-9]{2,62}$`,
+)
+
+// ErrCredentialNotAvailable is returned when the provider has no credential to authenticate against Azure Resource Manager.
+var ErrCredentialNotAvailable = errors.New("the provider credential is not available to authenticate against Azure Resource Manager")
+
+// Capacity is the Azure Resource Manager representation of a Microsoft Fabric capacity.
+type Capacity struct {
+	ID         *string             `json:"id,omitempty"`
+	Name       *string             `json:"name,omitempty"`
+	Location   *string             `json:"location,omitempty"`
+	SKU        *SKU                `json:"sku,omitempty"`
+	Properties *CapacityProperties `json:"properties,omitempty"`
+}
+
+// SKU is the SKU of a Microsoft Fabric capacity.
+type SKU struct {
+	Name *string `json:"name,omitempty"`
+	Tier *string `json:"tier,omitempty"`
+}
+
+// CapacityProperties are the properties of a Microsoft Fabric capacity.
+type CapacityProperties struct {
+	Administration    *Administration `json:"administration,omitempty"`
+	ProvisioningState *string         `json:"provisioningState,omitempty"`
+	State             *State          `json:"state,omitempty"`
+}
+
+// Administration holds the administrators of a Microsoft Fabric capacity.
+type Administration struct {
+	Members []string `json:"members"`
+}
+
+// CapacityUpdate is the body of a Microsoft Fabric capacity update (PATCH) request.
+type CapacityUpdate struct {
+	SKU        *SKU                `json:"sku,omitempty"`
+	Properties *CapacityProperties `json:"properties,omitempty"`
+}
+
+// Client performs the Azure Resource Manager operations on Microsoft Fabric capacities (Microsoft.Fabric/capacities)
+// not exposed by the Fabric API: suspend, resume, scale and capacity administrators.
+type Client struct {
+	internal *arm.Client
+}
+
+// NewClient creates a Client authenticated with the given credential against the Azure Resource Manager endpoint of the given cloud.
+// The optional clientOptions are the retry, logging, policies and transport settings of the client, e.g. the ones of the provider.
+func NewClient(cred azcore.TokenCredential, cloudCfg cloud.Configuration, version string, clientOptions *policy.ClientOptions) (*Client, error) {
+	if cred == nil {
+		return nil, ErrCredentialNotAvailable
+	}
+
+	options := &arm.ClientOptions{
+		DisableRPRegistration: true,
+	}
+
+	if clientOptions != nil {
+		options.ClientOptions = *clientOptions
+	}
+
+	options.Cloud = cloudCfg
+
+	client, err := arm.NewClient(moduleName, version, cred, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{internal: client}, nil
+}
+
+// Get returns the capacity with the given Azure resource ID.
+func (c *Client) Get(ctx context.Context, resourceID string) (*Capacity, error) {
+	resp, err := c.do(ctx, http.MethodGet, resourceID, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	var capacity Capacity
+	if err := runtime.UnmarshalAsJSON(resp, &capacity); err != nil {
+		return nil, err
+	}
+
+	return &capacity, nil
+}
+
+// Update updates the capacity with the given Azure resource ID and waits for the operation to complete.
+func (c *Client) Update(ctx context.Context, resourceID string, update CapacityUpdate) (*Capacity, error) {
+	resp, err := c.do(ctx, http.MethodPatch, resourceID, update, http.StatusOK, http.StatusAccepted)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.wait(ctx, resp); err != nil {
+		return nil, err
+	}
+
+	return c.Get(ctx, resourceID)
+}
+
+// Suspend pauses the capacity with the given Azure resource ID and waits for the operation to complete.
+func (c *Client) Suspend(ctx context.Context, resourceID string) error {
+	return c.action(ctx, resourceID, "suspend")
+}
+
+// Resume resumes the capacity with the given Azure resource ID and waits for the operation to complete.
+func (c *Client) Resume(ctx context.Context, resourceID string) error {
+	return c.action(ctx, resourceID, "resume")
+}
+
+func (c *Client) action(ctx context.Context, resourceID, name string) error {
+	resp, err := c.do(ctx, http.MethodPost, resourceID+"/"+name, nil, http.StatusOK, http.StatusAccepted)
+	if err != nil {
+		return err
+	}
+
+	return c.wait(ctx, resp)
+}
+
+func (c *Client) wait(ctx context.Context, resp *http.Response) error {
+	if resp.StatusCode != http.StatusAccepted {
+		return nil
+	}
+
+	poller, err := runtime.NewPoller[struct{}](resp, c.internal.Pipeline(), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = poller.PollUntilDone(ctx, &runtime.PollUntilDoneOptions{Frequency: utils.PollInterval})
+
+	return err
+}
+
+func (c *Client) do(ctx context.Context, method, urlPath string, body any, statusCodes ...int) (*http.Response, error) {
+	req, err := runtime.NewRequest(ctx, method, runtime.JoinPaths(c.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", apiVersion)
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+
+	if body != nil {
+		if err := runtime.MarshalAsJSON(req, body); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !runtime.HasStatusCode(resp, statusCodes...) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,98 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package armcapacity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Window is a recurring period during which a capacity must be active.
+// A window ending before (or when) it starts spans midnight and ends on the next day.
+type Window struct {
+	Days  []time.Weekday
+	Start time.Duration
+	End   time.Duration
+}
+
+// Schedule defines when a capacity must be active. Outside of its windows the capacity must be paused.
+type Schedule struct {
+	Location *time.Location
+	Windows  []Window
+}
+
+// IsActive reports whether the capacity must be active at the given time.
+func (s Schedule) IsActive(t time.Time) bool {
+	if s.Location != nil {
+		t = t.In(s.Location)
+	}
+
+	clock := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	today := t.Weekday()
+	yesterday := (today + 6) % 7
+
+	for _, w := range s.Windows {
+		overnight := w.End <= w.Start
+
+		for _, day := range w.Days {
+			switch {
+			case day == today && !overnight && clock >= w.Start && clock < w.End:
+				return true
+			case day == today && overnight && clock >= w.Start:
+				return true
+			case day == yesterday && overnight && clock < w.End:
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// DesiredState returns the state the capacity must be in at the given time.
+func (s Schedule) DesiredState(t time.Time) State {
+	if s.IsActive(t) {
+		return StateActive
+	}
+
+	return StatePaused
+}
+
+// ParseClock parses a time of day in the HH:MM 24-hour format.
+func ParseClock(value string) (time.Duration, error) {
+	var hours, minutes int
+
+	if _, err := fmt.Sscanf(value, "%2d:%2d", &hours, &minutes); err != nil || len(value) != len("15:04") {
+		return 0, fmt.Errorf("invalid time of day %q, expected the HH:MM format", value)
+	}
+
+	if hours < 0 || hours > 23 || minutes < 0 || minutes > 59 {
+		return 0, fmt.Errorf("invalid time of day %q, expected a value between 00:00 and 23:59", value)
+	}
+
+	return time.Duration(hours*60+minutes) * time.Minute, nil
+}
+
+// ParseWeekday parses an English day name (e.g. Monday), case-insensitively.
+func ParseWeekday(value string) (time.Weekday, error) {
+	for day := range time.Weekday(7) {
+		if strings.EqualFold(day.String(), value) {
+			return day, nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid day %q, expected one of %s", value, strings.Join(PossibleWeekdayValues(), ", "))
+}
+
+// PossibleWeekdayValues returns the day names accepted by ParseWeekday.
+func PossibleWeekdayValues() []string {
+	values := make([]string, 0, 7)
+
+	for day := range time.Weekday(7) {
+		values = append(values, day.String())
+	}
+
+	return values
+}
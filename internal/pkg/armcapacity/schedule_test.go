@@ -0,0 +1,87 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package armcapacity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/armcapacity"
+)
+
+func TestUnit_ScheduleIsActive(t *testing.T) {
+	t.Parallel()
+
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+	schedule := armcapacity.Schedule{
+		Location: time.UTC,
+		Windows: []armcapacity.Window{
+			{Days: weekdays, Start: 8 * time.Hour, End: 18 * time.Hour},
+			// Friday night batch, ending on Saturday
+			{Days: []time.Weekday{time.Friday}, Start: 22 * time.Hour, End: 2 * time.Hour},
+		},
+	}
+
+	// 2026-10-19 is a Monday
+	testCases := map[string]struct {
+		at       time.Time
+		expected bool
+	}{
+		"weekday - before window": {at: time.Date(2026, 10, 19, 7, 59, 0, 0, time.UTC), expected: false},
+		"weekday - window start":  {at: time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC), expected: true},
+		"weekday - window end":    {at: time.Date(2026, 10, 19, 18, 0, 0, 0, time.UTC), expected: false},
+		"weekend":                 {at: time.Date(2026, 10, 25, 12, 0, 0, 0, time.UTC), expected: false},
+		"overnight - start day":   {at: time.Date(2026, 10, 23, 23, 0, 0, 0, time.UTC), expected: true},
+		"overnight - next day":    {at: time.Date(2026, 10, 24, 1, 59, 0, 0, time.UTC), expected: true},
+		"overnight - after end":   {at: time.Date(2026, 10, 24, 2, 0, 0, 0, time.UTC), expected: false},
+		"other time zone":         {at: time.Date(2026, 10, 19, 9, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60)), expected: false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.expected, schedule.IsActive(testCase.at))
+		})
+	}
+
+	assert.Equal(t, armcapacity.StatePaused, schedule.DesiredState(time.Date(2026, 10, 25, 12, 0, 0, 0, time.UTC)))
+	assert.Equal(t, armcapacity.StateActive, schedule.DesiredState(time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)))
+}
+
+func TestUnit_ParseClock(t *testing.T) {
+	t.Parallel()
+
+	value, err := armcapacity.ParseClock("08:30")
+	require.NoError(t, err)
+	assert.Equal(t, 8*time.Hour+30*time.Minute, value)
+
+	for _, invalid := range []string{"8:30", "24:00", "12:60", "noon", "12:00:00"} {
+		_, err := armcapacity.ParseClock(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestUnit_ParseWeekday(t *testing.T) {
+	t.Parallel()
+
+	day, err := armcapacity.ParseWeekday("friday")
+	require.NoError(t, err)
+	assert.Equal(t, time.Friday, day)
+
+	_, err = armcapacity.ParseWeekday("Fri")
+	assert.ErrorContains(t, err, "Sunday, Monday, Tuesday, Wednesday, Thursday, Friday, Saturday")
+}
+
+func TestUnit_ResourceIDRegex(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, armcapacity.ResourceIDRegex.MatchString("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-fabric/providers/Microsoft.Fabric/capacities/fabricdev01"))
+	assert.False(t, armcapacity.ResourceIDRegex.MatchString("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-fabric/providers/Microsoft.PowerBIDedicated/capacities/fabricdev01"))
+	assert.False(t, armcapacity.ResourceIDRegex.MatchString("fabricdev01"))
+}
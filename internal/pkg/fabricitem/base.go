@@ -11,9 +11,12 @@ const (
 	SPNNotSupportedResource          = "\n\n-> This resource does not support Service Principal. Please use a User context authentication."
 	SPNSupportedEphemeralResource    = "\n\n-> This ephemeral resource supports Service Principal authentication."
 	SPNNotSupportedEphemeralResource = "\n\n-> This ephemeral resource does not support Service Principal. Please use a User context authentication."
+	SPNSupportedListResource         = "\n\n-> This list resource supports Service Principal authentication."
+	SPNNotSupportedListResource      = "\n\n-> This list resource does not support Service Principal. Please use a User context authentication."
 	PreviewDataSource                = "\n\n~> This data-source is in **preview**. To access it, you must explicitly enable the `preview` mode in the provider level configuration."
 	PreviewResource                  = "\n\n~> This resource is in **preview**. To access it, you must explicitly enable the `preview` mode in the provider level configuration."
 	PreviewEphemeralResource         = "\n\n~> This ephemeral resource is in **preview**. To access it, you must explicitly enable the `preview` mode in the provider level configuration."
+	PreviewListResource              = "\n\n~> This list resource is in **preview**. To access it, you must explicitly enable the `preview` mode in the provider level configuration."
 	TenantPermissionBlocksAPINote    = "\n\n~> When using Service Principal authentication, the Azure application must not have any Fabric permissions that require admin consent configured in the Azure portal."
 	IsAdminNote                      = "\n\n~> The caller must be a Fabric administrator."
 )
@@ -134,12 +134,14 @@ func (d *DataSourceFabricItemDefinitionProperties[Ttfprop, Titemprop]) Read(ctx
 		data.OutputDefinition = types.BoolValue(false)
 	}
 
-	if data.OutputDefinition.ValueBool() {
+	if data.OutputDefinition.ValueBool() || !data.OutputDirectory.IsNull() {
 		if resp.Diagnostics.Append(d.getDefinition(ctx, &data)...); resp.Diagnostics.HasError() {
 			return
 		}
 
-		tflog.Debug(ctx, "Definition parts content is gzip base64. Use `provider::fabric::content_decode` function to decode content.")
+		if data.OutputDefinition.ValueBool() {
+			tflog.Debug(ctx, "Definition parts content is gzip base64. Use `provider::fabric::content_decode` function to decode content.")
+		}
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 	}
@@ -223,12 +225,23 @@ func (d *DataSourceFabricItemDefinitionProperties[Ttfprop, Titemprop]) getDefini
 		return diags
 	}
 
-	definition, diags := getDataSourceDefinitionModel(ctx, *respGet.Definition)
-	if diags.HasError() {
-		return diags
+	if model.OutputDefinition.ValueBool() {
+		definition, diags := getDataSourceDefinitionModel(ctx, *respGet.Definition)
+		if diags.HasError() {
+			return diags
+		}
+
+		model.setDefinition(definition)
 	}
 
-	model.setDefinition(definition)
+	if !model.OutputDirectory.IsNull() {
+		outputFiles, diags := writeDefinitionParts(ctx, model.OutputDirectory.ValueString(), *respGet.Definition)
+		if diags.HasError() {
+			return diags
+		}
+
+		model.setOutputFiles(outputFiles)
+	}
 
 	return nil
 }
@@ -133,6 +133,7 @@ func getDataSourceFabricItemDefinitionAttributes(ctx context.Context, name strin
 	attrFormat.Validators = []validator.String{
 		stringvalidator.OneOf(formatTypes...),
 		superstringvalidator.RequireIfAttributeIsOneOf(path.MatchRoot("output_definition"), []attr.Value{types.BoolValue(true)}),
+		superstringvalidator.RequireIfAttributeIsSet(path.MatchRoot("output_directory")),
 	}
 
 	attributes["format"] = attrFormat
@@ -163,6 +164,25 @@ func getDataSourceFabricItemDefinitionAttributes(ctx context.Context, name strin
 
 	attributes["definition"] = attrDefinition
 
+	// output_directory attribute
+	attributes["output_directory"] = schema.StringAttribute{
+		MarkdownDescription: "Path to the directory to write the definition parts to. Each part is written to a file with the part path relative to the directory, " +
+			"the same layout as consumed by the `definition_directory` attribute of the resource. Existing files are overwritten.",
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	// output_files attribute
+	attributes["output_files"] = schema.MapAttribute{
+		MarkdownDescription: "Map of the definition part paths written to the `output_directory` to the SHA256 of their content. " +
+			"The SHA256 can be compared with the `source_content_sha256` of the resource definition part.",
+		Computed:    true,
+		CustomType:  supertypes.NewMapTypeOf[types.String](ctx),
+		ElementType: types.StringType,
+	}
+
 	return attributes
 }
 
@@ -5,6 +5,7 @@ package fabricitem
 
 import (
 	"maps"
+	"regexp"
 	"slices"
 	"strings"
 
@@ -82,6 +83,20 @@ func GetDefinitionFormatPaths(values []DefinitionFormat, format string) []string
 	return nil
 }
 
+// DefinitionPathMatches checks if the definition part path matches one of the definition format paths.
+// The `*` in the definition format path matches any characters, including the path separator.
+func DefinitionPathMatches(partPath string, definitionPaths []string) bool {
+	for _, definitionPath := range definitionPaths {
+		pattern := "^" + strings.ReplaceAll(regexp.QuoteMeta(definitionPath), `\*`, ".+") + "$"
+
+		if regexp.MustCompile(pattern).MatchString(partPath) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func getDefinitionFormatAPI(values []DefinitionFormat, format string) string {
 	for _, value := range values {
 		if value.Type == format {
@@ -0,0 +1,247 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fabricitem
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/params"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/transforms"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+// definitionDirectoryTemplateExt is trimmed from the source file name to get the definition part path.
+const definitionDirectoryTemplateExt = ".tmpl"
+
+// modifyPlanDefinitionDirectory sets the planned definition parts from the definition_directory.
+// Without definition_directory the configured definition is kept as is.
+func modifyPlanDefinitionDirectory(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+	definitionFormats []DefinitionFormat,
+) diag.Diagnostics {
+	if req.Plan.Raw.IsNull() {
+		return nil
+	}
+
+	var directory supertypes.SingleNestedObjectValueOf[resourceFabricItemDefinitionDirectoryModel]
+	if diags := resp.Plan.GetAttribute(ctx, path.Root("definition_directory"), &directory); diags.HasError() {
+		return diags
+	}
+
+	if directory.IsNull() {
+		var definition supertypes.MapNestedObjectValueOf[resourceFabricItemDefinitionPartModel]
+		if diags := req.Config.GetAttribute(ctx, path.Root("definition"), &definition); diags.HasError() {
+			return diags
+		}
+
+		if definition.IsNull() {
+			return resp.Plan.SetAttribute(ctx, path.Root("definition"), supertypes.NewMapNestedObjectValueOfNull[resourceFabricItemDefinitionPartModel](ctx))
+		}
+
+		return nil
+	}
+
+	// The definition stays unknown until the directory and format are known
+	if directory.IsUnknown() {
+		return nil
+	}
+
+	dir, diags := directory.Get(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	var format types.String
+	if diags := resp.Plan.GetAttribute(ctx, path.Root("format"), &format); diags.HasError() {
+		return diags
+	}
+
+	if dir.Path.IsUnknown() || format.IsUnknown() {
+		return nil
+	}
+
+	defParts, diags := getDefinitionDirectoryParts(ctx, dir, GetDefinitionFormatPaths(definitionFormats, format.ValueString()))
+	if diags.HasError() {
+		return diags
+	}
+
+	return resp.Plan.SetAttribute(ctx, path.Root("definition"), supertypes.NewMapNestedObjectValueOfMap(ctx, defParts))
+}
+
+// getDefinitionDirectoryParts walks the directory and maps each source file to a definition part.
+// The part path is the file path relative to the directory, without the `.tmpl` extension.
+// Files and directories starting with a dot (e.g. `.platform` or `.pbi` from Git integration) are ignored.
+func getDefinitionDirectoryParts(
+	ctx context.Context,
+	dir *resourceFabricItemDefinitionDirectoryModel,
+	definitionPaths []string,
+) (map[string]*resourceFabricItemDefinitionPartModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	root := dir.Path.ValueString()
+	sources := make(map[string]string)
+	unmatchedPaths := make([]string, 0)
+
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if p != root && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+
+		partPath := strings.TrimSuffix(filepath.ToSlash(relPath), definitionDirectoryTemplateExt)
+
+		if !DefinitionPathMatches(partPath, definitionPaths) {
+			unmatchedPaths = append(unmatchedPaths, partPath)
+
+			return nil
+		}
+
+		sources[partPath] = filepath.ToSlash(p)
+
+		return nil
+	})
+	if err != nil {
+		diags.AddAttributeError(path.Root("definition_directory").AtName("path"), common.ErrorFileReadHeader, err.Error())
+
+		return nil, diags
+	}
+
+	if len(unmatchedPaths) > 0 {
+		diags.AddAttributeError(
+			path.Root("definition_directory").AtName("path"),
+			common.ErrorDefinitionDirectoryHeader,
+			fmt.Sprintf(
+				common.ErrorDefinitionDirectoryPaths,
+				root,
+				utils.ConvertStringSlicesToString(definitionPaths, true, true),
+				utils.ConvertStringSlicesToString(unmatchedPaths, true, true),
+			),
+		)
+
+		return nil, diags
+	}
+
+	if len(sources) == 0 {
+		diags.AddAttributeError(
+			path.Root("definition_directory").AtName("path"),
+			common.ErrorDefinitionDirectoryHeader,
+			fmt.Sprintf(common.ErrorDefinitionDirectoryEmpty, root),
+		)
+
+		return nil, diags
+	}
+
+	tokensValue, parameters, known, diags := getDefinitionDirectoryProcessing(ctx, dir)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	defParts := make(map[string]*resourceFabricItemDefinitionPartModel, len(sources))
+
+	for partPath, source := range sources {
+		contentSha256 := types.StringUnknown()
+
+		if known {
+			_, sha256Value, diags := transforms.SourceFileToPayload(
+				source,
+				dir.ProcessingMode.ValueString(),
+				tokensValue,
+				parameters,
+				dir.TokensDelimiter.ValueString(),
+			)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			contentSha256 = types.StringValue(sha256Value)
+		}
+
+		defParts[partPath] = &resourceFabricItemDefinitionPartModel{
+			Source:              types.StringValue(source),
+			Parameters:          dir.Parameters,
+			ProcessingMode:      dir.ProcessingMode,
+			Tokens:              dir.Tokens,
+			TokensDelimiter:     dir.TokensDelimiter,
+			SourceContentSha256: contentSha256,
+		}
+	}
+
+	tflog.Debug(ctx, "definition directory parts", map[string]any{
+		"path":  root,
+		"parts": len(defParts),
+	})
+
+	return defParts, nil
+}
+
+// getDefinitionDirectoryProcessing returns the tokens and parameters of the definition directory.
+// If any of the values is not known yet, the content SHA256 cannot be calculated.
+func getDefinitionDirectoryProcessing(
+	ctx context.Context,
+	dir *resourceFabricItemDefinitionDirectoryModel,
+) (map[string]string, []*params.ParametersModel, bool, diag.Diagnostics) { //revive:disable-line:function-result-limit
+	if dir.ProcessingMode.IsUnknown() || dir.TokensDelimiter.IsUnknown() || dir.Tokens.IsUnknown() || dir.Parameters.IsUnknown() {
+		return nil, nil, false, nil
+	}
+
+	tokensValue := make(map[string]string)
+
+	tokens, diags := dir.Tokens.Get(ctx)
+	if diags.HasError() {
+		return nil, nil, false, diags
+	}
+
+	for k, v := range tokens {
+		if v.IsUnknown() {
+			return nil, nil, false, nil
+		}
+
+		if !v.IsNull() {
+			tokensValue[k] = v.ValueString()
+		}
+	}
+
+	parameters, diags := dir.Parameters.Get(ctx)
+	if diags.HasError() {
+		return nil, nil, false, diags
+	}
+
+	for _, param := range parameters {
+		if param.Type.IsUnknown() || param.Find.IsUnknown() || param.Value.IsUnknown() {
+			return nil, nil, false, nil
+		}
+	}
+
+	return tokensValue, parameters, true, nil
+}
@@ -0,0 +1,190 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fabricitem
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/transforms"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+// isDefinitionDriftDetectionEnabled returns the resource level setting if set, otherwise the provider level setting.
+// Drift is never detected when definition updates are disabled, as the configured content could not be restored anyway.
+func (r *ResourceFabricItemDefinition) isDefinitionDriftDetectionEnabled(
+	definitionDriftDetectionEnabled, definitionUpdateEnabled types.Bool,
+	definition supertypes.MapNestedObjectValueOf[resourceFabricItemDefinitionPartModel],
+) bool {
+	if definition.IsNull() || definition.IsUnknown() || !definitionUpdateEnabled.ValueBool() {
+		return false
+	}
+
+	if !definitionDriftDetectionEnabled.IsNull() && !definitionDriftDetectionEnabled.IsUnknown() {
+		return definitionDriftDetectionEnabled.ValueBool()
+	}
+
+	return r.pConfigData.DefinitionDriftDetectionEnabled
+}
+
+// definitionPrivateStateKey is the private state key holding the SHA256 of each definition part as returned by Fabric after the last apply.
+const definitionPrivateStateKey = "definition_sha256"
+
+// privateState is the resource private state, shared by the Create, Read and Update responses.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getDefinitionSha256 gets the item definition and returns the SHA256 of the content of each part, by path.
+func (r *ResourceFabricItemDefinition) getDefinitionSha256(ctx context.Context, workspaceID, itemID string, format types.String) (map[string]string, diag.Diagnostics) {
+	respGetOpts := &fabcore.ItemsClientBeginGetItemDefinitionOptions{}
+
+	if format.ValueString() != DefinitionFormatDefault && format.ValueString() != "" {
+		apiFormat := getDefinitionFormatAPI(r.DefinitionFormats, format.ValueString())
+
+		if apiFormat != "" {
+			respGetOpts.Format = &apiFormat
+		}
+	}
+
+	respGet, err := r.client.GetItemDefinition(ctx, workspaceID, itemID, respGetOpts)
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationRead, nil); diags.HasError() {
+		return nil, diags
+	}
+
+	result := make(map[string]string)
+
+	if respGet.Definition != nil {
+		for _, part := range respGet.Definition.Parts {
+			if part.Path == nil || part.Payload == nil {
+				continue
+			}
+
+			contentSha256, diags := transforms.PayloadToSha256(*part.Payload)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			result[*part.Path] = contentSha256
+		}
+	}
+
+	return result, nil
+}
+
+// saveDefinitionBaseline records the SHA256 of the definition parts as returned by Fabric in the private state.
+// Fabric normalizes the content of some formats (e.g. notebooks and reports), so drift is detected against
+// the content Fabric returns after an apply, not against the configured content.
+func (r *ResourceFabricItemDefinition) saveDefinitionBaseline(
+	ctx context.Context,
+	private privateState,
+	workspaceID, itemID string,
+	format types.String,
+) diag.Diagnostics {
+	remoteSha256, diags := r.getDefinitionSha256(ctx, workspaceID, itemID, format)
+	if diags.HasError() {
+		return diags
+	}
+
+	value, err := json.Marshal(remoteSha256)
+	if err != nil {
+		diags.AddError(common.ErrorReadHeader, err.Error())
+
+		return diags
+	}
+
+	return private.SetKey(ctx, definitionPrivateStateKey, value)
+}
+
+// checkDefinitionDrift gets the item definition and compares the content of each part with the content Fabric returned after the last apply.
+// Changed or missing parts get the SHA256 of the Fabric side content (null for missing parts), so the next plan restores the configured content.
+// When no baseline was recorded yet (e.g. after an import), the current content becomes the baseline and no drift is reported.
+func (r *ResourceFabricItemDefinition) checkDefinitionDrift(
+	ctx context.Context,
+	private privateState,
+	workspaceID, itemID string,
+	format types.String,
+	definition *supertypes.MapNestedObjectValueOf[resourceFabricItemDefinitionPartModel],
+) diag.Diagnostics {
+	tflog.Trace(ctx, fmt.Sprintf("checking %s definition drift (WorkspaceID: %s ItemID: %s)", r.TypeInfo.Name, workspaceID, itemID))
+
+	defParts, diags := definition.Get(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(defParts) == 0 {
+		return nil
+	}
+
+	baseline, diags := private.GetKey(ctx, definitionPrivateStateKey)
+	if diags.HasError() {
+		return diags
+	}
+
+	if baseline == nil {
+		return r.saveDefinitionBaseline(ctx, private, workspaceID, itemID, format)
+	}
+
+	var baselineSha256 map[string]string
+
+	if err := json.Unmarshal(baseline, &baselineSha256); err != nil {
+		diags.AddError(common.ErrorReadHeader, err.Error())
+
+		return diags
+	}
+
+	remoteSha256, diags := r.getDefinitionSha256(ctx, workspaceID, itemID, format)
+	if diags.HasError() {
+		return diags
+	}
+
+	driftedPaths := make([]string, 0)
+
+	for defPartKey, defPartValue := range defParts {
+		contentSha256, ok := remoteSha256[defPartKey]
+
+		if contentSha256 == baselineSha256[defPartKey] {
+			continue
+		}
+
+		driftedPaths = append(driftedPaths, defPartKey)
+
+		if ok {
+			defPartValue.SourceContentSha256 = types.StringValue(contentSha256)
+		} else {
+			defPartValue.SourceContentSha256 = types.StringNull()
+		}
+	}
+	if len(driftedPaths) == 0 {
+		return nil
+	}
+
+	if diags := definition.Set(ctx, defParts); diags.HasError() {
+		return diags
+	}
+
+	slices.Sort(driftedPaths)
+
+	tflog.Debug(ctx, fmt.Sprintf("%s definition drift detected", r.TypeInfo.Name), map[string]any{
+		"paths": driftedPaths,
+	})
+
+	diags.AddWarning(
+		common.WarningItemDefinitionDriftHeader,
+		fmt.Sprintf(common.WarningItemDefinitionDriftDetails, r.TypeInfo.Name, utils.ConvertStringSlicesToString(driftedPaths, true, true)),
+	)
+
+	return diags
+}
@@ -0,0 +1,84 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fabricitem
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/transforms"
+)
+
+// writeDefinitionParts writes the content of each definition part to a file in the directory, with the part path relative to the directory.
+// It returns the SHA256 of the content of each written part, calculated the same way as source_content_sha256 of the resource definition part.
+func writeDefinitionParts(ctx context.Context, dir string, definition fabcore.ItemDefinition) (supertypes.MapValueOf[types.String], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	outputFiles := make(map[string]types.String, len(definition.Parts))
+
+	for _, part := range definition.Parts {
+		if part.Path == nil || part.Payload == nil {
+			continue
+		}
+
+		partPath := filepath.FromSlash(*part.Path)
+
+		// Part paths come from the Fabric API, never write outside of the output directory
+		if !filepath.IsLocal(partPath) {
+			diags.AddAttributeError(
+				path.Root("output_directory"),
+				common.ErrorFileWriteHeader,
+				fmt.Sprintf("Definition part path '%s' is not a local path.", *part.Path),
+			)
+
+			return supertypes.NewMapValueOfNull[types.String](ctx), diags
+		}
+
+		content, err := base64.StdEncoding.DecodeString(*part.Payload)
+		if err != nil {
+			diags.AddError(common.ErrorBase64DecodeHeader, err.Error())
+
+			return supertypes.NewMapValueOfNull[types.String](ctx), diags
+		}
+
+		contentSha256, diags := transforms.PayloadToSha256(*part.Payload)
+		if diags.HasError() {
+			return supertypes.NewMapValueOfNull[types.String](ctx), diags
+		}
+
+		filePath := filepath.Join(dir, partPath)
+
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o750); err != nil {
+			diags.AddAttributeError(path.Root("output_directory"), common.ErrorFileWriteHeader, err.Error())
+
+			return supertypes.NewMapValueOfNull[types.String](ctx), diags
+		}
+
+		if err := os.WriteFile(filePath, content, 0o600); err != nil {
+			diags.AddAttributeError(path.Root("output_directory"), common.ErrorFileWriteHeader, err.Error())
+
+			return supertypes.NewMapValueOfNull[types.String](ctx), diags
+		}
+
+		outputFiles[*part.Path] = types.StringValue(contentSha256)
+	}
+
+	tflog.Debug(ctx, "definition parts written", map[string]any{
+		"path":  dir,
+		"parts": len(outputFiles),
+	})
+
+	return supertypes.NewMapValueOfMap(ctx, outputFiles)
+}
@@ -0,0 +1,35 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fabricitem_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+)
+
+func TestUnit_DefinitionPathMatches(t *testing.T) {
+	definitionPaths := []string{"definition.pbir", "definition/**", "StaticResources/**"}
+
+	testCases := map[string]struct {
+		partPath string
+		expected bool
+	}{
+		"exact":              {partPath: "definition.pbir", expected: true},
+		"wildcard":           {partPath: "definition/report.json", expected: true},
+		"wildcard_nested":    {partPath: "StaticResources/SharedResources/BaseThemes/CY24SU10.json", expected: true},
+		"wildcard_empty":     {partPath: "definition/", expected: false},
+		"not_matched":        {partPath: "report.json", expected: false},
+		"not_matched_prefix": {partPath: "other/definition.pbir", expected: false},
+		"regexp_meta":        {partPath: "definitionXpbir", expected: false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, fabricitem.DefinitionPathMatches(testCase.partPath, definitionPaths))
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/microsoft/terraform-provider-fabric/internal/common"
 	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
 )
 
 func NewResourceMarkdownDescription(typeInfo tftypeinfo.TFTypeInfo, plural bool) string { //revive:disable-line:flag-parameter
@@ -126,6 +127,30 @@ func NewEphemeralResourceMarkdownDescription(typeInfo tftypeinfo.TFTypeInfo, plu
 	return md
 }
 
+func NewListResourceMarkdownDescription(typeInfo tftypeinfo.TFTypeInfo) string {
+	md := fmt.Sprintf("The %s list resource allows you to discover existing Fabric", typeInfo.Name)
+
+	if typeInfo.DocsURL != "" {
+		md += fmt.Sprintf(" [%s](%s)", typeInfo.Names, typeInfo.DocsURL)
+	} else {
+		md += " " + typeInfo.Names
+	}
+
+	md += " with `terraform query` and generate `import` blocks for them."
+
+	if typeInfo.IsSPNSupported {
+		md += SPNSupportedListResource
+	} else {
+		md += SPNNotSupportedListResource
+	}
+
+	if typeInfo.IsPreview {
+		md += PreviewListResource
+	}
+
+	return md
+}
+
 func IsPreviewMode(name string, itemIsPreview, providerPreviewMode bool) diag.Diagnostics { //revive:disable-line:flag-parameter
 	var diags diag.Diagnostics
 
@@ -233,3 +258,36 @@ func CreateItem(ctx context.Context, client *fabcore.ItemsClient, workspaceID st
 func MoveItem(ctx context.Context, client *fabcore.ItemsClient, workspaceID, itemID string, request fabcore.MoveItemRequest) (fabcore.ItemsClientMoveItemResponse, error) {
 	return client.MoveItem(ctx, workspaceID, itemID, request, nil)
 }
+
+// ErrCapacityAssignmentFailed is returned when the assignment of a Workspace to a Capacity fails.
+var ErrCapacityAssignmentFailed = errors.New("the capacity assignment failed")
+
+// WaitForCapacityAssignment reads the Workspace until its capacity assignment is no longer in progress.
+// It returns ErrCapacityAssignmentFailed when the assignment fails, and the ctx error when ctx is done first.
+func WaitForCapacityAssignment(ctx context.Context, client *fabcore.WorkspacesClient, workspaceID string) (fabcore.WorkspaceInfo, error) {
+	for {
+		respGet, err := client.GetWorkspace(ctx, workspaceID, nil)
+		if err != nil {
+			return fabcore.WorkspaceInfo{}, err
+		}
+
+		if respGet.CapacityAssignmentProgress == nil {
+			return respGet.WorkspaceInfo, nil
+		}
+
+		switch *respGet.CapacityAssignmentProgress {
+		case fabcore.CapacityAssignmentProgressCompleted:
+			return respGet.WorkspaceInfo, nil
+		case fabcore.CapacityAssignmentProgressFailed:
+			return respGet.WorkspaceInfo, ErrCapacityAssignmentFailed
+		default:
+			tflog.Info(ctx, "Workspace capacity assignment in progress, waiting before retrying", map[string]any{
+				"workspace_id": workspaceID,
+			})
+
+			if err := utils.WaitForNextPoll(ctx); err != nil {
+				return respGet.WorkspaceInfo, err
+			}
+		}
+	}
+}
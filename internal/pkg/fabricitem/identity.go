@@ -0,0 +1,48 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fabricitem
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+)
+
+type itemIdentityModel struct {
+	WorkspaceID customtypes.UUID `tfsdk:"workspace_id"`
+	ID          customtypes.UUID `tfsdk:"id"`
+}
+
+func getItemIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_id": identityschema.StringAttribute{
+				Description:       "The Workspace ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The Item ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+		},
+	}
+}
+
+// setItemIdentity sets the resource identity of a workspace item, it is a no-op when the identity is not available (e.g. older Terraform versions).
+func setItemIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, workspaceID, itemID customtypes.UUID) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, itemIdentityModel{
+		WorkspaceID: workspaceID,
+		ID:          itemID,
+	})
+}
@@ -0,0 +1,157 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fabricitem
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResourceWithConfigure = (*ListResourceFabricItems)(nil)
+)
+
+type ListResourceFabricItems struct {
+	pConfigData    *pconfig.ProviderData
+	client         *fabcore.ItemsClient
+	FabricItemType fabcore.ItemType
+	TypeInfo       tftypeinfo.TFTypeInfo
+}
+
+type listResourceFabricItemsModel struct {
+	WorkspaceID customtypes.UUID `tfsdk:"workspace_id"`
+}
+
+func NewListResourceFabricItems(config ListResourceFabricItems) list.ListResource {
+	return &config
+}
+
+func (l *ListResourceFabricItems) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = l.TypeInfo.FullTypeName(false)
+}
+
+func (l *ListResourceFabricItems) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: NewListResourceMarkdownDescription(l.TypeInfo),
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "The Workspace ID.",
+				Required:            true,
+				CustomType:          customtypes.UUIDType{},
+			},
+		},
+	}
+}
+
+func (l *ListResourceFabricItems) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorListResourceConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	l.pConfigData = pConfigData
+	l.client = fabcore.NewClientFactoryWithClient(*pConfigData.FabricClient).NewItemsClient()
+
+	if resp.Diagnostics.Append(IsPreviewMode(l.TypeInfo.Name, l.TypeInfo.IsPreview, l.pConfigData.Preview)...); resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (l *ListResourceFabricItems) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Debug(ctx, "LIST", map[string]any{
+		"action": "start",
+	})
+
+	var config listResourceFabricItemsModel
+
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, l.pConfigData.Timeout)
+
+	respList, err := l.client.ListItems(ctx, config.WorkspaceID.ValueString(), &fabcore.ItemsClientListItemsOptions{
+		Type: new(string(l.FabricItemType)),
+	})
+
+	cancel()
+
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationList, nil); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, entity := range respList {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = utils.ValueOrZero(entity.DisplayName)
+
+			result.Diagnostics.Append(setItemIdentity(ctx, result.Identity, customtypes.NewUUIDPointerValue(entity.WorkspaceID), customtypes.NewUUIDPointerValue(entity.ID))...)
+
+			if req.IncludeResource {
+				result.Diagnostics.Append(setListResultResource(ctx, result.Resource, entity)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+
+		tflog.Debug(ctx, "LIST", map[string]any{
+			"action": "end",
+		})
+	}
+}
+
+// setListResultResource populates the attributes common to all Fabric Item resources, the item specific attributes are left null and populated on import.
+func setListResultResource(ctx context.Context, to *tfsdk.Resource, from fabcore.Item) diag.Diagnostics {
+	var model fabricItemModel
+
+	if diags := model.set(ctx, from); diags.HasError() {
+		return diags
+	}
+
+	var diags diag.Diagnostics
+
+	diags.Append(to.SetAttribute(ctx, path.Root("workspace_id"), model.WorkspaceID)...)
+	diags.Append(to.SetAttribute(ctx, path.Root("id"), model.ID)...)
+	diags.Append(to.SetAttribute(ctx, path.Root("display_name"), model.DisplayName)...)
+	diags.Append(to.SetAttribute(ctx, path.Root("description"), model.Description)...)
+	diags.Append(to.SetAttribute(ctx, path.Root("folder_id"), model.FolderID)...)
+	diags.Append(to.SetAttribute(ctx, path.Root("tags"), model.Tags)...)
+
+	return diags
+}
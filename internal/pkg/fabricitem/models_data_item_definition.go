@@ -21,6 +21,8 @@ type dataSourceFabricItemDefinitionModel struct {
 	Format           types.String                                                               `tfsdk:"format"`
 	OutputDefinition types.Bool                                                                 `tfsdk:"output_definition"`
 	Definition       supertypes.MapNestedObjectValueOf[dataSourceFabricItemDefinitionPartModel] `tfsdk:"definition"`
+	OutputDirectory  types.String                                                               `tfsdk:"output_directory"`
+	OutputFiles      supertypes.MapValueOf[types.String]                                        `tfsdk:"output_files"`
 	Timeouts         timeouts.Value                                                             `tfsdk:"timeouts"`
 }
 
@@ -28,6 +30,10 @@ func (to *dataSourceFabricItemDefinitionModel) setDefinition(v supertypes.MapNes
 	to.Definition = v
 }
 
+func (to *dataSourceFabricItemDefinitionModel) setOutputFiles(v supertypes.MapValueOf[types.String]) {
+	to.OutputFiles = v
+}
+
 func getDataSourceDefinitionModel(ctx context.Context, from fabcore.ItemDefinition) (supertypes.MapNestedObjectValueOf[dataSourceFabricItemDefinitionPartModel], diag.Diagnostics) {
 	defParts := make(map[string]*dataSourceFabricItemDefinitionPartModel, len(from.Parts))
 
@@ -15,9 +15,15 @@ type DataSourceFabricItemDefinitionPropertiesModel[Ttfprop, Titemprop any] struc
 	Format           types.String                                                               `tfsdk:"format"`
 	OutputDefinition types.Bool                                                                 `tfsdk:"output_definition"`
 	Definition       supertypes.MapNestedObjectValueOf[dataSourceFabricItemDefinitionPartModel] `tfsdk:"definition"`
+	OutputDirectory  types.String                                                               `tfsdk:"output_directory"`
+	OutputFiles      supertypes.MapValueOf[types.String]                                        `tfsdk:"output_files"`
 	Timeouts         timeouts.Value                                                             `tfsdk:"timeouts"`
 }
 
 func (to *DataSourceFabricItemDefinitionPropertiesModel[Ttfprop, Titemprop]) setDefinition(v supertypes.MapNestedObjectValueOf[dataSourceFabricItemDefinitionPartModel]) {
 	to.Definition = v
 }
+
+func (to *DataSourceFabricItemDefinitionPropertiesModel[Ttfprop, Titemprop]) setOutputFiles(v supertypes.MapValueOf[types.String]) {
+	to.OutputFiles = v
+}
@@ -12,9 +12,11 @@ import (
 type ResourceFabricItemConfigDefinitionPropertiesModel[Ttfprop, Titemprop, Ttfconfig, Titemconfig any] struct {
 	FabricItemPropertiesModel[Ttfprop, Titemprop]
 
-	Configuration           supertypes.SingleNestedObjectValueOf[Ttfconfig]                          `tfsdk:"configuration"`
-	Format                  types.String                                                             `tfsdk:"format"`
-	DefinitionUpdateEnabled types.Bool                                                               `tfsdk:"definition_update_enabled"`
-	Definition              supertypes.MapNestedObjectValueOf[resourceFabricItemDefinitionPartModel] `tfsdk:"definition"`
-	Timeouts                timeouts.Value                                                           `tfsdk:"timeouts"`
+	Configuration                   supertypes.SingleNestedObjectValueOf[Ttfconfig]                                  `tfsdk:"configuration"`
+	Format                          types.String                                                                     `tfsdk:"format"`
+	DefinitionUpdateEnabled         types.Bool                                                                       `tfsdk:"definition_update_enabled"`
+	DefinitionDriftDetectionEnabled types.Bool                                                                       `tfsdk:"definition_drift_detection_enabled"`
+	Definition                      supertypes.MapNestedObjectValueOf[resourceFabricItemDefinitionPartModel]         `tfsdk:"definition"`
+	DefinitionDirectory             supertypes.SingleNestedObjectValueOf[resourceFabricItemDefinitionDirectoryModel] `tfsdk:"definition_directory"`
+	Timeouts                        timeouts.Value                                                                   `tfsdk:"timeouts"`
 }
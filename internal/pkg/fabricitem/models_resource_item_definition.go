@@ -21,10 +21,12 @@ import (
 type resourceFabricItemDefinitionModel struct {
 	fabricItemModel
 
-	Format                  types.String                                                             `tfsdk:"format"`
-	DefinitionUpdateEnabled types.Bool                                                               `tfsdk:"definition_update_enabled"`
-	Definition              supertypes.MapNestedObjectValueOf[resourceFabricItemDefinitionPartModel] `tfsdk:"definition"`
-	Timeouts                timeouts.Value                                                           `tfsdk:"timeouts"`
+	Format                          types.String                                                                     `tfsdk:"format"`
+	DefinitionUpdateEnabled         types.Bool                                                                       `tfsdk:"definition_update_enabled"`
+	DefinitionDriftDetectionEnabled types.Bool                                                                       `tfsdk:"definition_drift_detection_enabled"`
+	Definition                      supertypes.MapNestedObjectValueOf[resourceFabricItemDefinitionPartModel]         `tfsdk:"definition"`
+	DefinitionDirectory             supertypes.SingleNestedObjectValueOf[resourceFabricItemDefinitionDirectoryModel] `tfsdk:"definition_directory"`
+	Timeouts                        timeouts.Value                                                                   `tfsdk:"timeouts"`
 }
 
 type resourceFabricItemDefinitionPartModel struct {
@@ -36,6 +38,14 @@ type resourceFabricItemDefinitionPartModel struct {
 	SourceContentSha256 types.String                                              `tfsdk:"source_content_sha256"`
 }
 
+type resourceFabricItemDefinitionDirectoryModel struct {
+	Path            types.String                                              `tfsdk:"path"`
+	Parameters      supertypes.SetNestedObjectValueOf[params.ParametersModel] `tfsdk:"parameters"`
+	ProcessingMode  types.String                                              `tfsdk:"processing_mode"`
+	Tokens          supertypes.MapValueOf[types.String]                       `tfsdk:"tokens"`
+	TokensDelimiter types.String                                              `tfsdk:"tokens_delimiter"`
+}
+
 type fabricItemDefinition struct {
 	fabcore.ItemDefinition
 }
@@ -12,8 +12,10 @@ import (
 type ResourceFabricItemDefinitionPropertiesModel[Ttfprop, Titemprop any] struct {
 	FabricItemPropertiesModel[Ttfprop, Titemprop]
 
-	Format                  types.String                                                             `tfsdk:"format"`
-	DefinitionUpdateEnabled types.Bool                                                               `tfsdk:"definition_update_enabled"`
-	Definition              supertypes.MapNestedObjectValueOf[resourceFabricItemDefinitionPartModel] `tfsdk:"definition"`
-	Timeouts                timeouts.Value                                                           `tfsdk:"timeouts"`
+	Format                          types.String                                                                     `tfsdk:"format"`
+	DefinitionUpdateEnabled         types.Bool                                                                       `tfsdk:"definition_update_enabled"`
+	DefinitionDriftDetectionEnabled types.Bool                                                                       `tfsdk:"definition_drift_detection_enabled"`
+	Definition                      supertypes.MapNestedObjectValueOf[resourceFabricItemDefinitionPartModel]         `tfsdk:"definition"`
+	DefinitionDirectory             supertypes.SingleNestedObjectValueOf[resourceFabricItemDefinitionDirectoryModel] `tfsdk:"definition_directory"`
+	Timeouts                        timeouts.Value                                                                   `tfsdk:"timeouts"`
 }
@@ -26,6 +26,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = (*ResourceFabricItem)(nil)
 	_ resource.ResourceWithImportState = (*ResourceFabricItem)(nil)
+	_ resource.ResourceWithIdentity    = (*ResourceFabricItem)(nil)
 )
 
 type ResourceFabricItem struct {
@@ -51,6 +52,10 @@ func (r *ResourceFabricItem) Schema(ctx context.Context, _ resource.SchemaReques
 	resp.Schema = getResourceFabricItemSchema(ctx, *r)
 }
 
+func (r *ResourceFabricItem) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = getItemIdentitySchema()
+}
+
 func (r *ResourceFabricItem) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -129,6 +134,7 @@ func (r *ResourceFabricItem) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "CREATE", map[string]any{
 		"action": "end",
@@ -172,6 +178,7 @@ func (r *ResourceFabricItem) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "READ", map[string]any{
 		"action": "end",
@@ -238,6 +245,7 @@ func (r *ResourceFabricItem) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "UPDATE", map[string]any{
 		"action": "end",
@@ -285,7 +293,12 @@ func (r *ResourceFabricItem) ImportState(ctx context.Context, req resource.Impor
 		"id": req.ID,
 	})
 
-	workspaceID, fabricItemID, found := strings.Cut(req.ID, "/")
+	importID, diags := utils.GetImportID(ctx, req, "workspace_id", "id")
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID, fabricItemID, found := strings.Cut(importID, "/")
 	if !found {
 		resp.Diagnostics.AddError(
 			common.ErrorImportIdentifierHeader,
@@ -323,6 +336,7 @@ func (r *ResourceFabricItem) ImportState(ctx context.Context, req resource.Impor
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "IMPORT", map[string]any{
 		"action": "end",
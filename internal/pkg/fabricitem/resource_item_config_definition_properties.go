@@ -32,6 +32,7 @@ var (
 	_ resource.ResourceWithConfigValidators = (*ResourceFabricItemConfigDefinitionProperties[struct{}, struct{}, struct{}, struct{}])(nil)
 	_ resource.ResourceWithConfigure        = (*ResourceFabricItemConfigDefinitionProperties[struct{}, struct{}, struct{}, struct{}])(nil)
 	_ resource.ResourceWithImportState      = (*ResourceFabricItemConfigDefinitionProperties[struct{}, struct{}, struct{}, struct{}])(nil)
+	_ resource.ResourceWithIdentity         = (*ResourceFabricItemConfigDefinitionProperties[struct{}, struct{}, struct{}, struct{}])(nil)
 )
 
 type ResourceFabricItemConfigDefinitionProperties[Ttfprop, Titemprop, Ttfconfig, Titemconfig any] struct {
@@ -69,10 +70,14 @@ func (r *ResourceFabricItemConfigDefinitionProperties[Ttfprop, Titemprop, Ttfcon
 		"action": "start",
 	})
 
+	if resp.Diagnostics.Append(modifyPlanDefinitionDirectory(ctx, req, resp, r.DefinitionFormats)...); resp.Diagnostics.HasError() {
+		return
+	}
+
 	if !req.State.Raw.IsNull() && !req.Plan.Raw.IsNull() {
 		var plan, state ResourceFabricItemConfigDefinitionPropertiesModel[Ttfprop, Titemprop, Ttfconfig, Titemconfig]
 
-		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
 		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 		if resp.Diagnostics.HasError() {
@@ -116,6 +121,10 @@ func (r *ResourceFabricItemConfigDefinitionProperties[Ttfprop, Titemprop, Ttfcon
 	resp.Schema = getResourceFabricItemConfigDefinitionPropertiesSchema(ctx, *r)
 }
 
+func (r *ResourceFabricItemConfigDefinitionProperties[Ttfprop, Titemprop, Ttfconfig, Titemconfig]) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = getItemIdentitySchema()
+}
+
 func (r *ResourceFabricItemConfigDefinitionProperties[Ttfprop, Titemprop, Ttfconfig, Titemconfig]) ConfigValidators(
 	_ context.Context,
 ) []resource.ConfigValidator {
@@ -125,12 +134,14 @@ func (r *ResourceFabricItemConfigDefinitionProperties[Ttfprop, Titemprop, Ttfcon
 		result = append(result, resourcevalidator.ExactlyOneOf(
 			path.MatchRoot("configuration"),
 			path.MatchRoot("definition"),
+			path.MatchRoot("definition_directory"),
 		))
 	}
 
 	result = append(result, resourcevalidator.Conflicting(
 		path.MatchRoot("configuration"),
 		path.MatchRoot("definition"),
+		path.MatchRoot("definition_directory"),
 	))
 
 	return result
@@ -236,6 +247,11 @@ func (r *ResourceFabricItemConfigDefinitionProperties[Ttfprop, Titemprop, Ttfcon
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
+
+	if r.isDefinitionDriftDetectionEnabled(plan.DefinitionDriftDetectionEnabled, plan.DefinitionUpdateEnabled, plan.Definition) {
+		resp.Diagnostics.Append(r.saveDefinitionBaseline(ctx, resp.Private, plan.WorkspaceID.ValueString(), plan.ID.ValueString(), plan.Format)...)
+	}
 
 	tflog.Debug(ctx, "CREATE", map[string]any{
 		"action": "end",
@@ -282,7 +298,14 @@ func (r *ResourceFabricItemConfigDefinitionProperties[Ttfprop, Titemprop, Ttfcon
 		return
 	}
 
+	if r.isDefinitionDriftDetectionEnabled(state.DefinitionDriftDetectionEnabled, state.DefinitionUpdateEnabled, state.Definition) {
+		if resp.Diagnostics.Append(r.checkDefinitionDrift(ctx, resp.Private, state.WorkspaceID.ValueString(), state.ID.ValueString(), state.Format, &state.Definition)...); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "READ", map[string]any{
 		"action": "end",
@@ -378,6 +401,11 @@ func (r *ResourceFabricItemConfigDefinitionProperties[Ttfprop, Titemprop, Ttfcon
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
+
+	if r.isDefinitionDriftDetectionEnabled(plan.DefinitionDriftDetectionEnabled, plan.DefinitionUpdateEnabled, plan.Definition) {
+		resp.Diagnostics.Append(r.saveDefinitionBaseline(ctx, resp.Private, plan.WorkspaceID.ValueString(), plan.ID.ValueString(), plan.Format)...)
+	}
 
 	tflog.Debug(ctx, "UPDATE", map[string]any{
 		"action": "end",
@@ -433,7 +461,12 @@ func (r *ResourceFabricItemConfigDefinitionProperties[Ttfprop, Titemprop, Ttfcon
 		"id": req.ID,
 	})
 
-	workspaceID, fabricItemID, found := strings.Cut(req.ID, "/")
+	importID, diags := utils.GetImportID(ctx, req, "workspace_id", "id")
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID, fabricItemID, found := strings.Cut(importID, "/")
 	if !found {
 		resp.Diagnostics.AddError(
 			common.ErrorImportIdentifierHeader,
@@ -471,20 +504,32 @@ func (r *ResourceFabricItemConfigDefinitionProperties[Ttfprop, Titemprop, Ttfcon
 		return
 	}
 
+	var definitionDriftDetectionEnabled types.Bool
+	if resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("definition_drift_detection_enabled"), &definitionDriftDetectionEnabled)...); resp.Diagnostics.HasError() {
+		return
+	}
+
 	var definition supertypes.MapNestedObjectValueOf[resourceFabricItemDefinitionPartModel]
 	if resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("definition"), &definition)...); resp.Diagnostics.HasError() {
 		return
 	}
 
+	var definitionDirectory supertypes.SingleNestedObjectValueOf[resourceFabricItemDefinitionDirectoryModel]
+	if resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("definition_directory"), &definitionDirectory)...); resp.Diagnostics.HasError() {
+		return
+	}
+
 	state := ResourceFabricItemConfigDefinitionPropertiesModel[Ttfprop, Titemprop, Ttfconfig, Titemconfig]{
 		FabricItemPropertiesModel: FabricItemPropertiesModel[Ttfprop, Titemprop]{
 			ID:          uuidFabricItemID,
 			WorkspaceID: uuidWorkspaceID,
 		},
-		Configuration:           configuration,
-		DefinitionUpdateEnabled: definitionUpdateEnabled,
-		Definition:              definition,
-		Timeouts:                timeout,
+		Configuration:                   configuration,
+		DefinitionUpdateEnabled:         definitionUpdateEnabled,
+		DefinitionDriftDetectionEnabled: definitionDriftDetectionEnabled,
+		Definition:                      definition,
+		DefinitionDirectory:             definitionDirectory,
+		Timeouts:                        timeout,
 	}
 
 	if resp.Diagnostics.Append(r.get(ctx, &state)...); resp.Diagnostics.HasError() {
@@ -492,6 +537,7 @@ func (r *ResourceFabricItemConfigDefinitionProperties[Ttfprop, Titemprop, Ttfcon
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "IMPORT", map[string]any{
 		"action": "end",
@@ -28,6 +28,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = (*ResourceFabricItemConfigProperties[struct{}, struct{}, struct{}, struct{}])(nil)
 	_ resource.ResourceWithImportState = (*ResourceFabricItemConfigProperties[struct{}, struct{}, struct{}, struct{}])(nil)
+	_ resource.ResourceWithIdentity    = (*ResourceFabricItemConfigProperties[struct{}, struct{}, struct{}, struct{}])(nil)
 )
 
 type ResourceFabricItemConfigProperties[Ttfprop, Titemprop, Ttfconfig, Titemconfig any] struct {
@@ -61,6 +62,10 @@ func (r *ResourceFabricItemConfigProperties[Ttfprop, Titemprop, Ttfconfig, Titem
 	resp.Schema = getResourceFabricItemConfigPropertiesSchema(ctx, *r)
 }
 
+func (r *ResourceFabricItemConfigProperties[Ttfprop, Titemprop, Ttfconfig, Titemconfig]) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = getItemIdentitySchema()
+}
+
 func (r *ResourceFabricItemConfigProperties[Ttfprop, Titemprop, Ttfconfig, Titemconfig]) Configure(
 	_ context.Context,
 	req resource.ConfigureRequest,
@@ -158,6 +163,7 @@ func (r *ResourceFabricItemConfigProperties[Ttfprop, Titemprop, Ttfconfig, Titem
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "CREATE", map[string]any{
 		"action": "end",
@@ -205,6 +211,7 @@ func (r *ResourceFabricItemConfigProperties[Ttfprop, Titemprop, Ttfconfig, Titem
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "READ", map[string]any{
 		"action": "end",
@@ -275,6 +282,7 @@ func (r *ResourceFabricItemConfigProperties[Ttfprop, Titemprop, Ttfconfig, Titem
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "UPDATE", map[string]any{
 		"action": "end",
@@ -330,7 +338,12 @@ func (r *ResourceFabricItemConfigProperties[Ttfprop, Titemprop, Ttfconfig, Titem
 		"id": req.ID,
 	})
 
-	workspaceID, fabricItemID, found := strings.Cut(req.ID, "/")
+	importID, diags := utils.GetImportID(ctx, req, "workspace_id", "id")
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID, fabricItemID, found := strings.Cut(importID, "/")
 	if !found {
 		resp.Diagnostics.AddError(
 			common.ErrorImportIdentifierHeader,
@@ -377,6 +390,7 @@ func (r *ResourceFabricItemConfigProperties[Ttfprop, Titemprop, Ttfconfig, Titem
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "IMPORT", map[string]any{
 		"action": "end",
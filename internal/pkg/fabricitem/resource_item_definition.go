@@ -30,6 +30,7 @@ var (
 	_ resource.ResourceWithModifyPlan  = (*ResourceFabricItemDefinition)(nil)
 	_ resource.ResourceWithConfigure   = (*ResourceFabricItemDefinition)(nil)
 	_ resource.ResourceWithImportState = (*ResourceFabricItemDefinition)(nil)
+	_ resource.ResourceWithIdentity    = (*ResourceFabricItemDefinition)(nil)
 )
 
 type ResourceFabricItemDefinition struct {
@@ -61,10 +62,14 @@ func (r *ResourceFabricItemDefinition) ModifyPlan(ctx context.Context, req resou
 		"action": "start",
 	})
 
+	if resp.Diagnostics.Append(modifyPlanDefinitionDirectory(ctx, req, resp, r.DefinitionFormats)...); resp.Diagnostics.HasError() {
+		return
+	}
+
 	if !req.State.Raw.IsNull() && !req.Plan.Raw.IsNull() {
 		var plan, state resourceFabricItemDefinitionModel
 
-		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
 		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 		if resp.Diagnostics.HasError() {
@@ -104,6 +109,10 @@ func (r *ResourceFabricItemDefinition) Schema(ctx context.Context, _ resource.Sc
 	resp.Schema = getResourceFabricItemDefinitionSchema(ctx, *r)
 }
 
+func (r *ResourceFabricItemDefinition) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = getItemIdentitySchema()
+}
+
 func (r *ResourceFabricItemDefinition) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -185,6 +194,11 @@ func (r *ResourceFabricItemDefinition) Create(ctx context.Context, req resource.
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
+
+	if r.isDefinitionDriftDetectionEnabled(plan.DefinitionDriftDetectionEnabled, plan.DefinitionUpdateEnabled, plan.Definition) {
+		resp.Diagnostics.Append(r.saveDefinitionBaseline(ctx, resp.Private, plan.WorkspaceID.ValueString(), plan.ID.ValueString(), plan.Format)...)
+	}
 
 	tflog.Debug(ctx, "CREATE", map[string]any{
 		"action": "end",
@@ -227,7 +241,14 @@ func (r *ResourceFabricItemDefinition) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	if r.isDefinitionDriftDetectionEnabled(state.DefinitionDriftDetectionEnabled, state.DefinitionUpdateEnabled, state.Definition) {
+		if resp.Diagnostics.Append(r.checkDefinitionDrift(ctx, resp.Private, state.WorkspaceID.ValueString(), state.ID.ValueString(), state.Format, &state.Definition)...); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "READ", map[string]any{
 		"action": "end",
@@ -319,6 +340,11 @@ func (r *ResourceFabricItemDefinition) Update(ctx context.Context, req resource.
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
+
+	if r.isDefinitionDriftDetectionEnabled(plan.DefinitionDriftDetectionEnabled, plan.DefinitionUpdateEnabled, plan.Definition) {
+		resp.Diagnostics.Append(r.saveDefinitionBaseline(ctx, resp.Private, plan.WorkspaceID.ValueString(), plan.ID.ValueString(), plan.Format)...)
+	}
 
 	tflog.Debug(ctx, "UPDATE", map[string]any{
 		"action": "end",
@@ -366,7 +392,12 @@ func (r *ResourceFabricItemDefinition) ImportState(ctx context.Context, req reso
 		"id": req.ID,
 	})
 
-	workspaceID, fabricItemID, found := strings.Cut(req.ID, "/")
+	importID, diags := utils.GetImportID(ctx, req, "workspace_id", "id")
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID, fabricItemID, found := strings.Cut(importID, "/")
 	if !found {
 		resp.Diagnostics.AddError(
 			common.ErrorImportIdentifierHeader,
@@ -399,19 +430,31 @@ func (r *ResourceFabricItemDefinition) ImportState(ctx context.Context, req reso
 		return
 	}
 
+	var definitionDriftDetectionEnabled types.Bool
+	if resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("definition_drift_detection_enabled"), &definitionDriftDetectionEnabled)...); resp.Diagnostics.HasError() {
+		return
+	}
+
 	var definition supertypes.MapNestedObjectValueOf[resourceFabricItemDefinitionPartModel]
 	if resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("definition"), &definition)...); resp.Diagnostics.HasError() {
 		return
 	}
 
+	var definitionDirectory supertypes.SingleNestedObjectValueOf[resourceFabricItemDefinitionDirectoryModel]
+	if resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("definition_directory"), &definitionDirectory)...); resp.Diagnostics.HasError() {
+		return
+	}
+
 	state := resourceFabricItemDefinitionModel{
 		fabricItemModel: fabricItemModel{
 			ID:          uuidFabricItemID,
 			WorkspaceID: uuidWorkspaceID,
 		},
-		DefinitionUpdateEnabled: definitionUpdateEnabled,
-		Definition:              definition,
-		Timeouts:                timeout,
+		DefinitionUpdateEnabled:         definitionUpdateEnabled,
+		DefinitionDriftDetectionEnabled: definitionDriftDetectionEnabled,
+		Definition:                      definition,
+		DefinitionDirectory:             definitionDirectory,
+		Timeouts:                        timeout,
 	}
 
 	if resp.Diagnostics.Append(r.get(ctx, &state)...); resp.Diagnostics.HasError() {
@@ -419,6 +462,7 @@ func (r *ResourceFabricItemDefinition) ImportState(ctx context.Context, req reso
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "IMPORT", map[string]any{
 		"action": "end",
@@ -30,6 +30,7 @@ var (
 	_ resource.ResourceWithModifyPlan  = (*ResourceFabricItemDefinitionProperties[struct{}, struct{}])(nil)
 	_ resource.ResourceWithConfigure   = (*ResourceFabricItemDefinitionProperties[struct{}, struct{}])(nil)
 	_ resource.ResourceWithImportState = (*ResourceFabricItemDefinitionProperties[struct{}, struct{}])(nil)
+	_ resource.ResourceWithIdentity    = (*ResourceFabricItemDefinitionProperties[struct{}, struct{}])(nil)
 )
 
 type ResourceFabricItemDefinitionProperties[Ttfprop, Titemprop any] struct {
@@ -57,10 +58,14 @@ func (r *ResourceFabricItemDefinitionProperties[Ttfprop, Titemprop]) ModifyPlan(
 		"action": "start",
 	})
 
+	if resp.Diagnostics.Append(modifyPlanDefinitionDirectory(ctx, req, resp, r.DefinitionFormats)...); resp.Diagnostics.HasError() {
+		return
+	}
+
 	if !req.State.Raw.IsNull() && !req.Plan.Raw.IsNull() {
 		var plan, state ResourceFabricItemDefinitionPropertiesModel[Ttfprop, Titemprop]
 
-		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
 		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 		if resp.Diagnostics.HasError() {
@@ -100,6 +105,10 @@ func (r *ResourceFabricItemDefinitionProperties[Ttfprop, Titemprop]) Schema(ctx
 	resp.Schema = getResourceFabricItemDefinitionPropertiesSchema(ctx, *r)
 }
 
+func (r *ResourceFabricItemDefinitionProperties[Ttfprop, Titemprop]) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = getItemIdentitySchema()
+}
+
 func (r *ResourceFabricItemDefinitionProperties[Ttfprop, Titemprop]) Configure(
 	_ context.Context,
 	req resource.ConfigureRequest,
@@ -186,6 +195,11 @@ func (r *ResourceFabricItemDefinitionProperties[Ttfprop, Titemprop]) Create(ctx
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
+
+	if r.isDefinitionDriftDetectionEnabled(plan.DefinitionDriftDetectionEnabled, plan.DefinitionUpdateEnabled, plan.Definition) {
+		resp.Diagnostics.Append(r.saveDefinitionBaseline(ctx, resp.Private, plan.WorkspaceID.ValueString(), plan.ID.ValueString(), plan.Format)...)
+	}
 
 	tflog.Debug(ctx, "CREATE", map[string]any{
 		"action": "end",
@@ -228,7 +242,14 @@ func (r *ResourceFabricItemDefinitionProperties[Ttfprop, Titemprop]) Read(ctx co
 		return
 	}
 
+	if r.isDefinitionDriftDetectionEnabled(state.DefinitionDriftDetectionEnabled, state.DefinitionUpdateEnabled, state.Definition) {
+		if resp.Diagnostics.Append(r.checkDefinitionDrift(ctx, resp.Private, state.WorkspaceID.ValueString(), state.ID.ValueString(), state.Format, &state.Definition)...); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "READ", map[string]any{
 		"action": "end",
@@ -320,6 +341,11 @@ func (r *ResourceFabricItemDefinitionProperties[Ttfprop, Titemprop]) Update(ctx
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
+
+	if r.isDefinitionDriftDetectionEnabled(plan.DefinitionDriftDetectionEnabled, plan.DefinitionUpdateEnabled, plan.Definition) {
+		resp.Diagnostics.Append(r.saveDefinitionBaseline(ctx, resp.Private, plan.WorkspaceID.ValueString(), plan.ID.ValueString(), plan.Format)...)
+	}
 
 	tflog.Debug(ctx, "UPDATE", map[string]any{
 		"action": "end",
@@ -371,7 +397,12 @@ func (r *ResourceFabricItemDefinitionProperties[Ttfprop, Titemprop]) ImportState
 		"id": req.ID,
 	})
 
-	workspaceID, fabricItemID, found := strings.Cut(req.ID, "/")
+	importID, diags := utils.GetImportID(ctx, req, "workspace_id", "id")
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID, fabricItemID, found := strings.Cut(importID, "/")
 	if !found {
 		resp.Diagnostics.AddError(
 			common.ErrorImportIdentifierHeader,
@@ -404,19 +435,31 @@ func (r *ResourceFabricItemDefinitionProperties[Ttfprop, Titemprop]) ImportState
 		return
 	}
 
+	var definitionDriftDetectionEnabled types.Bool
+	if resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("definition_drift_detection_enabled"), &definitionDriftDetectionEnabled)...); resp.Diagnostics.HasError() {
+		return
+	}
+
 	var definition supertypes.MapNestedObjectValueOf[resourceFabricItemDefinitionPartModel]
 	if resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("definition"), &definition)...); resp.Diagnostics.HasError() {
 		return
 	}
 
+	var definitionDirectory supertypes.SingleNestedObjectValueOf[resourceFabricItemDefinitionDirectoryModel]
+	if resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("definition_directory"), &definitionDirectory)...); resp.Diagnostics.HasError() {
+		return
+	}
+
 	state := ResourceFabricItemDefinitionPropertiesModel[Ttfprop, Titemprop]{
 		FabricItemPropertiesModel: FabricItemPropertiesModel[Ttfprop, Titemprop]{
 			ID:          uuidFabricItemID,
 			WorkspaceID: uuidWorkspaceID,
 		},
-		DefinitionUpdateEnabled: definitionUpdateEnabled,
-		Definition:              definition,
-		Timeouts:                timeout,
+		DefinitionUpdateEnabled:         definitionUpdateEnabled,
+		DefinitionDriftDetectionEnabled: definitionDriftDetectionEnabled,
+		Definition:                      definition,
+		DefinitionDirectory:             definitionDirectory,
+		Timeouts:                        timeout,
 	}
 
 	if resp.Diagnostics.Append(r.get(ctx, &state)...); resp.Diagnostics.HasError() {
@@ -424,6 +467,7 @@ func (r *ResourceFabricItemDefinitionProperties[Ttfprop, Titemprop]) ImportState
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "IMPORT", map[string]any{
 		"action": "end",
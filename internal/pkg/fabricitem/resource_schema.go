@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"maps"
 	"regexp"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
@@ -103,6 +104,7 @@ func getResourceFabricItemConfigDefinitionPropertiesSchema[Ttfprop, Titemprop, T
 	attrConfiguration.Validators = []validator.Object{
 		objectvalidator.ConflictsWith(
 			path.MatchRoot("definition"),
+			path.MatchRoot("definition_directory"),
 			path.MatchRoot("definition_update_enabled"),
 			path.MatchRoot("format"),
 		),
@@ -245,6 +247,24 @@ func getResourceFabricItemDefinitionAttributes(
 
 	attributes["definition_update_enabled"] = attrDefinitionUpdateEnabled
 
+	attrDefinitionDriftDetectionEnabled := schema.BoolAttribute{}
+
+	attrDefinitionDriftDetectionEnabled.MarkdownDescription = "Detect changes of the definition made outside of Terraform. When enabled, the definition is fetched on read and each part is compared with the content returned by Fabric after the last apply, changed parts are restored by the next apply. " +
+		"If not set, the provider `definition_drift_detection_enabled` setting is used. Requires `definition_update_enabled` to be `true`."
+	attrDefinitionDriftDetectionEnabled.Optional = true
+	attrDefinitionDriftDetectionEnabled.Validators = []validator.Bool{
+		boolvalidator.Any(
+			boolvalidator.AlsoRequires(path.MatchRoot("definition")),
+			boolvalidator.AlsoRequires(path.MatchRoot("definition_directory")),
+		),
+	}
+
+	if alongConfiguration {
+		attrDefinitionDriftDetectionEnabled.Validators = append(attrDefinitionDriftDetectionEnabled.Validators, boolvalidator.ConflictsWith(path.MatchRoot("configuration")))
+	}
+
+	attributes["definition_drift_detection_enabled"] = attrDefinitionDriftDetectionEnabled
+
 	formatTypes := getDefinitionFormats(definitionFormats)
 	definitionFormatsDocs := getDefinitionFormatsPathsDocs(definitionFormats)
 
@@ -254,6 +274,7 @@ func getResourceFabricItemDefinitionAttributes(
 	attrFormat.Validators = []validator.String{
 		stringvalidator.OneOf(utils.ConvertEnumsToStringSlices(formatTypes, true)...),
 		superstringvalidator.RequireIfAttributeIsSet(path.MatchRoot("definition")),
+		superstringvalidator.RequireIfAttributeIsSet(path.MatchRoot("definition_directory")),
 	}
 
 	if definitionRequired {
@@ -271,25 +292,60 @@ func getResourceFabricItemDefinitionAttributes(
 	attrDefinition := schema.MapNestedAttribute{}
 	attrDefinition.MarkdownDescription = fmt.Sprintf("Definition parts. Read more about [%s definition part paths](%s). Accepted path keys: %s", name, definitionPathDocsURL, definitionFormatsDocs)
 	attrDefinition.CustomType = supertypes.NewMapNestedObjectTypeOf[resourceFabricItemDefinitionPartModel](ctx)
-	attrDefinition.Validators = definitionPathKeysValidator
 	attrDefinition.NestedObject = getResourceFabricItemDefinitionPartSchema(ctx)
+	// Computed from the definition_directory when set
+	attrDefinition.Optional = true
+	attrDefinition.Computed = true
+
+	attrDefinitionDirectory := getResourceFabricItemDefinitionDirectorySchema(ctx, name)
+	definitionPathKeysValidator = slices.Clone(definitionPathKeysValidator)
 
 	if definitionRequired {
-		attrDefinition.Required = true
+		definitionPathKeysValidator = append(definitionPathKeysValidator, mapvalidator.ExactlyOneOf(path.MatchRoot("definition_directory")))
 	} else {
-		attrDefinition.Optional = true
+		definitionPathKeysValidator = append(definitionPathKeysValidator, mapvalidator.ConflictsWith(path.MatchRoot("definition_directory")))
 	}
 
 	if alongConfiguration {
 		definitionPathKeysValidator = append(definitionPathKeysValidator, mapvalidator.ConflictsWith(path.MatchRoot("configuration")))
-		attrDefinition.Validators = definitionPathKeysValidator
+		attrDefinitionDirectory.Validators = append(attrDefinitionDirectory.Validators, objectvalidator.ConflictsWith(path.MatchRoot("configuration")))
 	}
 
+	attrDefinition.Validators = definitionPathKeysValidator
+
 	attributes["definition"] = attrDefinition
+	attributes["definition_directory"] = attrDefinitionDirectory
 
 	return attributes
 }
 
+// Helper function to get Fabric Item definition directory attribute.
+func getResourceFabricItemDefinitionDirectorySchema(ctx context.Context, name string) schema.SingleNestedAttribute {
+	attributes := getResourceFabricItemDefinitionPartSchema(ctx).Attributes
+
+	delete(attributes, "source")
+	delete(attributes, "source_content_sha256")
+
+	attributes["path"] = schema.StringAttribute{
+		MarkdownDescription: "Path to the directory with sources of the definition parts.",
+		Required:            true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("Directory with sources of the %s definition parts, e.g. a Git export of the item. ", name) +
+			"Every file in the directory and its subdirectories is a definition part, with the part path relative to the directory (the `.tmpl` extension is trimmed). " +
+			"Files and directories starting with a dot (e.g. `.platform` or `.pbi`) are ignored. " +
+			"The part paths must match the accepted path keys of the `format`, the processing settings apply to all parts. " +
+			"The resulting parts are available in the `definition` attribute.",
+		Optional:   true,
+		CustomType: supertypes.NewSingleNestedObjectTypeOf[resourceFabricItemDefinitionDirectoryModel](ctx),
+		Attributes: attributes,
+	}
+}
+
 // Helper function to get Fabric Item data-source definition part attributes.
 func getResourceFabricItemDefinitionPartSchema(ctx context.Context) schema.NestedAttributeObject {
 	return schema.NestedAttributeObject{
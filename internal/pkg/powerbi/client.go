@@ -0,0 +1,296 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package powerbi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+const moduleName = "terraform-provider-fabric"
+
+// ErrCredentialNotAvailable is returned when the provider has no credential to authenticate against the Power BI REST API.
+var ErrCredentialNotAvailable = errors.New("the provider credential is not available to authenticate against the Power BI REST API")
+
+// ErrRefreshIDNotReturned is returned when the service does not return the ID of a started refresh.
+var ErrRefreshIDNotReturned = errors.New("the refresh ID was not returned by the service")
+
+// ClientOptions are the optional settings of a Client.
+type ClientOptions struct {
+	// ClientOptions are the retry, logging, policies and transport settings of the client, e.g. the ones of the provider.
+	// The cloud is always the one given to NewClient.
+	policy.ClientOptions
+
+	// Endpoint overrides the Power BI REST API endpoint of the cloud.
+	Endpoint string
+}
+
+// Client performs the Power BI REST API operations on semantic models (datasets) not exposed by the Fabric API:
+// refresh schedule, on-demand refreshes and item permissions.
+type Client struct {
+	endpoint string
+	pipeline runtime.Pipeline
+}
+
+// NewClient creates a Client authenticated with the given credential against the Power BI REST API endpoint of the given cloud.
+func NewClient(cred azcore.TokenCredential, cloudCfg cloud.Configuration, version string, options *ClientOptions) (*Client, error) {
+	if cred == nil {
+		return nil, ErrCredentialNotAvailable
+	}
+
+	if options == nil {
+		options = &ClientOptions{}
+	}
+
+	endpoint, scope := getEndpoint(cloudCfg)
+	if options.Endpoint != "" {
+		endpoint = options.Endpoint
+	}
+
+	clientOptions := options.ClientOptions
+	clientOptions.Cloud = cloudCfg
+
+	pipeline := runtime.NewPipeline(
+		moduleName,
+		version,
+		runtime.PipelineOptions{
+			PerRetry: []policy.Policy{
+				runtime.NewBearerTokenPolicy(cred, []string{scope}, nil),
+			},
+		},
+		&clientOptions,
+	)
+
+	return &Client{
+		endpoint: strings.TrimSuffix(endpoint, "/") + "/v1.0/myorg",
+		pipeline: pipeline,
+	}, nil
+}
+
+// GetRefreshSchedule returns the refresh schedule of the semantic model.
+func (c *Client) GetRefreshSchedule(ctx context.Context, workspaceID, semanticModelID string) (*RefreshSchedule, error) {
+	resp, err := c.do(ctx, http.MethodGet, datasetPath(workspaceID, semanticModelID, "refreshSchedule"), nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedule RefreshSchedule
+	if err := runtime.UnmarshalAsJSON(resp, &schedule); err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+// UpdateRefreshSchedule updates the refresh schedule of the semantic model.
+func (c *Client) UpdateRefreshSchedule(ctx context.Context, workspaceID, semanticModelID string, schedule RefreshSchedule) error {
+	body := struct {
+		Value RefreshSchedule `json:"value"`
+	}{
+		Value: schedule,
+	}
+
+	_, err := c.do(ctx, http.MethodPatch, datasetPath(workspaceID, semanticModelID, "refreshSchedule"), body, http.StatusOK)
+
+	return err
+}
+
+// Refresh starts an enhanced refresh of the semantic model and returns the refresh ID.
+func (c *Client) Refresh(ctx context.Context, workspaceID, semanticModelID string, request RefreshRequest) (string, error) {
+	resp, err := c.do(ctx, http.MethodPost, datasetPath(workspaceID, semanticModelID, "refreshes"), request, http.StatusAccepted)
+	if err != nil {
+		return "", err
+	}
+
+	// the refresh ID is the last segment of the Location header, the request ID of the response is not the refresh ID
+	location := strings.TrimSuffix(resp.Header.Get("Location"), "/")
+	if location == "" {
+		return "", ErrRefreshIDNotReturned
+	}
+
+	return location[strings.LastIndex(location, "/")+1:], nil
+}
+
+// GetRefresh returns the execution details of a refresh of the semantic model.
+func (c *Client) GetRefresh(ctx context.Context, workspaceID, semanticModelID, refreshID string) (*RefreshExecutionDetails, error) {
+	resp, err := c.do(ctx, http.MethodGet, datasetPath(workspaceID, semanticModelID, "refreshes", refreshID), nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	var details RefreshExecutionDetails
+	if err := runtime.UnmarshalAsJSON(resp, &details); err != nil {
+		return nil, err
+	}
+
+	return &details, nil
+}
+
+// WaitForRefresh polls a refresh of the semantic model until it reaches a final status.
+func (c *Client) WaitForRefresh(ctx context.Context, workspaceID, semanticModelID, refreshID string) (*RefreshExecutionDetails, error) {
+	for {
+		details, err := c.GetRefresh(ctx, workspaceID, semanticModelID, refreshID)
+		if err != nil {
+			return nil, err
+		}
+
+		if details.Status != nil && slices.Contains(FinalRefreshStatuses, *details.Status) {
+			return details, nil
+		}
+
+		if err := utils.WaitForNextPoll(ctx); err != nil {
+			return details, err
+		}
+	}
+}
+
+// ListDatasetUsers returns the principals with permissions on the semantic model.
+func (c *Client) ListDatasetUsers(ctx context.Context, workspaceID, semanticModelID string) ([]DatasetUser, error) {
+	resp, err := c.do(ctx, http.MethodGet, datasetPath(workspaceID, semanticModelID, "users"), nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	var body struct {
+		Value []DatasetUser `json:"value"`
+	}
+
+	if err := runtime.UnmarshalAsJSON(resp, &body); err != nil {
+		return nil, err
+	}
+
+	return body.Value, nil
+}
+
+// AddDatasetUser grants the permission to a principal on the semantic model.
+func (c *Client) AddDatasetUser(ctx context.Context, workspaceID, semanticModelID string, user DatasetUser) error {
+	_, err := c.do(ctx, http.MethodPost, datasetPath(workspaceID, semanticModelID, "users"), user, http.StatusOK)
+
+	return err
+}
+
+// UpdateDatasetUser replaces the permission of a principal on the semantic model.
+// The DatasetUserAccessRightNone access right revokes the permission.
+func (c *Client) UpdateDatasetUser(ctx context.Context, workspaceID, semanticModelID string, user DatasetUser) error {
+	_, err := c.do(ctx, http.MethodPut, datasetPath(workspaceID, semanticModelID, "users"), user, http.StatusOK)
+
+	return err
+}
+
+func (c *Client) do(ctx context.Context, method, urlPath string, body any, statusCodes ...int) (*http.Response, error) {
+	req, err := runtime.NewRequest(ctx, method, runtime.JoinPaths(c.endpoint, urlPath))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Raw().Header["Accept"] = []string{"application/json"}
+
+	if body != nil {
+		if err := runtime.MarshalAsJSON(req, body); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.pipeline.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !runtime.HasStatusCode(resp, statusCodes...) {
+		return nil, newResponseError(resp)
+	}
+
+	return resp, nil
+}
+
+// ResponseError is an error returned by the Power BI REST API.
+type ResponseError struct {
+	StatusCode int
+	ErrorCode  string
+	Message    string
+}
+
+func (e *ResponseError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("Power BI REST API request failed with status code %d: %s", e.StatusCode, e.ErrorCode)
+	}
+
+	return fmt.Sprintf("Power BI REST API request failed with status code %d: %s: %s", e.StatusCode, e.ErrorCode, e.Message)
+}
+
+// IsNotFoundError reports whether the error is a Power BI REST API response for an object that does not exist.
+func IsNotFoundError(err error) bool {
+	var errResp *ResponseError
+
+	return errors.As(err, &errResp) && errResp.StatusCode == http.StatusNotFound
+}
+
+func newResponseError(resp *http.Response) error {
+	result := &ResponseError{
+		StatusCode: resp.StatusCode,
+		ErrorCode:  http.StatusText(resp.StatusCode),
+	}
+
+	payload, err := io.ReadAll(resp.Body)
+	if err != nil || len(payload) == 0 {
+		return result
+	}
+
+	var body struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+
+	if err := json.Unmarshal(payload, &body); err != nil {
+		result.Message = string(payload)
+
+		return result
+	}
+
+	if body.Error.Code != "" {
+		result.ErrorCode = body.Error.Code
+	}
+
+	result.Message = body.Error.Message
+
+	return result
+}
+
+func datasetPath(workspaceID, semanticModelID string, segments ...string) string {
+	parts := []string{"groups", url.PathEscape(workspaceID), "datasets", url.PathEscape(semanticModelID)}
+
+	for _, segment := range segments {
+		parts = append(parts, url.PathEscape(segment))
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// getEndpoint returns the Power BI REST API endpoint and token scope of the cloud.
+func getEndpoint(cloudCfg cloud.Configuration) (string, string) { //revive:disable-line:confusing-results
+	switch cloudCfg.ActiveDirectoryAuthorityHost {
+	case cloud.AzureGovernment.ActiveDirectoryAuthorityHost:
+		return "https://api.powerbigov.us", "https://analysis.usgovcloudapi.net/powerbi/api/.default"
+	case cloud.AzureChina.ActiveDirectoryAuthorityHost:
+		return "https://api.powerbi.cn", "https://analysis.chinacloudapi.cn/powerbi/api/.default"
+	default:
+		return "https://api.powerbi.com", "https://analysis.windows.net/powerbi/api/.default"
+	}
+}
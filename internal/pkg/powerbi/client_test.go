@@ -0,0 +1,242 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package powerbi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/powerbi"
+)
+
+type fakeCredential struct{}
+
+func (fakeCredential) GetToken(_ context.Context, _ policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *powerbi.Client {
+	t.Helper()
+
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := powerbi.NewClient(fakeCredential{}, cloud.AzurePublic, "test", &powerbi.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: server.Client(),
+		},
+		Endpoint: server.URL,
+	})
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestUnit_NewClient(t *testing.T) {
+	t.Parallel()
+
+	_, err := powerbi.NewClient(nil, cloud.AzurePublic, "test", nil)
+	require.ErrorIs(t, err, powerbi.ErrCredentialNotAvailable)
+}
+
+func TestUnit_RefreshSchedule(t *testing.T) {
+	t.Parallel()
+
+	var received map[string]powerbi.RefreshSchedule
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Equal(t, "/v1.0/myorg/groups/ws/datasets/sm/refreshSchedule", r.URL.Path)
+
+		switch r.Method {
+		case http.MethodPatch:
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusOK)
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"days":["Monday"],"times":["07:30"],"enabled":true,"localTimeZoneId":"UTC","notifyOption":"NoNotification"}`))
+		}
+	})
+
+	err := client.UpdateRefreshSchedule(t.Context(), "ws", "sm", powerbi.RefreshSchedule{
+		Days:    []string{"Monday"},
+		Times:   []string{"07:30"},
+		Enabled: new(true),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Monday"}, received["value"].Days)
+
+	schedule, err := client.GetRefreshSchedule(t.Context(), "ws", "sm")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"07:30"}, schedule.Times)
+	assert.True(t, *schedule.Enabled)
+	assert.Equal(t, powerbi.NotifyOptionNoNotification, *schedule.NotifyOption)
+}
+
+func TestUnit_Refresh(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			assert.Equal(t, "/v1.0/myorg/groups/ws/datasets/sm/refreshes", r.URL.Path)
+			w.Header().Set("Location", "https://api.powerbi.com/v1.0/myorg/groups/ws/datasets/sm/refreshes/rid")
+			w.WriteHeader(http.StatusAccepted)
+		case http.MethodGet:
+			assert.Equal(t, "/v1.0/myorg/groups/ws/datasets/sm/refreshes/rid", r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"Failed","extendedStatus":"Failed","messages":[{"code":"ModelRefresh_ShortMessage_ProcessingError","message":"Login failed","type":"Error"}]}`))
+		}
+	})
+
+	refreshID, err := client.Refresh(t.Context(), "ws", "sm", powerbi.RefreshRequest{Type: new(powerbi.RefreshTypeFull)})
+	require.NoError(t, err)
+	assert.Equal(t, "rid", refreshID)
+
+	details, err := client.WaitForRefresh(t.Context(), "ws", "sm", refreshID)
+	require.NoError(t, err)
+	assert.Equal(t, powerbi.RefreshStatusFailed, *details.Status)
+	require.Len(t, details.Messages, 1)
+	assert.Equal(t, "Login failed", *details.Messages[0].Message)
+}
+
+func TestUnit_Refresh_IDNotReturned(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		// the request ID identifies the request, not the refresh
+		w.Header().Set("RequestId", "request-id")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, err := client.Refresh(t.Context(), "ws", "sm", powerbi.RefreshRequest{Type: new(powerbi.RefreshTypeFull)})
+	require.ErrorIs(t, err, powerbi.ErrRefreshIDNotReturned)
+}
+
+func TestUnit_NewClient_Policies(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "test-agent", r.Header.Get("User-Agent"))
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(server.Close)
+
+	var perRetryCalls int
+
+	client, err := powerbi.NewClient(fakeCredential{}, cloud.AzurePublic, "test", &powerbi.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: server.Client(),
+			Retry: policy.RetryOptions{
+				MaxRetries:    1,
+				RetryDelay:    time.Millisecond,
+				MaxRetryDelay: time.Millisecond,
+			},
+			PerCallPolicies:  []policy.Policy{headerPolicy{name: "User-Agent", value: "test-agent"}},
+			PerRetryPolicies: []policy.Policy{countPolicy{count: &perRetryCalls}},
+		},
+		Endpoint: server.URL,
+	})
+	require.NoError(t, err)
+
+	_, err = client.GetRefreshSchedule(t.Context(), "ws", "sm")
+	require.Error(t, err)
+	assert.Equal(t, 2, perRetryCalls)
+}
+
+type headerPolicy struct {
+	name  string
+	value string
+}
+
+func (p headerPolicy) Do(req *policy.Request) (*http.Response, error) {
+	req.Raw().Header.Set(p.name, p.value)
+
+	return req.Next()
+}
+
+type countPolicy struct {
+	count *int
+}
+
+func (p countPolicy) Do(req *policy.Request) (*http.Response, error) {
+	*p.count++
+
+	return req.Next()
+}
+
+func TestUnit_DatasetUsers(t *testing.T) {
+	t.Parallel()
+
+	var received []powerbi.DatasetUser
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1.0/myorg/groups/ws/datasets/sm/users", r.URL.Path)
+
+		switch r.Method {
+		case http.MethodPost, http.MethodPut:
+			var user powerbi.DatasetUser
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&user))
+
+			received = append(received, user)
+
+			w.WriteHeader(http.StatusOK)
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"value":[{"datasetUserAccessRight":"ReadExplore","identifier":"00000000-0000-0000-0000-000000000001","principalType":"Group","displayName":"Consumers"}]}`))
+		}
+	})
+
+	err := client.AddDatasetUser(t.Context(), "ws", "sm", powerbi.DatasetUser{
+		Identifier:             new("00000000-0000-0000-0000-000000000001"),
+		PrincipalType:          new(powerbi.PrincipalTypeGroup),
+		DatasetUserAccessRight: new(powerbi.DatasetUserAccessRightReadExplore),
+	})
+	require.NoError(t, err)
+
+	err = client.UpdateDatasetUser(t.Context(), "ws", "sm", powerbi.DatasetUser{
+		Identifier:             new("00000000-0000-0000-0000-000000000001"),
+		PrincipalType:          new(powerbi.PrincipalTypeGroup),
+		DatasetUserAccessRight: new(powerbi.DatasetUserAccessRightNone),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, received, 2)
+	assert.Equal(t, powerbi.DatasetUserAccessRightReadExplore, *received[0].DatasetUserAccessRight)
+	assert.Equal(t, powerbi.DatasetUserAccessRightNone, *received[1].DatasetUserAccessRight)
+
+	users, err := client.ListDatasetUsers(t.Context(), "ws", "sm")
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, powerbi.PrincipalTypeGroup, *users[0].PrincipalType)
+	assert.Equal(t, "Consumers", *users[0].DisplayName)
+}
+
+func TestUnit_ResponseError(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":{"code":"InvalidRequest","message":"Invalid time zone"}}`))
+	})
+
+	_, err := client.GetRefreshSchedule(t.Context(), "ws", "sm")
+
+	var errResp *powerbi.ResponseError
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, http.StatusBadRequest, errResp.StatusCode)
+	assert.Equal(t, "InvalidRequest", errResp.ErrorCode)
+	assert.Equal(t, "Invalid time zone", errResp.Message)
+}
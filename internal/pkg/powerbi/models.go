@@ -0,0 +1,141 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package powerbi
+
+import "time"
+
+// NotifyOption is the notification option of a scheduled refresh.
+type NotifyOption string
+
+const (
+	NotifyOptionMailOnFailure  NotifyOption = "MailOnFailure"
+	NotifyOptionNoNotification NotifyOption = "NoNotification"
+)
+
+// PossibleNotifyOptionValues returns the possible values of NotifyOption.
+func PossibleNotifyOptionValues() []NotifyOption {
+	return []NotifyOption{NotifyOptionMailOnFailure, NotifyOptionNoNotification}
+}
+
+// RefreshType is the type of processing of an enhanced refresh.
+type RefreshType string
+
+const (
+	RefreshTypeAutomatic   RefreshType = "Automatic"
+	RefreshTypeCalculate   RefreshType = "Calculate"
+	RefreshTypeClearValues RefreshType = "ClearValues"
+	RefreshTypeDataOnly    RefreshType = "DataOnly"
+	RefreshTypeDefragment  RefreshType = "Defragment"
+	RefreshTypeFull        RefreshType = "Full"
+)
+
+// PossibleRefreshTypeValues returns the possible values of RefreshType.
+func PossibleRefreshTypeValues() []RefreshType {
+	return []RefreshType{
+		RefreshTypeAutomatic,
+		RefreshTypeCalculate,
+		RefreshTypeClearValues,
+		RefreshTypeDataOnly,
+		RefreshTypeDefragment,
+		RefreshTypeFull,
+	}
+}
+
+// RefreshStatus is the status of a refresh.
+type RefreshStatus string
+
+const (
+	RefreshStatusCancelled  RefreshStatus = "Cancelled"
+	RefreshStatusCompleted  RefreshStatus = "Completed"
+	RefreshStatusDisabled   RefreshStatus = "Disabled"
+	RefreshStatusFailed     RefreshStatus = "Failed"
+	RefreshStatusNotStarted RefreshStatus = "NotStarted"
+	RefreshStatusTimedOut   RefreshStatus = "TimedOut"
+	RefreshStatusUnknown    RefreshStatus = "Unknown"
+)
+
+// FinalRefreshStatuses are the statuses of a refresh that is no longer running.
+var FinalRefreshStatuses = []RefreshStatus{ //nolint:gochecknoglobals
+	RefreshStatusCancelled,
+	RefreshStatusCompleted,
+	RefreshStatusDisabled,
+	RefreshStatusFailed,
+	RefreshStatusTimedOut,
+}
+
+// Weekdays are the days of a refresh schedule.
+var Weekdays = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"} //nolint:gochecknoglobals
+
+// RefreshSchedule is the refresh schedule of a semantic model.
+type RefreshSchedule struct {
+	Days            []string      `json:"days,omitempty"`
+	Times           []string      `json:"times,omitempty"`
+	Enabled         *bool         `json:"enabled,omitempty"`
+	LocalTimeZoneID *string       `json:"localTimeZoneId,omitempty"`
+	NotifyOption    *NotifyOption `json:"notifyOption,omitempty"`
+}
+
+// RefreshRequest is the body of an enhanced refresh request.
+type RefreshRequest struct {
+	Type       *RefreshType `json:"type,omitempty"`
+	RetryCount *int32       `json:"retryCount,omitempty"`
+}
+
+// RefreshExecutionDetails are the execution details of an enhanced refresh.
+type RefreshExecutionDetails struct {
+	Status         *RefreshStatus   `json:"status,omitempty"`
+	ExtendedStatus *string          `json:"extendedStatus,omitempty"`
+	Type           *string          `json:"type,omitempty"`
+	StartTime      *time.Time       `json:"startTime,omitempty"`
+	EndTime        *time.Time       `json:"endTime,omitempty"`
+	Messages       []RefreshMessage `json:"messages,omitempty"`
+}
+
+// RefreshMessage is a diagnostic message reported by a refresh.
+type RefreshMessage struct {
+	Code    *string `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+	Type    *string `json:"type,omitempty"`
+}
+
+// DatasetUserAccessRight is the permission of a principal on a semantic model.
+type DatasetUserAccessRight string
+
+const (
+	DatasetUserAccessRightNone               DatasetUserAccessRight = "None"
+	DatasetUserAccessRightRead               DatasetUserAccessRight = "Read"
+	DatasetUserAccessRightReadExplore        DatasetUserAccessRight = "ReadExplore"
+	DatasetUserAccessRightReadReshare        DatasetUserAccessRight = "ReadReshare"
+	DatasetUserAccessRightReadReshareExplore DatasetUserAccessRight = "ReadReshareExplore"
+)
+
+// PossibleDatasetUserAccessRightValues returns the possible values of DatasetUserAccessRight that grant access.
+func PossibleDatasetUserAccessRightValues() []DatasetUserAccessRight {
+	return []DatasetUserAccessRight{
+		DatasetUserAccessRightRead,
+		DatasetUserAccessRightReadExplore,
+		DatasetUserAccessRightReadReshare,
+		DatasetUserAccessRightReadReshareExplore,
+	}
+}
+
+// PrincipalType is the type of a principal of the Power BI REST API.
+type PrincipalType string
+
+const (
+	PrincipalTypeApp   PrincipalType = "App"
+	PrincipalTypeGroup PrincipalType = "Group"
+	PrincipalTypeNone  PrincipalType = "None"
+	PrincipalTypeUser  PrincipalType = "User"
+)
+
+// DatasetUser is the permission of a principal on a semantic model.
+type DatasetUser struct {
+	DatasetUserAccessRight *DatasetUserAccessRight `json:"datasetUserAccessRight,omitempty"`
+	Identifier             *string                 `json:"identifier,omitempty"`
+	PrincipalType          *PrincipalType          `json:"principalType,omitempty"`
+	DisplayName            *string                 `json:"displayName,omitempty"`
+	EmailAddress           *string                 `json:"emailAddress,omitempty"`
+	GraphID                *string                 `json:"graphId,omitempty"`
+}
@@ -0,0 +1,564 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package roleassignments
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	timeoutsR "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts" //revive:disable-line:import-alias-naming
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema" //revive:disable-line:import-alias-naming
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/fabric-sdk-go/fabric"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.ResourceWithConfigure      = (*ResourceRoleAssignments)(nil)
+	_ resource.ResourceWithValidateConfig = (*ResourceRoleAssignments)(nil)
+	_ resource.ResourceWithImportState    = (*ResourceRoleAssignments)(nil)
+	_ resource.ResourceWithIdentity       = (*ResourceRoleAssignments)(nil)
+)
+
+// RoleAssignmentsResourceConfig describes the Fabric object type managed by an authoritative role assignments resource.
+type RoleAssignmentsResourceConfig struct {
+	TypeInfo            tftypeinfo.TFTypeInfo
+	MarkdownDescription string
+	// ObjectName is the display name of the object type holding the role assignments, e.g. "Workspace".
+	ObjectName string
+	// ObjectIDAttribute is the name of the attribute holding the object ID, e.g. "workspace_id".
+	ObjectIDAttribute string
+	PrincipalTypes    []string
+	Roles             []string
+	NewClient         func(client *fabric.Client) RoleAssignmentsClient
+}
+
+// ResourceRoleAssignments manages the complete set of role assignments of a Fabric object.
+type ResourceRoleAssignments struct {
+	pConfigData *pconfig.ProviderData
+	client      RoleAssignmentsClient
+	Config      RoleAssignmentsResourceConfig
+}
+
+func NewResourceRoleAssignments(config RoleAssignmentsResourceConfig) resource.Resource {
+	return &ResourceRoleAssignments{
+		Config: config,
+	}
+}
+
+type roleAssignmentModel struct {
+	Principal supertypes.SingleNestedObjectValueOf[common.PrincipalModel] `tfsdk:"principal"`
+	Role      types.String                                                `tfsdk:"role"`
+}
+
+// roleAssignmentsModel holds the attributes of the resource.
+// The object ID attribute name depends on the object type, so the model is read and written attribute by attribute.
+type roleAssignmentsModel struct {
+	ObjectID            customtypes.UUID
+	Assignments         supertypes.SetNestedObjectValueOf[roleAssignmentModel]
+	IgnoredPrincipalIDs supertypes.SetValueOf[customtypes.UUID]
+	Timeouts            timeoutsR.Value
+}
+
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target any) diag.Diagnostics
+}
+
+func (r *ResourceRoleAssignments) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.Config.TypeInfo.FullTypeName(true)
+}
+
+func (r *ResourceRoleAssignments) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = r.schema().GetResource(ctx)
+}
+
+func (r *ResourceRoleAssignments) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			r.Config.ObjectIDAttribute: identityschema.StringAttribute{
+				Description:       fmt.Sprintf("The %s ID.", r.Config.ObjectName),
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+		},
+	}
+}
+
+func (r *ResourceRoleAssignments) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config roleAssignmentsModel
+
+	if resp.Diagnostics.Append(r.get(ctx, req.Config, &config)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Assignments.IsNull() || config.Assignments.IsUnknown() || config.IgnoredPrincipalIDs.IsUnknown() {
+		return
+	}
+
+	assignments, diags := config.getAssignments(ctx)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ignoredPrincipalIDs, diags := config.getIgnoredPrincipalIDs(ctx)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(ValidateRoleAssignments(assignments, ignoredPrincipalIDs)...)
+}
+
+func (r *ResourceRoleAssignments) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorResourceConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	r.pConfigData = pConfigData
+	r.client = r.Config.NewClient(pConfigData.FabricClient)
+}
+
+func (r *ResourceRoleAssignments) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan roleAssignmentsModel
+
+	if resp.Diagnostics.Append(r.get(ctx, req.Plan, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if resp.Diagnostics.Append(r.apply(ctx, &plan, utils.OperationCreate)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.Raw = req.Plan.Raw
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("assignments"), plan.Assignments)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
+
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ResourceRoleAssignments) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "start",
+	})
+
+	var state roleAssignmentsModel
+
+	if resp.Diagnostics.Append(r.get(ctx, req.State, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	diags = r.list(ctx, &state, r.callerPrincipalID(ctx))
+	if utils.IsErrNotFound(state.ObjectID.ValueString(), &diags, fabcore.ErrCommon.EntityNotFound) {
+		resp.State.RemoveResource(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		return
+	}
+
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("assignments"), state.Assignments)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
+
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ResourceRoleAssignments) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan roleAssignmentsModel
+
+	if resp.Diagnostics.Append(r.get(ctx, req.Plan, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if resp.Diagnostics.Append(r.apply(ctx, &plan, utils.OperationUpdate)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.Raw = req.Plan.Raw
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("assignments"), plan.Assignments)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
+
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ResourceRoleAssignments) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "start",
+	})
+
+	var state roleAssignmentsModel
+
+	if resp.Diagnostics.Append(r.get(ctx, req.State, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	declared, diags := state.getAssignments(ctx)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(DeleteRoleAssignments(ctx, r.client, state.ObjectID.ValueString(), declared, r.callerPrincipalID(ctx))...)
+
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "end",
+	})
+}
+
+func (r *ResourceRoleAssignments) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "IMPORT", map[string]any{
+		"action": "start",
+	})
+
+	importID, diags := utils.GetImportID(ctx, req, r.Config.ObjectIDAttribute)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "IMPORT", map[string]any{
+		"id": importID,
+	})
+
+	objectID, diags := customtypes.NewUUIDValueMust(importID)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.Config.ObjectIDAttribute), objectID)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
+
+	tflog.Debug(ctx, "IMPORT", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ResourceRoleAssignments) get(ctx context.Context, from attributeGetter, to *roleAssignmentsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(from.GetAttribute(ctx, path.Root(r.Config.ObjectIDAttribute), &to.ObjectID)...)
+	diags.Append(from.GetAttribute(ctx, path.Root("assignments"), &to.Assignments)...)
+	diags.Append(from.GetAttribute(ctx, path.Root("ignored_principal_ids"), &to.IgnoredPrincipalIDs)...)
+	diags.Append(from.GetAttribute(ctx, path.Root("timeouts"), &to.Timeouts)...)
+
+	return diags
+}
+
+func (r *ResourceRoleAssignments) apply(ctx context.Context, model *roleAssignmentsModel, operation utils.Operation) diag.Diagnostics {
+	desired, diags := model.getAssignments(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	ignoredPrincipalIDs, diags := model.getIgnoredPrincipalIDs(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	callerPrincipalID := r.callerPrincipalID(ctx)
+
+	diags = ApplyRoleAssignments(ctx, r.client, model.ObjectID.ValueString(), desired, ignoredPrincipalIDs, callerPrincipalID, operation)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(r.list(ctx, model, callerPrincipalID)...)
+
+	return diags
+}
+
+// list reads the role assignments of the object into the model.
+// The assignment of the caller is left out when it is not declared, as it is kept by apply and destroy.
+func (r *ResourceRoleAssignments) list(ctx context.Context, model *roleAssignmentsModel, callerPrincipalID string) diag.Diagnostics {
+	tflog.Trace(ctx, "getting Role Assignments")
+
+	current, err := r.client.List(ctx, model.ObjectID.ValueString())
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationList, fabcore.ErrCommon.EntityNotFound); diags.HasError() {
+		return diags
+	}
+
+	ignoredPrincipalIDs, diags := model.getIgnoredPrincipalIDs(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	declared, diags := model.getAssignments(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	if callerPrincipalID != "" && !containsPrincipal(declared, callerPrincipalID) {
+		ignoredPrincipalIDs = append(ignoredPrincipalIDs, callerPrincipalID)
+	}
+
+	return model.setAssignments(ctx, FilterRoleAssignments(current, ignoredPrincipalIDs))
+}
+
+// callerPrincipalID returns the principal ID of the identity running Terraform, or an empty string when it cannot be determined.
+func (r *ResourceRoleAssignments) callerPrincipalID(ctx context.Context) string {
+	principalID, err := GetCallerPrincipalID(ctx, r.pConfigData.Credential, r.pConfigData.Cloud)
+	if err != nil {
+		tflog.Debug(ctx, "cannot identify the principal running Terraform, its role assignment is not protected", map[string]any{
+			"error": err.Error(),
+		})
+
+		return ""
+	}
+
+	return principalID
+}
+
+func (to *roleAssignmentsModel) setAssignments(ctx context.Context, from []RoleAssignment) diag.Diagnostics {
+	slice := make([]*roleAssignmentModel, 0, len(from))
+
+	for _, entity := range from {
+		principal := supertypes.NewSingleNestedObjectValueOfNull[common.PrincipalModel](ctx)
+
+		if diags := principal.Set(ctx, &common.PrincipalModel{
+			ID:   customtypes.NewUUIDValue(entity.PrincipalID),
+			Type: types.StringValue(entity.PrincipalType),
+		}); diags.HasError() {
+			return diags
+		}
+
+		slice = append(slice, &roleAssignmentModel{
+			Principal: principal,
+			Role:      types.StringValue(entity.Role),
+		})
+	}
+
+	assignments := supertypes.NewSetNestedObjectValueOfNull[roleAssignmentModel](ctx)
+
+	if diags := assignments.Set(ctx, slice); diags.HasError() {
+		return diags
+	}
+
+	to.Assignments = assignments
+
+	return nil
+}
+
+func (to *roleAssignmentsModel) getAssignments(ctx context.Context) ([]RoleAssignment, diag.Diagnostics) {
+	assignments, diags := to.Assignments.Get(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result := make([]RoleAssignment, 0, len(assignments))
+
+	for _, assignment := range assignments {
+		principal, diags := assignment.Principal.Get(ctx)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = append(result, RoleAssignment{
+			PrincipalID:   principal.ID.ValueString(),
+			PrincipalType: principal.Type.ValueString(),
+			Role:          assignment.Role.ValueString(),
+		})
+	}
+
+	return result, nil
+}
+
+func (to *roleAssignmentsModel) getIgnoredPrincipalIDs(ctx context.Context) ([]string, diag.Diagnostics) {
+	if to.IgnoredPrincipalIDs.IsNull() || to.IgnoredPrincipalIDs.IsUnknown() {
+		return nil, nil
+	}
+
+	elements, diags := to.IgnoredPrincipalIDs.Get(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result := make([]string, 0, len(elements))
+
+	for _, element := range elements {
+		result = append(result, element.ValueString())
+	}
+
+	return result, nil
+}
+
+func (r *ResourceRoleAssignments) schema() superschema.Schema {
+	markdownDescription := r.Config.MarkdownDescription +
+		"\n\n-> The declared assignments are authoritative: any role assignment on the " + r.Config.ObjectName + " that is not declared is removed, " +
+		"unless its principal is listed in `ignored_principal_ids`. " +
+		"The role assignment of the identity running Terraform is never removed, so that it keeps the access required to manage the others. " +
+		"When it is not declared, it is left out of `assignments`."
+
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: markdownDescription,
+		},
+		Attributes: map[string]superschema.Attribute{
+			r.Config.ObjectIDAttribute: superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: fmt.Sprintf("The %s ID.", r.Config.ObjectName),
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"assignments": superschema.SuperSetNestedAttributeOf[roleAssignmentModel]{
+				Resource: &schemaR.SetNestedAttribute{
+					MarkdownDescription: fmt.Sprintf("The complete set of role assignments of the %s.", r.Config.ObjectName),
+					Required:            true,
+				},
+				Attributes: superschema.Attributes{
+					"principal": superschema.SuperSingleNestedAttributeOf[common.PrincipalModel]{
+						Resource: &schemaR.SingleNestedAttribute{
+							MarkdownDescription: "The principal.",
+							Required:            true,
+						},
+						Attributes: map[string]superschema.Attribute{
+							"id": superschema.SuperStringAttribute{
+								Resource: &schemaR.StringAttribute{
+									MarkdownDescription: "The principal ID.",
+									CustomType:          customtypes.UUIDType{},
+									Required:            true,
+								},
+							},
+							"type": superschema.StringAttribute{
+								Resource: &schemaR.StringAttribute{
+									MarkdownDescription: "The type of the principal.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(r.Config.PrincipalTypes...),
+									},
+								},
+							},
+						},
+					},
+					"role": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("The %s role of the principal.", strings.ToLower(r.Config.ObjectName)),
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(r.Config.Roles...),
+							},
+						},
+					},
+				},
+			},
+			"ignored_principal_ids": superschema.SuperSetAttribute{
+				Resource: &schemaR.SetAttribute{
+					MarkdownDescription: "The set of principal IDs whose role assignments are neither read nor changed, such as break-glass administrators.",
+					CustomType: supertypes.SetTypeOf[customtypes.UUID]{
+						SetType: basetypes.SetType{
+							ElemType: customtypes.UUIDType{},
+						},
+					},
+					ElementType: customtypes.UUIDType{},
+					Optional:    true,
+				},
+			},
+			"timeouts": superschema.TimeoutAttribute{
+				Resource: &superschema.ResourceTimeoutAttribute{
+					Create: true,
+					Read:   true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,331 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package roleassignments
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+var errCallerNotAvailable = errors.New("the principal running Terraform cannot be identified from the provider credential")
+
+// RoleAssignment is a role granted to a principal on a Fabric object, independent of the object type.
+type RoleAssignment struct {
+	ID            string
+	PrincipalID   string
+	PrincipalType string
+	Role          string
+}
+
+// RoleAssignmentsDiff holds the changes required to turn the current role assignments into the desired ones.
+type RoleAssignmentsDiff struct {
+	Add    []RoleAssignment
+	Update []RoleAssignment
+	Delete []RoleAssignment
+}
+
+// IsEmpty reports whether the diff holds no change.
+func (d RoleAssignmentsDiff) IsEmpty() bool {
+	return len(d.Add) == 0 && len(d.Update) == 0 && len(d.Delete) == 0
+}
+
+// DiffRoleAssignments compares the current and desired role assignments by principal ID.
+// Updated assignments carry the ID of the current assignment and the desired role.
+// Principals listed in ignoredPrincipalIDs are never added, updated or deleted.
+func DiffRoleAssignments(current, desired []RoleAssignment, ignoredPrincipalIDs []string) RoleAssignmentsDiff {
+	current = FilterRoleAssignments(current, ignoredPrincipalIDs)
+	desired = FilterRoleAssignments(desired, ignoredPrincipalIDs)
+
+	currentByPrincipal := make(map[string]RoleAssignment, len(current))
+	for _, v := range current {
+		currentByPrincipal[strings.ToLower(v.PrincipalID)] = v
+	}
+
+	desiredByPrincipal := make(map[string]bool, len(desired))
+
+	var diff RoleAssignmentsDiff
+
+	for _, v := range desired {
+		key := strings.ToLower(v.PrincipalID)
+		desiredByPrincipal[key] = true
+
+		existing, ok := currentByPrincipal[key]
+
+		switch {
+		case !ok:
+			diff.Add = append(diff.Add, v)
+		case !strings.EqualFold(existing.Role, v.Role):
+			v.ID = existing.ID
+			diff.Update = append(diff.Update, v)
+		}
+	}
+
+	for _, v := range current {
+		if !desiredByPrincipal[strings.ToLower(v.PrincipalID)] {
+			diff.Delete = append(diff.Delete, v)
+		}
+	}
+
+	return diff
+}
+
+// FilterRoleAssignments returns the role assignments whose principal is not listed in ignoredPrincipalIDs.
+func FilterRoleAssignments(values []RoleAssignment, ignoredPrincipalIDs []string) []RoleAssignment {
+	ignored := make(map[string]bool, len(ignoredPrincipalIDs))
+	for _, v := range ignoredPrincipalIDs {
+		ignored[strings.ToLower(v)] = true
+	}
+
+	result := make([]RoleAssignment, 0, len(values))
+
+	for _, v := range values {
+		if !ignored[strings.ToLower(v.PrincipalID)] {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// ValidateRoleAssignments checks that each principal is declared at most once in the `assignments` attribute
+// and that no declared principal is also listed in the `ignored_principal_ids` attribute.
+func ValidateRoleAssignments(values []RoleAssignment, ignoredPrincipalIDs []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	declared := make(map[string]bool, len(values))
+
+	for _, v := range values {
+		key := strings.ToLower(v.PrincipalID)
+
+		if v.PrincipalID == "" {
+			continue
+		}
+
+		if declared[key] {
+			diags.AddAttributeError(
+				path.Root("assignments"),
+				common.ErrorInvalidConfig,
+				fmt.Sprintf("The principal %s is declared more than once. A principal can only have one role.", v.PrincipalID),
+			)
+		}
+
+		declared[key] = true
+	}
+
+	for _, v := range ignoredPrincipalIDs {
+		if declared[strings.ToLower(v)] {
+			diags.AddAttributeError(
+				path.Root("ignored_principal_ids"),
+				common.ErrorInvalidConfig,
+				fmt.Sprintf("The principal %s is both declared in assignments and ignored.", v),
+			)
+		}
+	}
+
+	return diags
+}
+
+// RoleAssignmentsClient is the adapter to the role assignment API of a Fabric object type.
+// The object ID is the ID of the Workspace, Connection, Gateway or Deployment Pipeline holding the role assignments.
+type RoleAssignmentsClient interface {
+	List(ctx context.Context, objectID string) ([]RoleAssignment, error)
+	Create(ctx context.Context, objectID string, assignment RoleAssignment) error
+	Update(ctx context.Context, objectID string, assignment RoleAssignment) error
+	Delete(ctx context.Context, objectID string, assignment RoleAssignment) error
+}
+
+// ApplyRoleAssignments makes the role assignments of the object match the desired ones, leaving the ignored principals untouched.
+// Grants are applied before removals so that a principal moving between declarations never loses access.
+// The assignment of the caller is kept even when not declared, removing it would revoke the access Terraform needs to manage the object.
+func ApplyRoleAssignments(
+	ctx context.Context,
+	client RoleAssignmentsClient,
+	objectID string,
+	desired []RoleAssignment,
+	ignoredPrincipalIDs []string,
+	callerPrincipalID string,
+	operation utils.Operation,
+) diag.Diagnostics {
+	current, err := client.List(ctx, objectID)
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationList, nil); diags.HasError() {
+		return diags
+	}
+
+	diff := DiffRoleAssignments(current, desired, ignoredPrincipalIDs)
+
+	for _, assignment := range diff.Add {
+		tflog.Trace(ctx, "adding Role Assignment", map[string]any{
+			"principal_id": assignment.PrincipalID,
+			"role":         assignment.Role,
+		})
+
+		err := client.Create(ctx, objectID, assignment)
+		if diags := utils.GetDiagsFromError(ctx, err, operation, nil); diags.HasError() {
+			return diags
+		}
+	}
+
+	for _, assignment := range diff.Update {
+		tflog.Trace(ctx, "updating Role Assignment", map[string]any{
+			"principal_id": assignment.PrincipalID,
+			"role":         assignment.Role,
+		})
+
+		err := client.Update(ctx, objectID, assignment)
+		if diags := utils.GetDiagsFromError(ctx, err, operation, nil); diags.HasError() {
+			return diags
+		}
+	}
+
+	diags, toDelete := keepCallerRoleAssignment(diff.Delete, callerPrincipalID)
+
+	diags.Append(deleteRoleAssignments(ctx, client, objectID, toDelete, operation)...)
+
+	return diags
+}
+
+// DeleteRoleAssignments removes the role assignments of the declared principals from the object.
+// Assignments created since the last apply for other principals are left untouched.
+// The assignment of the caller is kept, removing it would revoke the access Terraform needs to manage the object.
+func DeleteRoleAssignments(ctx context.Context, client RoleAssignmentsClient, objectID string, declared []RoleAssignment, callerPrincipalID string) diag.Diagnostics {
+	current, err := client.List(ctx, objectID)
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationDelete, fabcore.ErrCommon.EntityNotFound); diags.HasError() {
+		if utils.IsErr(diags, fabcore.ErrCommon.EntityNotFound) {
+			return nil
+		}
+
+		return diags
+	}
+
+	var candidates []RoleAssignment
+
+	for _, assignment := range current {
+		if containsPrincipal(declared, assignment.PrincipalID) {
+			candidates = append(candidates, assignment)
+		}
+	}
+
+	diags, toDelete := keepCallerRoleAssignment(candidates, callerPrincipalID)
+
+	diags.Append(deleteRoleAssignments(ctx, client, objectID, toDelete, utils.OperationDelete)...)
+
+	return diags
+}
+
+func deleteRoleAssignments(ctx context.Context, client RoleAssignmentsClient, objectID string, values []RoleAssignment, operation utils.Operation) diag.Diagnostics {
+	for _, assignment := range values {
+		tflog.Trace(ctx, "removing Role Assignment", map[string]any{
+			"principal_id": assignment.PrincipalID,
+			"role":         assignment.Role,
+		})
+
+		err := client.Delete(ctx, objectID, assignment)
+		if diags := utils.GetDiagsFromError(ctx, err, operation, nil); diags.HasError() {
+			return diags
+		}
+	}
+
+	return nil
+}
+
+// keepCallerRoleAssignment removes the role assignment of the caller, if any, from the assignments to delete and warns that it is kept.
+func keepCallerRoleAssignment(values []RoleAssignment, callerPrincipalID string) (diag.Diagnostics, []RoleAssignment) {
+	var diags diag.Diagnostics
+
+	if callerPrincipalID == "" {
+		return diags, values
+	}
+
+	result := make([]RoleAssignment, 0, len(values))
+
+	for _, v := range values {
+		if strings.EqualFold(v.PrincipalID, callerPrincipalID) {
+			diags.AddWarning(
+				common.WarningRoleAssignmentCallerKeptHeader,
+				fmt.Sprintf(common.WarningRoleAssignmentCallerKeptDetails, v.PrincipalID, v.Role),
+			)
+
+			continue
+		}
+
+		result = append(result, v)
+	}
+
+	return diags, result
+}
+
+func containsPrincipal(values []RoleAssignment, principalID string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v.PrincipalID, principalID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getTokenScope returns the scope of the access token read to identify the caller in the given cloud.
+// Only the `oid` claim is read, which is the same for every API of the cloud.
+func getTokenScope(cloudCfg cloud.Configuration) string {
+	switch cloudCfg.ActiveDirectoryAuthorityHost {
+	case cloud.AzureGovernment.ActiveDirectoryAuthorityHost:
+		return "https://analysis.usgovcloudapi.net/powerbi/api/.default"
+	case cloud.AzureChina.ActiveDirectoryAuthorityHost:
+		return "https://analysis.chinacloudapi.cn/powerbi/api/.default"
+	default:
+		return "https://api.fabric.microsoft.com/.default"
+	}
+}
+
+// GetCallerPrincipalID returns the object ID of the principal the credential authenticates in the given cloud,
+// read from the `oid` claim of its access token.
+func GetCallerPrincipalID(ctx context.Context, cred azcore.TokenCredential, cloudCfg cloud.Configuration) (string, error) {
+	if cred == nil {
+		return "", errCallerNotAvailable
+	}
+
+	token, err := cred.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{getTokenScope(cloudCfg)}})
+	if err != nil {
+		return "", err
+	}
+
+	parts := strings.Split(token.Token, ".")
+	if len(parts) != 3 { //nolint:mnd
+		return "", errCallerNotAvailable
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", err
+	}
+
+	var claims struct {
+		OID string `json:"oid"`
+	}
+
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", err
+	}
+
+	if claims.OID == "" {
+		return "", errCallerNotAvailable
+	}
+
+	return claims.OID, nil
+}
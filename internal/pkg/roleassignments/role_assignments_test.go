@@ -0,0 +1,361 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package roleassignments_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/roleassignments"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func TestUnit_DiffRoleAssignments(t *testing.T) {
+	t.Parallel()
+
+	admin := roleassignments.RoleAssignment{ID: "ra-1", PrincipalID: "p-1", PrincipalType: "User", Role: "Admin"}
+	member := roleassignments.RoleAssignment{ID: "ra-2", PrincipalID: "p-2", PrincipalType: "Group", Role: "Member"}
+	breakGlass := roleassignments.RoleAssignment{ID: "ra-3", PrincipalID: "p-3", PrincipalType: "User", Role: "Admin"}
+
+	testCases := map[string]struct {
+		current  []roleassignments.RoleAssignment
+		desired  []roleassignments.RoleAssignment
+		ignored  []string
+		expected roleassignments.RoleAssignmentsDiff
+	}{
+		"no change": {
+			current:  []roleassignments.RoleAssignment{admin, member},
+			desired:  []roleassignments.RoleAssignment{{PrincipalID: "P-1", PrincipalType: "User", Role: "admin"}, {PrincipalID: "p-2", PrincipalType: "Group", Role: "Member"}},
+			expected: roleassignments.RoleAssignmentsDiff{},
+		},
+		"add": {
+			current: []roleassignments.RoleAssignment{admin},
+			desired: []roleassignments.RoleAssignment{{PrincipalID: "p-1", PrincipalType: "User", Role: "Admin"}, {PrincipalID: "p-2", PrincipalType: "Group", Role: "Member"}},
+			expected: roleassignments.RoleAssignmentsDiff{
+				Add: []roleassignments.RoleAssignment{{PrincipalID: "p-2", PrincipalType: "Group", Role: "Member"}},
+			},
+		},
+		"update": {
+			current: []roleassignments.RoleAssignment{admin, member},
+			desired: []roleassignments.RoleAssignment{{PrincipalID: "p-1", PrincipalType: "User", Role: "Admin"}, {PrincipalID: "p-2", PrincipalType: "Group", Role: "Viewer"}},
+			expected: roleassignments.RoleAssignmentsDiff{
+				Update: []roleassignments.RoleAssignment{{ID: "ra-2", PrincipalID: "p-2", PrincipalType: "Group", Role: "Viewer"}},
+			},
+		},
+		"delete unmanaged": {
+			current: []roleassignments.RoleAssignment{admin, member},
+			desired: []roleassignments.RoleAssignment{{PrincipalID: "p-1", PrincipalType: "User", Role: "Admin"}},
+			expected: roleassignments.RoleAssignmentsDiff{
+				Delete: []roleassignments.RoleAssignment{member},
+			},
+		},
+		"ignored principal": {
+			current:  []roleassignments.RoleAssignment{admin, breakGlass},
+			desired:  []roleassignments.RoleAssignment{{PrincipalID: "p-1", PrincipalType: "User", Role: "Admin"}, {PrincipalID: "p-3", PrincipalType: "User", Role: "Viewer"}},
+			ignored:  []string{"P-3"},
+			expected: roleassignments.RoleAssignmentsDiff{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result := roleassignments.DiffRoleAssignments(testCase.current, testCase.desired, testCase.ignored)
+			assert.Equal(t, testCase.expected, result, "they should be equal")
+			assert.Equal(t, testCase.expected.IsEmpty(), result.IsEmpty())
+		})
+	}
+}
+
+func TestUnit_FilterRoleAssignments(t *testing.T) {
+	t.Parallel()
+
+	values := []roleassignments.RoleAssignment{
+		{ID: "ra-1", PrincipalID: "p-1", Role: "Admin"},
+		{ID: "ra-2", PrincipalID: "p-2", Role: "Member"},
+	}
+
+	assert.Equal(t, values, roleassignments.FilterRoleAssignments(values, nil))
+	assert.Equal(t, values[1:], roleassignments.FilterRoleAssignments(values, []string{"P-1"}))
+	assert.Empty(t, roleassignments.FilterRoleAssignments(values, []string{"p-1", "p-2"}))
+}
+
+func TestUnit_ValidateRoleAssignments(t *testing.T) {
+	t.Parallel()
+
+	values := []roleassignments.RoleAssignment{
+		{PrincipalID: "p-1", Role: "Admin"},
+		{PrincipalID: "p-2", Role: "Member"},
+	}
+
+	assert.False(t, roleassignments.ValidateRoleAssignments(values, []string{"p-3"}).HasError())
+
+	diags := roleassignments.ValidateRoleAssignments(append(values, roleassignments.RoleAssignment{PrincipalID: "P-1", Role: "Viewer"}), nil)
+	assert.Len(t, diags, 1)
+	assert.Contains(t, diags[0].Detail(), "declared more than once")
+
+	diags = roleassignments.ValidateRoleAssignments(values, []string{"P-2"})
+	assert.Len(t, diags, 1)
+	assert.Contains(t, diags[0].Detail(), "both declared in assignments and ignored")
+}
+
+// fakeRoleAssignmentsClient is an in-memory roleassignments.RoleAssignmentsClient recording the calls it receives.
+type fakeRoleAssignmentsClient struct {
+	values  []roleassignments.RoleAssignment
+	calls   []string
+	listErr error
+}
+
+func (c *fakeRoleAssignmentsClient) List(_ context.Context, _ string) ([]roleassignments.RoleAssignment, error) {
+	return c.values, c.listErr
+}
+
+func (c *fakeRoleAssignmentsClient) Create(_ context.Context, _ string, assignment roleassignments.RoleAssignment) error {
+	c.calls = append(c.calls, "create "+assignment.PrincipalID+" "+assignment.Role)
+	c.values = append(c.values, assignment)
+
+	return nil
+}
+
+func (c *fakeRoleAssignmentsClient) Update(_ context.Context, _ string, assignment roleassignments.RoleAssignment) error {
+	c.calls = append(c.calls, "update "+assignment.PrincipalID+" "+assignment.Role)
+
+	return nil
+}
+
+func (c *fakeRoleAssignmentsClient) Delete(_ context.Context, _ string, assignment roleassignments.RoleAssignment) error {
+	c.calls = append(c.calls, "delete "+assignment.PrincipalID)
+
+	return nil
+}
+
+func TestUnit_ApplyRoleAssignments(t *testing.T) {
+	t.Parallel()
+
+	caller := roleassignments.RoleAssignment{ID: "ra-0", PrincipalID: "p-0", PrincipalType: "ServicePrincipal", Role: "Admin"}
+	admin := roleassignments.RoleAssignment{ID: "ra-1", PrincipalID: "p-1", PrincipalType: "User", Role: "Admin"}
+	member := roleassignments.RoleAssignment{ID: "ra-2", PrincipalID: "p-2", PrincipalType: "Group", Role: "Member"}
+	breakGlass := roleassignments.RoleAssignment{ID: "ra-3", PrincipalID: "p-3", PrincipalType: "User", Role: "Admin"}
+
+	testCases := map[string]struct {
+		current         []roleassignments.RoleAssignment
+		desired         []roleassignments.RoleAssignment
+		ignored         []string
+		caller          string
+		expected        []string
+		expectedWarning bool
+	}{
+		"grants before removals": {
+			current:  []roleassignments.RoleAssignment{admin, member},
+			desired:  []roleassignments.RoleAssignment{{PrincipalID: "p-1", PrincipalType: "User", Role: "Member"}, {PrincipalID: "p-4", PrincipalType: "User", Role: "Viewer"}},
+			expected: []string{"create p-4 Viewer", "update p-1 Member", "delete p-2"},
+		},
+		"ignored principal untouched": {
+			current:  []roleassignments.RoleAssignment{admin, breakGlass},
+			desired:  []roleassignments.RoleAssignment{{PrincipalID: "p-1", PrincipalType: "User", Role: "Admin"}},
+			ignored:  []string{"p-3"},
+			expected: nil,
+		},
+		"undeclared caller kept": {
+			current:         []roleassignments.RoleAssignment{caller, admin, member},
+			desired:         []roleassignments.RoleAssignment{{PrincipalID: "p-4", PrincipalType: "User", Role: "Admin"}},
+			caller:          "P-0",
+			expected:        []string{"create p-4 Admin", "delete p-1", "delete p-2"},
+			expectedWarning: true,
+		},
+		"declared caller updated": {
+			current:  []roleassignments.RoleAssignment{caller},
+			desired:  []roleassignments.RoleAssignment{{PrincipalID: "p-0", PrincipalType: "ServicePrincipal", Role: "Member"}},
+			caller:   "p-0",
+			expected: []string{"update p-0 Member"},
+		},
+		"unknown caller keeps list order": {
+			current:  []roleassignments.RoleAssignment{caller, admin},
+			desired:  []roleassignments.RoleAssignment{},
+			expected: []string{"delete p-0", "delete p-1"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &fakeRoleAssignmentsClient{values: testCase.current}
+
+			diags := roleassignments.ApplyRoleAssignments(t.Context(), client, "object-id", testCase.desired, testCase.ignored, testCase.caller, utils.OperationUpdate)
+
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, testCase.expected, client.calls)
+
+			if testCase.expectedWarning {
+				require.Len(t, diags.Warnings(), 1)
+				assert.Equal(t, common.WarningRoleAssignmentCallerKeptHeader, diags.Warnings()[0].Summary())
+			} else {
+				assert.Empty(t, diags.Warnings())
+			}
+		})
+	}
+}
+
+func TestUnit_DeleteRoleAssignments(t *testing.T) {
+	t.Parallel()
+
+	caller := roleassignments.RoleAssignment{ID: "ra-0", PrincipalID: "p-0", PrincipalType: "ServicePrincipal", Role: "Admin"}
+	admin := roleassignments.RoleAssignment{ID: "ra-1", PrincipalID: "p-1", PrincipalType: "User", Role: "Admin"}
+	member := roleassignments.RoleAssignment{ID: "ra-2", PrincipalID: "p-2", PrincipalType: "Group", Role: "Member"}
+	unmanaged := roleassignments.RoleAssignment{ID: "ra-3", PrincipalID: "p-3", PrincipalType: "User", Role: "Viewer"}
+
+	notFoundErr := &fabcore.ResponseError{
+		ErrorCode:  fabcore.ErrCommon.EntityNotFound.Error(),
+		StatusCode: http.StatusNotFound,
+		ErrorResponse: &fabcore.ErrorResponse{
+			ErrorCode: new(fabcore.ErrCommon.EntityNotFound.Error()),
+			Message:   new("Message"),
+		},
+	}
+
+	testCases := map[string]struct {
+		current         []roleassignments.RoleAssignment
+		listErr         error
+		declared        []roleassignments.RoleAssignment
+		caller          string
+		expected        []string
+		expectedWarning bool
+	}{
+		"declared only": {
+			current:  []roleassignments.RoleAssignment{admin, member, unmanaged},
+			declared: []roleassignments.RoleAssignment{{PrincipalID: "P-1"}, {PrincipalID: "p-2"}},
+			expected: []string{"delete p-1", "delete p-2"},
+		},
+		"caller kept": {
+			current:         []roleassignments.RoleAssignment{caller, admin, member},
+			declared:        []roleassignments.RoleAssignment{{PrincipalID: "p-0"}, {PrincipalID: "p-1"}, {PrincipalID: "p-2"}},
+			caller:          "p-0",
+			expected:        []string{"delete p-1", "delete p-2"},
+			expectedWarning: true,
+		},
+		"undeclared caller": {
+			current:  []roleassignments.RoleAssignment{caller, admin},
+			declared: []roleassignments.RoleAssignment{{PrincipalID: "p-1"}},
+			caller:   "p-0",
+			expected: []string{"delete p-1"},
+		},
+		"object not found": {
+			listErr:  notFoundErr,
+			declared: []roleassignments.RoleAssignment{{PrincipalID: "p-1"}},
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &fakeRoleAssignmentsClient{values: testCase.current, listErr: testCase.listErr}
+
+			diags := roleassignments.DeleteRoleAssignments(t.Context(), client, "object-id", testCase.declared, testCase.caller)
+
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, testCase.expected, client.calls)
+
+			if testCase.expectedWarning {
+				require.Len(t, diags.Warnings(), 1)
+				assert.Equal(t, common.WarningRoleAssignmentCallerKeptHeader, diags.Warnings()[0].Summary())
+			} else {
+				assert.Empty(t, diags.Warnings())
+			}
+		})
+	}
+}
+
+type fakeTokenCredential struct {
+	token string
+	scope string
+	err   error
+}
+
+func (c fakeTokenCredential) GetToken(_ context.Context, options policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	if c.scope != "" && (len(options.Scopes) != 1 || options.Scopes[0] != c.scope) {
+		return azcore.AccessToken{}, errors.New("unexpected scope")
+	}
+
+	return azcore.AccessToken{Token: c.token, ExpiresOn: time.Now().Add(time.Hour)}, c.err
+}
+
+func TestUnit_GetCallerPrincipalID(t *testing.T) {
+	t.Parallel()
+
+	jwt := func(payload string) string {
+		return strings.Join([]string{
+			base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`)),
+			base64.RawURLEncoding.EncodeToString([]byte(payload)),
+			"signature",
+		}, ".")
+	}
+
+	testCases := map[string]struct {
+		cred     azcore.TokenCredential
+		cloud    cloud.Configuration
+		expected string
+		hasError bool
+	}{
+		"oid claim": {
+			cred:     fakeTokenCredential{token: jwt(`{"oid":"00000000-0000-0000-0000-000000000001"}`), scope: "https://api.fabric.microsoft.com/.default"},
+			cloud:    cloud.AzurePublic,
+			expected: "00000000-0000-0000-0000-000000000001",
+		},
+		"sovereign cloud scope": {
+			cred:     fakeTokenCredential{token: jwt(`{"oid":"00000000-0000-0000-0000-000000000002"}`), scope: "https://analysis.usgovcloudapi.net/powerbi/api/.default"},
+			cloud:    cloud.AzureGovernment,
+			expected: "00000000-0000-0000-0000-000000000002",
+		},
+		"no oid claim": {
+			cred:     fakeTokenCredential{token: jwt(`{"sub":"subject"}`)},
+			hasError: true,
+		},
+		"opaque token": {
+			cred:     fakeTokenCredential{token: "opaque"},
+			hasError: true,
+		},
+		"token error": {
+			cred:     fakeTokenCredential{err: errors.New("token error")},
+			hasError: true,
+		},
+		"no credential": {
+			cred:     nil,
+			hasError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := roleassignments.GetCallerPrincipalID(t.Context(), testCase.cred, testCase.cloud)
+
+			if testCase.hasError {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, result)
+		})
+	}
+}
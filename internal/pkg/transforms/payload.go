@@ -232,6 +232,36 @@ func SourceFileToPayload(
 	return contentB64, contentSha256, nil
 }
 
+// PayloadToSha256 calculates the SHA256 hash of a base64 encoded definition part payload.
+// The content is normalized the same way as in SourceFileToPayload, so the result can be compared with the hash of the source file.
+func PayloadToSha256(payloadB64 string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	content, err := base64ToByte(payloadB64)
+	if err != nil {
+		diags.AddError(common.ErrorBase64DecodeHeader, err.Error())
+
+		return "", diags
+	}
+
+	if !utf8.Valid(content) {
+		return utils.Sha256(content), nil
+	}
+
+	contentStr := string(content)
+
+	if IsJSON(contentStr) {
+		contentStr, err = JSONNormalize(contentStr)
+		if err != nil {
+			diags.AddError(common.ErrorJSONNormalizeHeader, err.Error())
+
+			return "", diags
+		}
+	}
+
+	return utils.Sha256(contentStr), nil
+}
+
 // PayloadToGzip transforms a base64 encoded content string to a gzip compressed base64 string.
 // If the content is valid JSON, it uses JSON-specific encoding.
 func PayloadToGzip(content string) (string, diag.Diagnostics) {
@@ -636,6 +636,62 @@ func TestUnit_PayloadToGzip(t *testing.T) {
 	}
 }
 
+func TestUnit_PayloadToSha256(t *testing.T) {
+	tempDir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{
+			name:    "json",
+			content: `{"name": "test",   "value": 123}`,
+		},
+		{
+			name:    "plain_text",
+			content: "Hello World",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srcPath := filepath.Join(tempDir, testhelp.RandomUUID()+".txt")
+
+			err := os.WriteFile(srcPath, []byte(tt.content), 0o600)
+			require.NoError(t, err, "Failed to write test file")
+
+			payloadB64, expectedSha256, diags := transforms.SourceFileToPayload(srcPath, transforms.ProcessingModeNone, nil, nil, "")
+			require.False(t, diags.HasError(), "Unexpected error diagnostics: %v", diags)
+
+			result, diags := transforms.PayloadToSha256(payloadB64)
+			require.False(t, diags.HasError(), "Unexpected error diagnostics: %v", diags)
+			assert.Equal(t, expectedSha256, result)
+		})
+	}
+
+	t.Run("not_normalized_json", func(t *testing.T) {
+		payloadB64, err := transforms.Base64Encode(`{"value": 123,  "name": "test"}`)
+		require.NoError(t, err)
+
+		normalizedB64, err := transforms.Base64Encode(`{"name":"test","value":123}`)
+		require.NoError(t, err)
+
+		result, diags := transforms.PayloadToSha256(payloadB64)
+		require.False(t, diags.HasError())
+
+		expected, diags := transforms.PayloadToSha256(normalizedB64)
+		require.False(t, diags.HasError())
+
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("invalid_base64", func(t *testing.T) {
+		result, diags := transforms.PayloadToSha256("this is not valid base64!")
+		assert.Empty(t, result)
+		assert.True(t, diags.HasError())
+	})
+}
+
 func TestUnit_SourceFileToPayload_TokensDelimiter(t *testing.T) {
 	tempDir := t.TempDir()
 
@@ -0,0 +1,31 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+)
+
+// CheckDeleteSafeguard refuses the deletion of an entity that still contains other entities.
+// Terraform destroys the dependent resources it manages first, so the remaining contents are not managed by Terraform.
+// contentName describes the remaining contents (e.g. "items"), contents lists them in a human readable form.
+func CheckDeleteSafeguard(entityName, entityID, contentName string, contents []string) diag.Diagnostics {
+	if len(contents) == 0 {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+
+	diags.AddError(
+		common.ErrorDeleteSafeguardHeader,
+		fmt.Sprintf(common.ErrorDeleteSafeguardDetails, entityName, entityID, contentName, strings.Join(contents, ", "), entityName),
+	)
+
+	return diags
+}
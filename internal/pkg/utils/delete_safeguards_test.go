@@ -0,0 +1,24 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func TestUnit_CheckDeleteSafeguard(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, utils.CheckDeleteSafeguard("Workspace", "ws-1", "items", nil))
+
+	diags := utils.CheckDeleteSafeguard("Workspace", "ws-1", "items", []string{"Sales (Lakehouse, i-1)", "Finance (Warehouse, i-2)"})
+	assert.Len(t, diags, 1)
+	assert.Equal(t, common.ErrorDeleteSafeguardHeader, diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "The Workspace ws-1 still contains items not managed by Terraform: Sales (Lakehouse, i-1), Finance (Warehouse, i-2).")
+}
@@ -0,0 +1,64 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// SetIdentityFromState sets every attribute of the resource identity from the state attribute with the same name.
+// It is a no-op when the resource identity is not available.
+func SetIdentityFromState(ctx context.Context, identity *tfsdk.ResourceIdentity, state tfsdk.State) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+
+	for name := range identity.Schema.GetAttributes() {
+		var value attr.Value
+
+		if diags.Append(state.GetAttribute(ctx, path.Root(name), &value)...); diags.HasError() {
+			return diags
+		}
+
+		if diags.Append(identity.SetAttribute(ctx, path.Root(name), value)...); diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// GetImportID returns the import identifier of the resource.
+// When importing by identity, the identifier is built from the given identity attributes joined with "/",
+// so the same parsing applies to both import methods.
+func GetImportID(ctx context.Context, req resource.ImportStateRequest, attributes ...string) (string, diag.Diagnostics) {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID, nil
+	}
+
+	var diags diag.Diagnostics
+
+	parts := make([]string, 0, len(attributes))
+
+	for _, name := range attributes {
+		var value string
+
+		if diags.Append(req.Identity.GetAttribute(ctx, path.Root(name), &value)...); diags.HasError() {
+			return "", diags
+		}
+
+		parts = append(parts, value)
+	}
+
+	return strings.Join(parts, "/"), diags
+}
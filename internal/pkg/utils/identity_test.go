@@ -0,0 +1,123 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func newTestIdentity(workspaceID, id any) *tfsdk.ResourceIdentity {
+	return &tfsdk.ResourceIdentity{
+		Schema: identityschema.Schema{
+			Attributes: map[string]identityschema.Attribute{
+				"workspace_id": identityschema.StringAttribute{RequiredForImport: true},
+				"id":           identityschema.StringAttribute{RequiredForImport: true},
+			},
+		},
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"workspace_id": tftypes.String,
+				"id":           tftypes.String,
+			},
+		}, map[string]tftypes.Value{
+			"workspace_id": tftypes.NewValue(tftypes.String, workspaceID),
+			"id":           tftypes.NewValue(tftypes.String, id),
+		}),
+	}
+}
+
+func TestUnit_GetImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		req      resource.ImportStateRequest
+		expected string
+	}{
+		"import by id": {
+			req:      resource.ImportStateRequest{ID: "00000000-0000-0000-0000-000000000001/00000000-0000-0000-0000-000000000002"},
+			expected: "00000000-0000-0000-0000-000000000001/00000000-0000-0000-0000-000000000002",
+		},
+		"import by identity": {
+			req: resource.ImportStateRequest{
+				Identity: newTestIdentity("00000000-0000-0000-0000-000000000001", "00000000-0000-0000-0000-000000000002"),
+			},
+			expected: "00000000-0000-0000-0000-000000000001/00000000-0000-0000-0000-000000000002",
+		},
+		"no identity": {
+			req:      resource.ImportStateRequest{},
+			expected: "",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, diags := utils.GetImportID(t.Context(), tc.req, "workspace_id", "id")
+
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestUnit_SetIdentityFromState(t *testing.T) {
+	t.Parallel()
+
+	state := tfsdk.State{
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"workspace_id": schema.StringAttribute{Required: true},
+				"id":           schema.StringAttribute{Computed: true},
+				"display_name": schema.StringAttribute{Required: true},
+			},
+		},
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"workspace_id": tftypes.String,
+				"id":           tftypes.String,
+				"display_name": tftypes.String,
+			},
+		}, map[string]tftypes.Value{
+			"workspace_id": tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001"),
+			"id":           tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000002"),
+			"display_name": tftypes.NewValue(tftypes.String, "test"),
+		}),
+	}
+
+	t.Run("nil identity", func(t *testing.T) {
+		t.Parallel()
+
+		diags := utils.SetIdentityFromState(t.Context(), nil, state)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("identity", func(t *testing.T) {
+		t.Parallel()
+
+		identity := newTestIdentity(nil, nil)
+
+		diags := utils.SetIdentityFromState(t.Context(), identity, state)
+		assert.False(t, diags.HasError())
+
+		var workspaceID, id string
+
+		identity.GetAttribute(t.Context(), path.Root("workspace_id"), &workspaceID)
+		identity.GetAttribute(t.Context(), path.Root("id"), &id)
+
+		assert.Equal(t, "00000000-0000-0000-0000-000000000001", workspaceID)
+		assert.Equal(t, "00000000-0000-0000-0000-000000000002", id)
+	})
+}
@@ -0,0 +1,92 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+)
+
+// PollInterval is the delay between two reads of the status of a long-running operation.
+const PollInterval = 30 * time.Second
+
+// itemJobFinalStatuses are the statuses of a job instance that is no longer running.
+var itemJobFinalStatuses = []fabcore.ItemJobStatus{ //nolint:gochecknoglobals
+	fabcore.ItemJobStatusCompleted,
+	fabcore.ItemJobStatusFailed,
+	fabcore.ItemJobStatusCancelled,
+	fabcore.ItemJobStatusDeduped,
+}
+
+// WaitForNextPoll waits for the PollInterval before the next read of the status of a long-running operation.
+// It returns the ctx error when ctx is done before the PollInterval elapses.
+func WaitForNextPoll(ctx context.Context) error {
+	timer := time.NewTimer(PollInterval)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// WaitForItemJobInstance polls the job instance until it reaches a final status.
+// When ctx is done first, it returns the last known state of the job instance with the ctx error.
+func WaitForItemJobInstance(ctx context.Context, client *fabcore.JobSchedulerClient, workspaceID, itemID, jobInstanceID string) (fabcore.ItemJobInstance, error) {
+	jobInstance := fabcore.ItemJobInstance{ID: &jobInstanceID}
+
+	for {
+		respGet, err := client.GetItemJobInstance(ctx, workspaceID, itemID, jobInstanceID, nil)
+		if err != nil {
+			return jobInstance, err
+		}
+
+		jobInstance = respGet.ItemJobInstance
+
+		if jobInstance.Status != nil && slices.Contains(itemJobFinalStatuses, *jobInstance.Status) {
+			return jobInstance, nil
+		}
+
+		tflog.Info(ctx, "Job instance in progress, waiting before retrying", map[string]any{
+			"id": jobInstanceID,
+		})
+
+		if err := WaitForNextPoll(ctx); err != nil {
+			return jobInstance, err
+		}
+	}
+}
+
+// GetItemJobInstanceID returns the job instance ID from the Location header of a run on demand job response.
+func GetItemJobInstanceID(resp *http.Response) string {
+	if resp == nil {
+		return ""
+	}
+
+	location := strings.TrimSuffix(resp.Header.Get("Location"), "/")
+
+	return location[strings.LastIndex(location, "/")+1:]
+}
+
+// GetItemJobFailureReason returns the failure reason of the job instance, when reported.
+func GetItemJobFailureReason(jobInstance fabcore.ItemJobInstance) string {
+	if jobInstance.FailureReason == nil || jobInstance.FailureReason.Message == nil {
+		return "no failure reason was reported"
+	}
+
+	if jobInstance.FailureReason.ErrorCode != nil {
+		return fmt.Sprintf("%s: %s", *jobInstance.FailureReason.ErrorCode, *jobInstance.FailureReason.Message)
+	}
+
+	return *jobInstance.FailureReason.Message
+}
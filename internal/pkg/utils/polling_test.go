@@ -0,0 +1,137 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/microsoft/fabric-sdk-go/fabric"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	fabfake "github.com/microsoft/fabric-sdk-go/fabric/core/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func TestUnit_WaitForNextPoll(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	assert.ErrorIs(t, utils.WaitForNextPoll(ctx), context.Canceled)
+}
+
+func newFakeJobSchedulerClient(t *testing.T, status fabcore.ItemJobStatus) *fabcore.JobSchedulerClient {
+	t.Helper()
+
+	server := &fabfake.JobSchedulerServer{
+		GetItemJobInstance: func(_ context.Context, _, _, jobInstanceID string, _ *fabcore.JobSchedulerClientGetItemJobInstanceOptions) (resp azfake.Responder[fabcore.JobSchedulerClientGetItemJobInstanceResponse], errResp azfake.ErrorResponder) {
+			resp.SetResponse(http.StatusOK, fabcore.JobSchedulerClientGetItemJobInstanceResponse{
+				ItemJobInstance: fabcore.ItemJobInstance{ID: new(jobInstanceID), Status: new(status)},
+			}, nil)
+
+			return resp, errResp
+		},
+	}
+
+	factory, err := fabcore.NewClientFactory(&azfake.TokenCredential{}, nil, &fabric.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fabfake.NewJobSchedulerServerTransport(server),
+		},
+	})
+	require.NoError(t, err)
+
+	return factory.NewJobSchedulerClient()
+}
+
+func TestUnit_WaitForItemJobInstance(t *testing.T) {
+	t.Parallel()
+
+	t.Run("final status", func(t *testing.T) {
+		t.Parallel()
+
+		client := newFakeJobSchedulerClient(t, fabcore.ItemJobStatusFailed)
+
+		jobInstance, err := utils.WaitForItemJobInstance(t.Context(), client, "workspace-id", "item-id", "job-id")
+		require.NoError(t, err)
+		assert.Equal(t, fabcore.ItemJobStatusFailed, *jobInstance.Status)
+	})
+
+	t.Run("timeout returns the last known status", func(t *testing.T) {
+		t.Parallel()
+
+		client := newFakeJobSchedulerClient(t, fabcore.ItemJobStatusInProgress)
+
+		ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+		defer cancel()
+
+		jobInstance, err := utils.WaitForItemJobInstance(ctx, client, "workspace-id", "item-id", "job-id")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, "job-id", *jobInstance.ID)
+		assert.Equal(t, fabcore.ItemJobStatusInProgress, *jobInstance.Status)
+	})
+}
+
+func TestUnit_GetItemJobInstanceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		location string
+		expected string
+	}{
+		"location":          {location: "https://api.fabric.microsoft.com/v1/workspaces/ws/items/it/jobs/instances/job-id", expected: "job-id"},
+		"trailing slash":    {location: "https://api.fabric.microsoft.com/v1/workspaces/ws/items/it/jobs/instances/job-id/", expected: "job-id"},
+		"no location":       {location: "", expected: ""},
+		"relative location": {location: "job-id", expected: "job-id"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &http.Response{Header: http.Header{}}
+			resp.Header.Set("Location", testCase.location)
+
+			assert.Equal(t, testCase.expected, utils.GetItemJobInstanceID(resp))
+		})
+	}
+
+	assert.Empty(t, utils.GetItemJobInstanceID(nil))
+}
+
+func TestUnit_GetItemJobFailureReason(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		failureReason *fabcore.ErrorResponse
+		expected      string
+	}{
+		"code and message": {
+			failureReason: &fabcore.ErrorResponse{ErrorCode: new("JobFailed"), Message: new("The job failed")},
+			expected:      "JobFailed: The job failed",
+		},
+		"message only": {
+			failureReason: &fabcore.ErrorResponse{Message: new("The job failed")},
+			expected:      "The job failed",
+		},
+		"no failure reason": {
+			expected: "no failure reason was reported",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.expected, utils.GetItemJobFailureReason(fabcore.ItemJobInstance{FailureReason: testCase.failureReason}))
+		})
+	}
+}
@@ -99,3 +99,14 @@ func Sha256[T string | []byte](content T) string {
 
 	return hex.EncodeToString(hash[:])
 }
+
+// ValueOrZero returns the value the pointer points to, or the zero value of its type when the pointer is nil.
+func ValueOrZero[T any](v *T) T {
+	if v == nil {
+		var zero T
+
+		return zero
+	}
+
+	return *v
+}
@@ -345,3 +345,13 @@ func TestUnit_Sha256(t *testing.T) {
 		})
 	}
 }
+
+func TestUnit_ValueOrZero(t *testing.T) {
+	t.Parallel()
+
+	value := "value"
+
+	assert.Equal(t, "value", utils.ValueOrZero(&value))
+	assert.Empty(t, utils.ValueOrZero[string](nil))
+	assert.Zero(t, utils.ValueOrZero[int](nil))
+}
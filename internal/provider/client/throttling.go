@@ -0,0 +1,200 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RateLimiter is a token bucket refilled at a constant rate. It is safe for concurrent use.
+type RateLimiter struct {
+	mu       sync.Mutex
+	rate     float64
+	burst    float64
+	tokens   float64
+	last     time.Time
+	throttle atomic.Int64
+}
+
+// NewRateLimiter returns a RateLimiter allowing requestsPerSecond on average, with bursts of up to burst requests.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	burst = max(burst, 1)
+
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Reserve takes a token from the bucket and returns how long the caller must wait before using it.
+func (rl *RateLimiter) Reserve() time.Duration {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	rl.tokens = math.Min(rl.burst, rl.tokens+now.Sub(rl.last).Seconds()*rl.rate)
+	rl.last = now
+	rl.tokens--
+
+	if rl.tokens >= 0 {
+		return 0
+	}
+
+	rl.throttle.Add(1)
+
+	return time.Duration(-rl.tokens / rl.rate * float64(time.Second))
+}
+
+// Wait blocks until a token is available or ctx is done, and returns the time spent waiting.
+func (rl *RateLimiter) Wait(ctx context.Context) (time.Duration, error) {
+	delay := rl.Reserve()
+	if delay <= 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return delay, ctx.Err()
+	case <-timer.C:
+		return delay, nil
+	}
+}
+
+// Throttled returns the number of requests delayed by the RateLimiter so far.
+func (rl *RateLimiter) Throttled() int64 {
+	return rl.throttle.Load()
+}
+
+var (
+	tenantRateLimitersMu sync.Mutex                  //nolint:gochecknoglobals
+	tenantRateLimiters   = map[string]*RateLimiter{} //nolint:gochecknoglobals
+)
+
+// GetTenantRateLimiter returns the RateLimiter shared by all provider instances of the process targeting the same tenant.
+// The limiter is created on first use; later calls for the same tenant get it with the settings of the first call.
+func GetTenantRateLimiter(tenantID string, requestsPerSecond float64, burst int) *RateLimiter {
+	tenantRateLimitersMu.Lock()
+	defer tenantRateLimitersMu.Unlock()
+
+	rl, ok := tenantRateLimiters[tenantID]
+	if !ok {
+		rl = NewRateLimiter(requestsPerSecond, burst)
+		tenantRateLimiters[tenantID] = rl
+	}
+
+	return rl
+}
+
+type requestStatsKey struct{}
+
+// requestStats counts the tries of a single API call, across the SDK retry policy.
+type requestStats struct {
+	tries     atomic.Int32
+	throttled atomic.Int32
+}
+
+// RequestStatsPolicy is a per-call policy logging the retry and throttle counts of each API call.
+// When RateLimiter is set, each call first waits for a token of the client-side rate limiter.
+type RequestStatsPolicy struct {
+	RateLimiter *RateLimiter
+}
+
+func (c RequestStatsPolicy) Do(req *policy.Request) (*http.Response, error) {
+	ctx := req.Raw().Context()
+
+	var waited time.Duration
+
+	if c.RateLimiter != nil {
+		var err error
+
+		if waited, err = c.RateLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		if waited > 0 {
+			tflog.Debug(ctx, "API call delayed by the client-side rate limiter", map[string]any{
+				"method":          req.Raw().Method,
+				"url":             req.Raw().URL.Path,
+				"wait":            waited.String(),
+				"throttled_total": c.RateLimiter.Throttled(),
+			})
+		}
+	}
+
+	stats := &requestStats{}
+
+	resp, err := req.WithContext(context.WithValue(ctx, requestStatsKey{}, stats)).Next()
+
+	if retries := stats.tries.Load() - 1; retries > 0 || stats.throttled.Load() > 0 || waited > 0 {
+		fields := map[string]any{
+			"method":          req.Raw().Method,
+			"url":             req.Raw().URL.Path,
+			"retries":         retries,
+			"throttled":       stats.throttled.Load(),
+			"rate_limit_wait": waited.String(),
+		}
+
+		if resp != nil {
+			fields["status_code"] = resp.StatusCode
+		}
+
+		tflog.Debug(ctx, "API call retry statistics", fields)
+	}
+
+	return resp, err
+}
+
+// RetryStatsPolicy is a per-retry policy counting the tries and the throttled (HTTP 429) responses of an API call.
+type RetryStatsPolicy struct{}
+
+func (c RetryStatsPolicy) Do(req *policy.Request) (*http.Response, error) {
+	stats, _ := req.Raw().Context().Value(requestStatsKey{}).(*requestStats)
+	if stats != nil {
+		stats.tries.Add(1)
+	}
+
+	resp, err := req.Next()
+
+	if stats != nil && resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		stats.throttled.Add(1)
+
+		tflog.Debug(req.Raw().Context(), "API call throttled by the service", map[string]any{
+			"method":      req.Raw().Method,
+			"url":         req.Raw().URL.Path,
+			"try":         stats.tries.Load(),
+			"retry_after": resp.Header.Get("Retry-After"),
+		})
+	}
+
+	return resp, err
+}
+
+var (
+	_ policy.Policy = RequestStatsPolicy{}
+	_ policy.Policy = RetryStatsPolicy{}
+)
+
+// WithRequestStats returns a per-call policy.Policy that logs the retry and throttle counts of each API call, and applies rateLimiter when not nil.
+// It must be paired with the WithRetryStats per-retry policy.
+func WithRequestStats(rateLimiter *RateLimiter) policy.Policy {
+	return RequestStatsPolicy{RateLimiter: rateLimiter}
+}
+
+// WithRetryStats returns a per-retry policy.Policy that counts the tries of each API call for WithRequestStats.
+func WithRetryStats() policy.Policy {
+	return RetryStatsPolicy{}
+}
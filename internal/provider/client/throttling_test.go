@@ -0,0 +1,127 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pclient "github.com/microsoft/terraform-provider-fabric/internal/provider/client"
+)
+
+func TestUnit_RateLimiter_Reserve(t *testing.T) {
+	rl := pclient.NewRateLimiter(1, 2)
+
+	assert.Zero(t, rl.Reserve())
+	assert.Zero(t, rl.Reserve())
+
+	delay := rl.Reserve()
+	assert.Greater(t, delay, 900*time.Millisecond)
+	assert.LessOrEqual(t, delay, time.Second)
+	assert.Equal(t, int64(1), rl.Throttled())
+}
+
+func TestUnit_RateLimiter_WaitCanceled(t *testing.T) {
+	rl := pclient.NewRateLimiter(0.01, 1)
+
+	waited, err := rl.Wait(t.Context())
+	require.NoError(t, err)
+	assert.Zero(t, waited)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, err = rl.Wait(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestUnit_GetTenantRateLimiter(t *testing.T) {
+	tenantID := t.Name()
+
+	rl := pclient.GetTenantRateLimiter(tenantID, 10, 5)
+
+	assert.Same(t, rl, pclient.GetTenantRateLimiter(tenantID, 1, 1))
+	assert.NotSame(t, rl, pclient.GetTenantRateLimiter(tenantID+"-other", 10, 5))
+}
+
+// throttledTransport answers HTTP 429 to the first throttledTries requests, then HTTP 200.
+type throttledTransport struct {
+	tries          int
+	throttledTries int
+}
+
+func (t *throttledTransport) Do(req *http.Request) (*http.Response, error) {
+	t.tries++
+
+	statusCode := http.StatusOK
+	if t.tries <= t.throttledTries {
+		statusCode = http.StatusTooManyRequests
+	}
+
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"Retry-After": []string{"0"}},
+		Body:       io.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}, nil
+}
+
+func TestUnit_RequestStatsPolicy(t *testing.T) {
+	var logs bytes.Buffer
+
+	ctx := tflogtest.RootLogger(t.Context(), &logs)
+
+	transport := &throttledTransport{throttledTries: 2}
+	pipeline := runtime.NewPipeline("test", "v0.0.0", runtime.PipelineOptions{
+		PerCall:  []policy.Policy{pclient.WithRequestStats(nil)},
+		PerRetry: []policy.Policy{pclient.WithRetryStats()},
+	}, &policy.ClientOptions{
+		Transport: transport,
+		Retry: policy.RetryOptions{
+			MaxRetries: 5,
+			RetryDelay: time.Millisecond,
+		},
+	})
+
+	req, err := runtime.NewRequest(ctx, http.MethodGet, "https://api.fabric.microsoft.com/v1/workspaces")
+	require.NoError(t, err)
+
+	resp, err := pipeline.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, transport.tries)
+
+	entries, err := tflogtest.MultilineJSONDecode(&logs)
+	require.NoError(t, err)
+
+	var throttled int
+
+	var stats map[string]any
+
+	for _, entry := range entries {
+		switch entry["@message"] {
+		case "API call throttled by the service":
+			throttled++
+		case "API call retry statistics":
+			stats = entry
+		}
+	}
+
+	assert.Equal(t, 2, throttled)
+	require.NotNil(t, stats)
+	assert.InDelta(t, 2, stats["retries"], 0)
+	assert.InDelta(t, 2, stats["throttled"], 0)
+	assert.InDelta(t, http.StatusOK, stats["status_code"], 0)
+}
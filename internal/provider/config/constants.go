@@ -3,8 +3,34 @@
 
 package config
 
+import (
+	"math"
+	"net/http"
+)
+
 // Default Microsoft Fabric endpoint URL.
 const (
 	DefaultFabricEndpointURL = "https://api.fabric.microsoft.com"
 	DefaultTimeout           = "10m"
 )
+
+// Default retry settings of the Microsoft Fabric client.
+const (
+	// DefaultMaxRetries is not really an unlimited cap, but sufficiently large enough to be considered as such.
+	// Operations are still bounded by the timeout.
+	DefaultMaxRetries = math.MaxInt32
+	// DefaultMaxRetryDelay less than zero means there is no cap.
+	DefaultMaxRetryDelay = -1
+)
+
+// DefaultRetryStatusCodes are the HTTP status codes retried by the Microsoft Fabric client when not configured.
+func DefaultRetryStatusCodes() []int {
+	return []int{
+		http.StatusRequestTimeout,
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	}
+}
@@ -102,3 +102,27 @@ func GetEnvVarsDisableTerraformPartnerID() []string {
 func GetEnvVarsUseWorkspacePrivateLinkEndpoint() []string {
 	return []string{"FABRIC_USE_WORKSPACE_PRIVATE_LINK_ENDPOINT"}
 }
+
+func GetEnvVarsDefinitionDriftDetectionEnabled() []string {
+	return []string{"FABRIC_DEFINITION_DRIFT_DETECTION_ENABLED"}
+}
+
+func GetEnvVarsMaxRetries() []string {
+	return []string{"FABRIC_MAX_RETRIES"}
+}
+
+func GetEnvVarsMaxRetryDelay() []string {
+	return []string{"FABRIC_MAX_RETRY_DELAY"}
+}
+
+func GetEnvVarsRetryStatusCodes() []string {
+	return []string{"FABRIC_RETRY_STATUS_CODES"}
+}
+
+func GetEnvVarsRateLimitRequestsPerSecond() []string {
+	return []string{"FABRIC_RATE_LIMIT_REQUESTS_PER_SECOND"}
+}
+
+func GetEnvVarsRateLimitBurst() []string {
+	return []string{"FABRIC_RATE_LIMIT_BURST"}
+}
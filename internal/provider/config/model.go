@@ -6,6 +6,8 @@ package config
 import (
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/microsoft/fabric-sdk-go/fabric"
@@ -16,6 +18,9 @@ import (
 
 type ProviderData struct {
 	FabricClient                    *fabric.Client
+	Credential                      azcore.TokenCredential
+	ClientOptions                   azcore.ClientOptions
+	Cloud                           cloud.Configuration
 	Timeout                         time.Duration
 	Endpoint                        string
 	Version                         string
@@ -24,6 +29,12 @@ type ProviderData struct {
 	TerraformVersion                string
 	PartnerID                       string
 	DisableTerraformPartnerID       bool
+	DefinitionDriftDetectionEnabled bool
+	MaxRetries                      int32
+	MaxRetryDelay                   time.Duration
+	RetryStatusCodes                []int
+	RateLimitRequestsPerSecond      float64
+	RateLimitBurst                  int
 }
 
 type ProviderConfig struct {
@@ -59,4 +70,10 @@ type ProviderConfigModel struct {
 	PartnerID                       customtypes.UUID     `tfsdk:"partner_id"`
 	DisableTerraformPartnerID       types.Bool           `tfsdk:"disable_terraform_partner_id"`
 	UseWorkspacePrivateLinkEndpoint types.Bool           `tfsdk:"use_workspace_private_link_endpoint"`
+	DefinitionDriftDetectionEnabled types.Bool           `tfsdk:"definition_drift_detection_enabled"`
+	MaxRetries                      types.Int64          `tfsdk:"max_retries"`
+	MaxRetryDelay                   timetypes.GoDuration `tfsdk:"max_retry_delay"`
+	RetryStatusCodes                types.Set            `tfsdk:"retry_status_codes"`
+	RateLimitRequestsPerSecond      types.Float64        `tfsdk:"rate_limit_requests_per_second"`
+	RateLimitBurst                  types.Int64          `tfsdk:"rate_limit_burst"`
 }
@@ -16,16 +16,21 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/microsoft/fabric-sdk-go/fabric"
 
@@ -41,7 +46,11 @@ import (
 	"github.com/microsoft/terraform-provider-fabric/internal/services/anomalydetector"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/apacheairflowjob"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/capacity"
+	"github.com/microsoft/terraform-provider-fabric/internal/services/capacitysettings"
+	"github.com/microsoft/terraform-provider-fabric/internal/services/capacitystate"
+	"github.com/microsoft/terraform-provider-fabric/internal/services/capacitywa"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/connection"
+	"github.com/microsoft/terraform-provider-fabric/internal/services/connectionhealth"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/connectionra"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/copyjob"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/cosmosdb"
@@ -51,6 +60,7 @@ import (
 	"github.com/microsoft/terraform-provider-fabric/internal/services/datamart"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/datapipeline"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/deploymentpipeline"
+	"github.com/microsoft/terraform-provider-fabric/internal/services/deploymentpipelinedeploy"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/deploymentpipelinera"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/digitaltwinbuilder"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/digitaltwinbuilderflow"
@@ -68,7 +78,9 @@ import (
 	"github.com/microsoft/terraform-provider-fabric/internal/services/gateway"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/gatewayra"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/graphqlapi"
+	"github.com/microsoft/terraform-provider-fabric/internal/services/itemjobinstance"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/itemjobscheduler"
+	"github.com/microsoft/terraform-provider-fabric/internal/services/itempermission"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/kqldashboard"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/kqldatabase"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/kqlqueryset"
@@ -76,6 +88,7 @@ import (
 	"github.com/microsoft/terraform-provider-fabric/internal/services/lakehousetable"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/mirroredcatalog"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/mirroreddatabase"
+	"github.com/microsoft/terraform-provider-fabric/internal/services/mirroreddatabasemirroring"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/mirroredwarehouse"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/mlexperiment"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/mlmodel"
@@ -87,13 +100,18 @@ import (
 	"github.com/microsoft/terraform-provider-fabric/internal/services/paginatedreport"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/report"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/semanticmodel"
+	"github.com/microsoft/terraform-provider-fabric/internal/services/semanticmodelbinding"
+	"github.com/microsoft/terraform-provider-fabric/internal/services/semanticmodelrefresh"
+	"github.com/microsoft/terraform-provider-fabric/internal/services/semanticmodelrefreshschedule"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/shortcut"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/sparkcustompool"
+	"github.com/microsoft/terraform-provider-fabric/internal/services/sparkenvlibraries"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/sparkenvsettings"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/sparkjobdefinition"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/sparkwssettings"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/sqldatabase"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/sqlendpoint"
+	"github.com/microsoft/terraform-provider-fabric/internal/services/sqlendpointrefresh"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/tags"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/tenantsetting"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/variablelibrary"
@@ -102,7 +120,11 @@ import (
 	"github.com/microsoft/terraform-provider-fabric/internal/services/warehousesqlauditsetting"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/workspace"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/workspacegit"
+	"github.com/microsoft/terraform-provider-fabric/internal/services/workspacegitcommit"
+	"github.com/microsoft/terraform-provider-fabric/internal/services/workspacegitstatus"
+	"github.com/microsoft/terraform-provider-fabric/internal/services/workspacegitupdate"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/workspacegop"
+	"github.com/microsoft/terraform-provider-fabric/internal/services/workspaceitemimports"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/workspacempe"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/workspacencp"
 	"github.com/microsoft/terraform-provider-fabric/internal/services/workspaceocr"
@@ -115,6 +137,8 @@ var (
 	_ provider.Provider                       = (*FabricProvider)(nil)
 	_ provider.ProviderWithFunctions          = (*FabricProvider)(nil)
 	_ provider.ProviderWithEphemeralResources = (*FabricProvider)(nil)
+	_ provider.ProviderWithListResources      = (*FabricProvider)(nil)
+	_ provider.ProviderWithActions            = (*FabricProvider)(nil)
 	// _ provider.ProviderWithConfigValidators = (*FabricProvider)(nil)
 	// _ provider.ProviderWithValidateConfig   = (*FabricProvider)(nil)
 	// _ provider.ProviderWithMetaSchema = (*FabricProvider)(nil).
@@ -137,6 +161,8 @@ func New(version string) pclient.ProviderWithFabricClient {
 	cfg.ProviderData = &pconfig.ProviderData{}
 	cfg.Endpoint = pconfig.DefaultFabricEndpointURL
 	cfg.Timeout, _ = time.ParseDuration(pconfig.DefaultTimeout)
+	cfg.MaxRetries = pconfig.DefaultMaxRetries
+	cfg.MaxRetryDelay = pconfig.DefaultMaxRetryDelay
 	cfg.Version = version
 
 	return &FabricProvider{
@@ -163,15 +189,25 @@ func createDefaultClient(ctx context.Context, cfg *pconfig.ProviderConfig) (*fab
 
 	tflog.Info(ctx, resp.Info)
 
+	// Keep the credential to authenticate requests outside of the Fabric API (e.g. Azure Resource Manager).
+	cfg.Credential = resp.Cred
+
 	fabricClientOpt := &fabric.ClientOptions{}
 
 	// MaxRetries specifies the maximum number of attempts a failed operation will be retried before producing an error.
-	// Not really an unlimited cap, but sufficiently large enough to be considered as such.
-	fabricClientOpt.Retry.MaxRetries = math.MaxInt32
+	// A value less than zero means one try and no retries, zero would fall back to the SDK default.
+	fabricClientOpt.Retry.MaxRetries = cfg.MaxRetries
+	if cfg.MaxRetries == 0 {
+		fabricClientOpt.Retry.MaxRetries = -1
+	}
 
 	// MaxRetryDelay specifies the maximum delay allowed before retrying an operation.
 	// A value less than zero means there is no cap.
-	fabricClientOpt.Retry.MaxRetryDelay = -1
+	fabricClientOpt.Retry.MaxRetryDelay = cfg.MaxRetryDelay
+
+	// StatusCodes specifies the HTTP status codes that indicate the operation should be retried.
+	// A nil slice will use the SDK default values.
+	fabricClientOpt.Retry.StatusCodes = cfg.RetryStatusCodes
 
 	ctx, lvl, err := pclient.NewFabricSDKLoggerSubsystem(ctx)
 	if err != nil {
@@ -200,9 +236,20 @@ func createDefaultClient(ctx context.Context, cfg *pconfig.ProviderConfig) (*fab
 		})
 	}
 
-	perCallPolicies := make([]policy.Policy, 0, 1)
+	// The rate limiter is shared by all provider instances targeting the same tenant, e.g. provider aliases.
+	var rateLimiter *pclient.RateLimiter
+	if cfg.RateLimitRequestsPerSecond > 0 {
+		rateLimiter = pclient.GetTenantRateLimiter(cfg.Auth.TenantID, cfg.RateLimitRequestsPerSecond, cfg.RateLimitBurst)
+	}
+
+	perCallPolicies := make([]policy.Policy, 0, 2)
 	perCallPolicies = append(perCallPolicies, pclient.WithUserAgent(pclient.BuildUserAgent(cfg.TerraformVersion, fabric.Version, cfg.Version, cfg.PartnerID, cfg.DisableTerraformPartnerID)))
+	perCallPolicies = append(perCallPolicies, pclient.WithRequestStats(rateLimiter))
 	fabricClientOpt.PerCallPolicies = perCallPolicies
+	fabricClientOpt.PerRetryPolicies = []policy.Policy{pclient.WithRetryStats()}
+
+	// Keep the retry, logging and policies to configure the clients of the APIs outside of Fabric (e.g. Power BI, Azure Resource Manager) the same way.
+	cfg.ClientOptions = fabricClientOpt.ClientOptions
 
 	// Set workspace private links
 	fabricClientOpt.UseWorkspacePrivateLinks = cfg.UseWorkspacePrivateLinkEndpoint
@@ -379,6 +426,52 @@ func (p *FabricProvider) Schema(ctx context.Context, _ provider.SchemaRequest, r
 				MarkdownDescription: "Use the workspace private link endpoint. When set to `true`, the provider routes all workspace-scoped API requests through the workspace's private link endpoint (workspace-specific hostname). This can also be sourced from the `FABRIC_USE_WORKSPACE_PRIVATE_LINK_ENDPOINT` environment variable. Defaults to `false`.",
 				Optional:            true,
 			},
+
+			// Item definitions
+			"definition_drift_detection_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Detect out-of-band changes of item definitions. When set to `true`, resources with a `definition` fetch the item definition on read and compare each part with the content returned by Fabric after the last apply, so the next plan restores the configured content. Fetching definitions is expensive, it can be overridden at any Resource with the `definition_drift_detection_enabled` attribute. This can also be sourced from the `FABRIC_DEFINITION_DRIFT_DETECTION_ENABLED` environment variable. Defaults to `false`.",
+				Optional:            true,
+			},
+
+			// Retry and throttling
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of times a failed request is retried before producing an error. `0` disables retries. This can also be sourced from the `FABRIC_MAX_RETRIES` environment variable. If not set, requests are retried until the `timeout` is reached.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, math.MaxInt32),
+				},
+			},
+			"max_retry_delay": schema.StringAttribute{
+				MarkdownDescription: "The maximum delay between two retries of a request, including the delay requested by the `Retry-After` header of throttled responses. " +
+					"A string that can be [parsed as a duration](https://pkg.go.dev/time#ParseDuration), such as `30s` or `2m`. This can also be sourced from the `FABRIC_MAX_RETRY_DELAY` environment variable. If not set, the delay is not capped.",
+				Optional:   true,
+				CustomType: timetypes.GoDurationType{},
+			},
+			"retry_status_codes": schema.SetAttribute{
+				MarkdownDescription: "The HTTP status codes of the responses to retry. This can also be sourced from the `FABRIC_RETRY_STATUS_CODES` environment variable, as a semicolon-separated list. " +
+					"If not set, the default is " + utils.ConvertStringSlicesToString(pconfig.DefaultRetryStatusCodes(), true, false) + ".",
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+				},
+			},
+			"rate_limit_requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "The average number of requests per second sent to the Microsoft Fabric API. The limit is shared by all provider configurations of the same `tenant_id`, e.g. provider aliases, and applies to each API call before its retries. " +
+					"This can also be sourced from the `FABRIC_RATE_LIMIT_REQUESTS_PER_SECOND` environment variable. If not set, requests are not rate limited.",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.01),
+				},
+			},
+			"rate_limit_burst": schema.Int64Attribute{
+				MarkdownDescription: "The number of requests that can be sent at once before `rate_limit_requests_per_second` applies. This can also be sourced from the `FABRIC_RATE_LIMIT_BURST` environment variable. Defaults to `1`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -448,6 +541,14 @@ func (p *FabricProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	resp.EphemeralResourceData = p.config.ProviderData
 
+	tflog.Debug(ctx, "Assigning Microsoft Fabric client to ListResourceData")
+
+	resp.ListResourceData = p.config.ProviderData
+
+	tflog.Debug(ctx, "Assigning Microsoft Fabric client to ActionData")
+
+	resp.ActionData = p.config.ProviderData
+
 	tflog.Info(ctx, "Configured Microsoft Fabric client", map[string]any{"success": true})
 }
 
@@ -455,6 +556,8 @@ func (p *FabricProvider) Resources(ctx context.Context) []func() resource.Resour
 	return []func() resource.Resource{
 		anomalydetector.NewResourceAnomalyDetector,
 		apacheairflowjob.NewResourceApacheAirflowJob,
+		capacitysettings.NewResourceCapacityAdminSettings,
+		capacitywa.NewResourceCapacityWorkspaceAssignments,
 		copyjob.NewResourceCopyJob,
 		cosmosdb.NewResourceCosmosDB,
 		dataagent.NewResourceDataAgent,
@@ -467,8 +570,11 @@ func (p *FabricProvider) Resources(ctx context.Context) []func() resource.Resour
 		domainwa.NewResourceDomainWorkspaceAssignments,
 		connection.NewResourceConnection,
 		connectionra.NewResourceConnectionRoleAssignment,
+		connectionra.NewResourceConnectionRoleAssignments,
 		deploymentpipeline.NewResourceDeploymentPipeline,
+		deploymentpipelinedeploy.NewResourceDeploymentPipelineDeployment,
 		deploymentpipelinera.NewResourceDeploymentPipelineRoleAssignment,
+		deploymentpipelinera.NewResourceDeploymentPipelineRoleAssignments,
 		func() resource.Resource { return environment.NewResourceEnvironment(ctx) },
 		func() resource.Resource { return eventhouse.NewResourceEventhouse(ctx) },
 		eventstream.NewResourceEventstream,
@@ -477,12 +583,16 @@ func (p *FabricProvider) Resources(ctx context.Context) []func() resource.Resour
 		folder.NewResourceFolder,
 		gateway.NewResourceGateway,
 		gatewayra.NewResourceGatewayRoleAssignment,
+		gatewayra.NewResourceGatewayRoleAssignments,
 		graphqlapi.NewResourceGraphQLApi,
+		itemjobinstance.NewResourceItemJobInstance,
 		itemjobscheduler.NewResourceItemJobScheduler,
+		itempermission.NewResourceItemPermission,
 		kqldashboard.NewResourceKQLDashboard,
 		kqldatabase.NewResourceKQLDatabase,
 		kqlqueryset.NewResourceKQLQueryset,
 		func() resource.Resource { return lakehouse.NewResourceLakehouse(ctx) },
+		lakehousetable.NewResourceLakehouseTable,
 		func() resource.Resource { return mirroredcatalog.NewResourceMirroredCatalog(ctx) },
 		func() resource.Resource { return mirroreddatabase.NewResourceMirroredDatabase(ctx) },
 		mounteddatafactory.NewResourceMountedDataFactory,
@@ -497,7 +607,10 @@ func (p *FabricProvider) Resources(ctx context.Context) []func() resource.Resour
 		activator.NewResourceActivator,
 		report.NewResourceReport,
 		semanticmodel.NewResourceSemanticModel,
+		semanticmodelbinding.NewResourceSemanticModelConnectionBinding,
+		semanticmodelrefreshschedule.NewResourceSemanticModelRefreshSchedule,
 		sparkcustompool.NewResourceSparkCustomPool,
+		sparkenvlibraries.NewResourceSparkEnvironmentLibraries,
 		sparkenvsettings.NewResourceSparkEnvironmentSettings,
 		sparkwssettings.NewResourceSparkWorkspaceSettings,
 		sparkjobdefinition.NewResourceSparkJobDefinition,
@@ -513,7 +626,10 @@ func (p *FabricProvider) Resources(ctx context.Context) []func() resource.Resour
 		workspaceogr.NewResourceWorkspaceOutboundGatewayRules,
 		workspacencp.NewResourceWorkspaceNetworkCommunicationPolicy,
 		workspacera.NewResourceWorkspaceRoleAssignment,
+		workspacera.NewResourceWorkspaceRoleAssignments,
 		workspacegit.NewResourceWorkspaceGit,
+		workspacegitcommit.NewResourceWorkspaceGitCommit,
+		workspacegitupdate.NewResourceWorkspaceGitUpdate,
 		workspacempe.NewResourceWorkspaceManagedPrivateEndpoint,
 	}
 }
@@ -528,6 +644,7 @@ func (p *FabricProvider) DataSources(ctx context.Context) []func() datasource.Da
 		capacity.NewDataSourceCapacities,
 		connection.NewDataSourceConnection,
 		connection.NewDataSourceConnections,
+		connectionhealth.NewDataSourceConnectionHealth,
 		connectionra.NewDataSourceConnectionRoleAssignment,
 		connectionra.NewDataSourceConnectionRoleAssignments,
 		copyjob.NewDataSourceCopyJob,
@@ -574,6 +691,7 @@ func (p *FabricProvider) DataSources(ctx context.Context) []func() datasource.Da
 		graphqlapi.NewDataSourceGraphQLApis,
 		itemjobscheduler.NewDataSourceItemJobScheduler,
 		itemjobscheduler.NewDataSourceItemJobSchedulers,
+		itempermission.NewDataSourceItemPermissions,
 		kqldashboard.NewDataSourceKQLDashboard,
 		kqldashboard.NewDataSourceKQLDashboards,
 		kqldatabase.NewDataSourceKQLDatabase,
@@ -588,6 +706,7 @@ func (p *FabricProvider) DataSources(ctx context.Context) []func() datasource.Da
 		func() datasource.DataSource { return mirroredcatalog.NewDataSourceMirroredCatalogs(ctx) },
 		func() datasource.DataSource { return mirroreddatabase.NewDataSourceMirroredDatabase(ctx) },
 		func() datasource.DataSource { return mirroreddatabase.NewDataSourceMirroredDatabases(ctx) },
+		mirroreddatabasemirroring.NewDataSourceMirroredDatabaseMirroring,
 		mirroredwarehouse.NewDataSourceMirroredWarehouses,
 		mlexperiment.NewDataSourceMLExperiment,
 		mlexperiment.NewDataSourceMLExperiments,
@@ -621,6 +740,7 @@ func (p *FabricProvider) DataSources(ctx context.Context) []func() datasource.Da
 		sparkjobdefinition.NewDataSourceSparkJobDefinitions,
 		sqldatabase.NewDataSourceSQLDatabase,
 		sqldatabase.NewDataSourceSQLDatabases,
+		sqlendpoint.NewDataSourceSQLEndpoint,
 		sqlendpoint.NewDataSourceSQLEndpoints,
 		tenantsetting.NewDataSourceTenantSetting,
 		tenantsetting.NewDataSourceTenantSettings,
@@ -640,6 +760,10 @@ func (p *FabricProvider) DataSources(ctx context.Context) []func() datasource.Da
 		workspacera.NewDataSourceWorkspaceRoleAssignment,
 		workspacera.NewDataSourceWorkspaceRoleAssignments,
 		workspacegit.NewDataSourceWorkspaceGit,
+		workspacegitstatus.NewDataSourceWorkspaceGitStatus,
+		func() datasource.DataSource {
+			return workspaceitemimports.NewDataSourceWorkspaceItemImports(p.ListResources(ctx))
+		},
 		workspacempe.NewDataSourceWorkspaceManagedPrivateEndpoint,
 		workspacempe.NewDataSourceWorkspaceManagedPrivateEndpoints,
 	}
@@ -652,9 +776,59 @@ func (p *FabricProvider) EphemeralResources(_ context.Context) []func() ephemera
 	}
 }
 
+func (p *FabricProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		activator.NewListResourceActivator,
+		anomalydetector.NewListResourceAnomalyDetector,
+		apacheairflowjob.NewListResourceApacheAirflowJob,
+		copyjob.NewListResourceCopyJob,
+		cosmosdb.NewListResourceCosmosDB,
+		dataagent.NewListResourceDataAgent,
+		dataflow.NewListResourceDataflow,
+		datapipeline.NewListResourceDataPipeline,
+		digitaltwinbuilder.NewListResourceDigitalTwinBuilder,
+		digitaltwinbuilderflow.NewListResourceDigitalTwinBuilderFlow,
+		environment.NewListResourceEnvironment,
+		eventhouse.NewListResourceEventhouse,
+		eventstream.NewListResourceEventstream,
+		fabricmap.NewListResourceMap,
+		graphqlapi.NewListResourceGraphQLApi,
+		kqldashboard.NewListResourceKQLDashboard,
+		kqldatabase.NewListResourceKQLDatabase,
+		kqlqueryset.NewListResourceKQLQueryset,
+		lakehouse.NewListResourceLakehouse,
+		mirroredcatalog.NewListResourceMirroredCatalog,
+		mirroreddatabase.NewListResourceMirroredDatabase,
+		mlexperiment.NewListResourceMLExperiment,
+		mlmodel.NewListResourceMLModel,
+		mounteddatafactory.NewListResourceMountedDataFactory,
+		notebook.NewListResourceNotebook,
+		ontology.NewListResourceOntology,
+		operationsagent.NewListResourceOperationsAgent,
+		report.NewListResourceReport,
+		semanticmodel.NewListResourceSemanticModel,
+		sparkjobdefinition.NewListResourceSparkJobDefinition,
+		sqldatabase.NewListResourceSQLDatabase,
+		variablelibrary.NewListResourceVariableLibrary,
+		warehouse.NewListResourceWarehouse,
+		warehousesnapshot.NewListResourceWarehouseSnapshot,
+		workspace.NewListResourceWorkspace,
+	}
+}
+
+func (p *FabricProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		capacitystate.NewActionCapacityState,
+		mirroreddatabasemirroring.NewActionMirroredDatabaseMirroring,
+		semanticmodelrefresh.NewActionSemanticModelRefresh,
+		sqlendpointrefresh.NewActionSQLEndpointMetadataRefresh,
+	}
+}
+
 func (p *FabricProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		functions.NewFunctionContentDecode,
+		functions.NewFunctionContentRender,
 	}
 }
 
@@ -835,6 +1009,31 @@ func (p *FabricProvider) setConfig(ctx context.Context, config *pconfig.Provider
 	config.UseWorkspacePrivateLinkEndpoint = putils.GetBoolValue(config.UseWorkspacePrivateLinkEndpoint, pconfig.GetEnvVarsUseWorkspacePrivateLinkEndpoint(), false)
 	ctx = tflog.SetField(ctx, "use_workspace_private_link_endpoint", config.UseWorkspacePrivateLinkEndpoint.ValueBool())
 
+	config.DefinitionDriftDetectionEnabled = putils.GetBoolValue(config.DefinitionDriftDetectionEnabled, pconfig.GetEnvVarsDefinitionDriftDetectionEnabled(), false)
+	ctx = tflog.SetField(ctx, "definition_drift_detection_enabled", config.DefinitionDriftDetectionEnabled.ValueBool())
+
+	config.MaxRetries = putils.GetInt64Value(config.MaxRetries, pconfig.GetEnvVarsMaxRetries())
+	ctx = tflog.SetField(ctx, "max_retries", config.MaxRetries.String())
+
+	maxRetryDelay, diags := config.MaxRetryDelay.ToStringValue(ctx)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return ctx
+	}
+
+	if maxRetryDelay = putils.GetStringValue(maxRetryDelay, pconfig.GetEnvVarsMaxRetryDelay(), ""); maxRetryDelay.ValueString() != "" {
+		config.MaxRetryDelay = timetypes.NewGoDurationValueFromStringMust(maxRetryDelay.ValueString())
+		ctx = tflog.SetField(ctx, "max_retry_delay", config.MaxRetryDelay)
+	}
+
+	config.RetryStatusCodes = putils.GetSetInt64Values(config.RetryStatusCodes, pconfig.GetEnvVarsRetryStatusCodes())
+	ctx = tflog.SetField(ctx, "retry_status_codes", config.RetryStatusCodes.String())
+
+	config.RateLimitRequestsPerSecond = putils.GetFloat64Value(config.RateLimitRequestsPerSecond, pconfig.GetEnvVarsRateLimitRequestsPerSecond())
+	ctx = tflog.SetField(ctx, "rate_limit_requests_per_second", config.RateLimitRequestsPerSecond.String())
+
+	config.RateLimitBurst = putils.GetInt64Value(config.RateLimitBurst, pconfig.GetEnvVarsRateLimitBurst())
+	ctx = tflog.SetField(ctx, "rate_limit_burst", config.RateLimitBurst.String())
+
 	return ctx
 }
 
@@ -860,6 +1059,8 @@ func (p *FabricProvider) mapConfig(ctx context.Context, config *pconfig.Provider
 		p.config.Auth.Environment = cloud.AzurePublic
 	}
 
+	p.config.Cloud = p.config.Auth.Environment
+
 	p.config.Auth.TenantID = config.TenantID.ValueString()
 
 	var auxiliaryTenantIDs []string
@@ -930,6 +1131,40 @@ func (p *FabricProvider) mapConfig(ctx context.Context, config *pconfig.Provider
 	p.config.PartnerID = config.PartnerID.ValueString()
 	p.config.DisableTerraformPartnerID = config.DisableTerraformPartnerID.ValueBool()
 	p.config.UseWorkspacePrivateLinkEndpoint = config.UseWorkspacePrivateLinkEndpoint.ValueBool()
+	p.config.DefinitionDriftDetectionEnabled = config.DefinitionDriftDetectionEnabled.ValueBool()
+
+	p.mapConfigRetry(ctx, config, resp)
+}
+
+func (p *FabricProvider) mapConfigRetry(ctx context.Context, config *pconfig.ProviderConfigModel, resp *provider.ConfigureResponse) {
+	if !config.MaxRetries.IsNull() {
+		p.config.MaxRetries = int32(max(min(config.MaxRetries.ValueInt64(), math.MaxInt32), -1)) //nolint:gosec // clamped to the int32 range
+	}
+
+	if !config.MaxRetryDelay.IsNull() {
+		maxRetryDelay, diags := config.MaxRetryDelay.ValueGoDuration()
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
+
+		p.config.MaxRetryDelay = maxRetryDelay
+	}
+
+	if !config.RetryStatusCodes.IsNull() {
+		var retryStatusCodes []int64
+
+		if resp.Diagnostics.Append(config.RetryStatusCodes.ElementsAs(ctx, &retryStatusCodes, false)...); resp.Diagnostics.HasError() {
+			return
+		}
+
+		p.config.RetryStatusCodes = make([]int, 0, len(retryStatusCodes))
+		for _, code := range retryStatusCodes {
+			p.config.RetryStatusCodes = append(p.config.RetryStatusCodes, int(code))
+		}
+	}
+
+	p.config.RateLimitRequestsPerSecond = config.RateLimitRequestsPerSecond.ValueFloat64()
+	p.config.RateLimitBurst = max(int(config.RateLimitBurst.ValueInt64()), 1)
 }
 
 func (p *FabricProvider) validateConfigAuthOIDC(resp *provider.ConfigureResponse) {
@@ -32,6 +32,33 @@ func GetListStringValues(value types.Set, envVarKeys, defaultValue []string) typ
 	return value
 }
 
+// GetSetInt64Values returns the integers of the first environment variable that is set when value is not configured, skipping invalid and duplicated entries.
+func GetSetInt64Values(value types.Set, envVarKeys []string) types.Set {
+	if value.IsUnknown() || value.IsNull() {
+		values := []attr.Value{}
+		seen := make(map[int64]bool)
+
+		for _, v := range getEnvList(envVarKeys) {
+			intValue, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+			if err != nil || seen[intValue] {
+				continue
+			}
+
+			seen[intValue] = true
+
+			values = append(values, types.Int64Value(intValue))
+		}
+
+		if len(values) == 0 {
+			return types.SetNull(types.Int64Type)
+		}
+
+		return types.SetValueMust(types.Int64Type, values)
+	}
+
+	return value
+}
+
 func getEnvList(envVarKeys []string) []string {
 	if v, ok := getMultiEnvVar(envVarKeys); ok {
 		return strings.Split(v, ";")
@@ -77,6 +104,36 @@ func getEnvBool(envVarKeys []string, defaultValue bool) bool {
 	return defaultValue
 }
 
+func GetInt64Value(value types.Int64, envVarKeys []string) types.Int64 {
+	if value.IsUnknown() || value.IsNull() {
+		if v, ok := getMultiEnvVar(envVarKeys); ok {
+			intValue, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+			if err == nil {
+				return types.Int64Value(intValue)
+			}
+		}
+
+		return types.Int64Null()
+	}
+
+	return value
+}
+
+func GetFloat64Value(value types.Float64, envVarKeys []string) types.Float64 {
+	if value.IsUnknown() || value.IsNull() {
+		if v, ok := getMultiEnvVar(envVarKeys); ok {
+			floatValue, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err == nil {
+				return types.Float64Value(floatValue)
+			}
+		}
+
+		return types.Float64Null()
+	}
+
+	return value
+}
+
 // getMultiEnvVar returns the value of the first environment variable that is set.
 func getMultiEnvVar(envVarNames []string) (string, bool) {
 	for _, envVarName := range envVarNames {
@@ -0,0 +1,66 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	putils "github.com/microsoft/terraform-provider-fabric/internal/provider/utils"
+)
+
+func TestUnit_GetSetInt64Values(t *testing.T) {
+	const envVarKey = "TEST_FABRIC_RETRY_STATUS_CODES"
+
+	testCases := []struct {
+		name     string
+		value    types.Set
+		envValue string
+		expected types.Set
+	}{
+		{
+			name:     "Configured value",
+			value:    types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(503)}),
+			envValue: "429",
+			expected: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(503)}),
+		},
+		{
+			name:     "Environment variable",
+			value:    types.SetNull(types.Int64Type),
+			envValue: "429; 503",
+			expected: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(429), types.Int64Value(503)}),
+		},
+		{
+			name:     "Duplicated entries",
+			value:    types.SetNull(types.Int64Type),
+			envValue: "429;429",
+			expected: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(429)}),
+		},
+		{
+			name:     "Invalid entries",
+			value:    types.SetUnknown(types.Int64Type),
+			envValue: "429;abc;",
+			expected: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(429)}),
+		},
+		{
+			name:     "No valid entries",
+			value:    types.SetNull(types.Int64Type),
+			envValue: "abc",
+			expected: types.SetNull(types.Int64Type),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(envVarKey, tc.envValue)
+
+			result := putils.GetSetInt64Values(tc.value, []string{envVarKey})
+
+			assert.True(t, tc.expected.Equal(result), "expected %s, got %s", tc.expected, result)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package apacheairflowjob
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/list"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+)
+
+func NewListResourceApacheAirflowJob() list.ListResource {
+	config := fabricitem.ListResourceFabricItems{
+		TypeInfo:       ItemTypeInfo,
+		FabricItemType: FabricItemType,
+	}
+
+	return fabricitem.NewListResourceFabricItems(config)
+}
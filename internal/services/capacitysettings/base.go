@@ -0,0 +1,18 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package capacitysettings
+
+import (
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+)
+
+var ItemTypeInfo = tftypeinfo.TFTypeInfo{ //nolint:gochecknoglobals
+	Name:           "Capacity Admin Settings",
+	Type:           "capacity_admin_settings",
+	DocsURL:        "https://learn.microsoft.com/fabric/admin/capacity-settings",
+	IsPreview:      true,
+	IsSPNSupported: true,
+}
+
+var possibleSKUValues = []string{"F2", "F4", "F8", "F16", "F32", "F64", "F128", "F256", "F512", "F1024", "F2048"} //nolint:gochecknoglobals
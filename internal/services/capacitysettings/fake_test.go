@@ -0,0 +1,89 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package capacitysettings_test
+
+import (
+	"context"
+	"net/http"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	fabadmin "github.com/microsoft/fabric-sdk-go/fabric/admin"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+)
+
+// fakeCapacityOverridesStore keeps the tenant setting overrides per capacity ID and setting name.
+var fakeCapacityOverridesStore = map[string]map[string]fabadmin.CapacityTenantSetting{}
+
+func NewRandomCapacityTenantSetting() fabadmin.CapacityTenantSetting {
+	return fabadmin.CapacityTenantSetting{
+		SettingName:              new(testhelp.RandomName()),
+		TenantSettingGroup:       new(testhelp.RandomName()),
+		Title:                    new(testhelp.RandomName()),
+		CanSpecifySecurityGroups: new(true),
+		Enabled:                  new(testhelp.RandomBool()),
+		DelegateToWorkspace:      new(false),
+	}
+}
+
+func getAllStoredCapacityOverrides(capacityID string) []fabadmin.CapacityTenantSetting {
+	overrides := make([]fabadmin.CapacityTenantSetting, 0, len(fakeCapacityOverridesStore[capacityID]))
+	for _, override := range fakeCapacityOverridesStore[capacityID] {
+		overrides = append(overrides, override)
+	}
+
+	return overrides
+}
+
+func fakeListCapacityTenantSettingsOverrides() func(capacityID string, options *fabadmin.TenantsClientListCapacityTenantSettingsOverridesByCapacityIDOptions) (resp azfake.PagerResponder[fabadmin.TenantsClientListCapacityTenantSettingsOverridesByCapacityIDResponse]) {
+	return func(capacityID string, _ *fabadmin.TenantsClientListCapacityTenantSettingsOverridesByCapacityIDOptions) (resp azfake.PagerResponder[fabadmin.TenantsClientListCapacityTenantSettingsOverridesByCapacityIDResponse]) {
+		resp = azfake.PagerResponder[fabadmin.TenantsClientListCapacityTenantSettingsOverridesByCapacityIDResponse]{}
+		resp.AddPage(
+			http.StatusOK,
+			fabadmin.TenantsClientListCapacityTenantSettingsOverridesByCapacityIDResponse{
+				CapacityTenantSettingsByCapacityIDResponse: fabadmin.CapacityTenantSettingsByCapacityIDResponse{Value: getAllStoredCapacityOverrides(capacityID)},
+			},
+			nil,
+		)
+
+		return resp
+	}
+}
+
+func fakeUpdateCapacityTenantSettingOverride() func(ctx context.Context, capacityID, tenantSettingName string, updateRequest fabadmin.UpdateCapacityTenantSettingOverrideRequest, options *fabadmin.TenantsClientUpdateCapacityTenantSettingOverrideOptions) (resp azfake.Responder[fabadmin.TenantsClientUpdateCapacityTenantSettingOverrideResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, capacityID, tenantSettingName string, updateRequest fabadmin.UpdateCapacityTenantSettingOverrideRequest, _ *fabadmin.TenantsClientUpdateCapacityTenantSettingOverrideOptions) (resp azfake.Responder[fabadmin.TenantsClientUpdateCapacityTenantSettingOverrideResponse], errResp azfake.ErrorResponder) {
+		if _, ok := fakeCapacityOverridesStore[capacityID]; !ok {
+			fakeCapacityOverridesStore[capacityID] = map[string]fabadmin.CapacityTenantSetting{}
+		}
+
+		override := fakeCapacityOverridesStore[capacityID][tenantSettingName]
+		override.SettingName = new(tenantSettingName)
+		override.Enabled = updateRequest.Enabled
+		override.DelegateToWorkspace = updateRequest.DelegateToWorkspace
+		override.EnabledSecurityGroups = updateRequest.EnabledSecurityGroups
+		override.ExcludedSecurityGroups = updateRequest.ExcludedSecurityGroups
+
+		fakeCapacityOverridesStore[capacityID][tenantSettingName] = override
+
+		resp.SetResponse(
+			http.StatusOK,
+			fabadmin.TenantsClientUpdateCapacityTenantSettingOverrideResponse{
+				UpdateCapacityTenantSettingOverrideResponse: fabadmin.UpdateCapacityTenantSettingOverrideResponse{Overrides: getAllStoredCapacityOverrides(capacityID)},
+			},
+			nil,
+		)
+
+		return resp, errResp
+	}
+}
+
+func fakeDeleteCapacityTenantSettingOverride() func(ctx context.Context, capacityID, tenantSettingName string, options *fabadmin.TenantsClientDeleteCapacityTenantSettingOverrideOptions) (resp azfake.Responder[fabadmin.TenantsClientDeleteCapacityTenantSettingOverrideResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, capacityID, tenantSettingName string, _ *fabadmin.TenantsClientDeleteCapacityTenantSettingOverrideOptions) (resp azfake.Responder[fabadmin.TenantsClientDeleteCapacityTenantSettingOverrideResponse], errResp azfake.ErrorResponder) {
+		delete(fakeCapacityOverridesStore[capacityID], tenantSettingName)
+
+		resp.SetResponse(http.StatusOK, fabadmin.TenantsClientDeleteCapacityTenantSettingOverrideResponse{}, nil)
+
+		return resp, errResp
+	}
+}
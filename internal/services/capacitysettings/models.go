@@ -0,0 +1,193 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package capacitysettings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fabadmin "github.com/microsoft/fabric-sdk-go/fabric/admin"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/armcapacity"
+)
+
+/*
+RESOURCE
+*/
+
+type resourceCapacityAdminSettingsModel struct {
+	ID                     customtypes.UUID                                              `tfsdk:"id"`
+	CapacityID             customtypes.UUID                                              `tfsdk:"capacity_id"`
+	AzureResourceID        types.String                                                  `tfsdk:"azure_resource_id"`
+	SKU                    types.String                                                  `tfsdk:"sku"`
+	Admins                 supertypes.SetValueOf[types.String]                           `tfsdk:"admins"`
+	TenantSettingOverrides supertypes.SetNestedObjectValueOf[tenantSettingOverrideModel] `tfsdk:"tenant_setting_overrides"`
+	Timeouts               timeouts.Value                                                `tfsdk:"timeouts"`
+}
+
+// manageARM reports whether any setting managed through Azure Resource Manager is configured.
+func (to *resourceCapacityAdminSettingsModel) manageARM() bool {
+	return !to.SKU.IsNull() || !to.Admins.IsNull()
+}
+
+func (to *resourceCapacityAdminSettingsModel) setTenantSettingOverrides(ctx context.Context, from []fabadmin.CapacityTenantSetting) diag.Diagnostics {
+	slice := make([]*tenantSettingOverrideModel, 0, len(from))
+
+	for _, entity := range from {
+		var entityModel tenantSettingOverrideModel
+
+		if diags := entityModel.set(ctx, entity); diags.HasError() {
+			return diags
+		}
+
+		slice = append(slice, &entityModel)
+	}
+
+	return to.TenantSettingOverrides.Set(ctx, slice)
+}
+
+func (to *resourceCapacityAdminSettingsModel) setARM(ctx context.Context, from *armcapacity.Capacity) diag.Diagnostics {
+	if !to.SKU.IsNull() && from.SKU != nil {
+		to.SKU = types.StringPointerValue(from.SKU.Name)
+	}
+
+	if to.Admins.IsNull() {
+		return nil
+	}
+
+	var members []string
+
+	if from.Properties != nil && from.Properties.Administration != nil {
+		members = from.Properties.Administration.Members
+	}
+
+	admins := make([]types.String, 0, len(members))
+	for _, member := range members {
+		admins = append(admins, types.StringValue(member))
+	}
+
+	return to.Admins.Set(ctx, admins)
+}
+
+type requestUpdateCapacity struct {
+	armcapacity.CapacityUpdate
+}
+
+func (to *requestUpdateCapacity) set(ctx context.Context, from resourceCapacityAdminSettingsModel) diag.Diagnostics {
+	if !from.SKU.IsNull() {
+		to.SKU = &armcapacity.SKU{
+			Name: from.SKU.ValueStringPointer(),
+			Tier: new(armcapacity.SKUTier),
+		}
+	}
+
+	if !from.Admins.IsNull() {
+		admins, diags := from.Admins.Get(ctx)
+		if diags.HasError() {
+			return diags
+		}
+
+		members := make([]string, 0, len(admins))
+		for _, admin := range admins {
+			members = append(members, admin.ValueString())
+		}
+
+		to.Properties = &armcapacity.CapacityProperties{
+			Administration: &armcapacity.Administration{
+				Members: members,
+			},
+		}
+	}
+
+	return nil
+}
+
+/*
+HELPER MODELS
+*/
+
+type tenantSettingOverrideModel struct {
+	SettingName              types.String                            `tfsdk:"setting_name"`
+	Enabled                  types.Bool                              `tfsdk:"enabled"`
+	DelegateToWorkspace      types.Bool                              `tfsdk:"delegate_to_workspace"`
+	EnabledSecurityGroupIDs  supertypes.SetValueOf[customtypes.UUID] `tfsdk:"enabled_security_group_ids"`
+	ExcludedSecurityGroupIDs supertypes.SetValueOf[customtypes.UUID] `tfsdk:"excluded_security_group_ids"`
+}
+
+func (to *tenantSettingOverrideModel) set(ctx context.Context, from fabadmin.CapacityTenantSetting) diag.Diagnostics {
+	to.SettingName = types.StringPointerValue(from.SettingName)
+	to.Enabled = types.BoolPointerValue(from.Enabled)
+	to.DelegateToWorkspace = types.BoolValue(from.DelegateToWorkspace != nil && *from.DelegateToWorkspace)
+
+	var diags diag.Diagnostics
+
+	to.EnabledSecurityGroupIDs, diags = securityGroupIDsValue(ctx, from.EnabledSecurityGroups)
+	if diags.HasError() {
+		return diags
+	}
+
+	to.ExcludedSecurityGroupIDs, diags = securityGroupIDsValue(ctx, from.ExcludedSecurityGroups)
+
+	return diags
+}
+
+func (to *tenantSettingOverrideModel) request(ctx context.Context) (fabadmin.UpdateCapacityTenantSettingOverrideRequest, diag.Diagnostics) {
+	var reqUpdate fabadmin.UpdateCapacityTenantSettingOverrideRequest
+
+	reqUpdate.Enabled = to.Enabled.ValueBoolPointer()
+	reqUpdate.DelegateToWorkspace = to.DelegateToWorkspace.ValueBoolPointer()
+
+	var diags diag.Diagnostics
+
+	reqUpdate.EnabledSecurityGroups, diags = toSecurityGroups(ctx, to.EnabledSecurityGroupIDs)
+	if diags.HasError() {
+		return reqUpdate, diags
+	}
+
+	reqUpdate.ExcludedSecurityGroups, diags = toSecurityGroups(ctx, to.ExcludedSecurityGroupIDs)
+
+	return reqUpdate, diags
+}
+
+// securityGroupIDsValue returns the security group IDs, or a null set when there are none to match an unset attribute.
+func securityGroupIDsValue(ctx context.Context, from []fabadmin.TenantSettingSecurityGroup) (supertypes.SetValueOf[customtypes.UUID], diag.Diagnostics) {
+	value := supertypes.NewSetValueOfNull[customtypes.UUID](ctx)
+
+	if len(from) == 0 {
+		return value, nil
+	}
+
+	ids := make([]customtypes.UUID, 0, len(from))
+	for _, sg := range from {
+		ids = append(ids, customtypes.NewUUIDPointerValue(sg.GraphID))
+	}
+
+	diags := value.Set(ctx, ids)
+
+	return value, diags
+}
+
+func toSecurityGroups(ctx context.Context, from supertypes.SetValueOf[customtypes.UUID]) ([]fabadmin.TenantSettingSecurityGroup, diag.Diagnostics) {
+	if from.IsNull() || from.IsUnknown() {
+		return nil, nil
+	}
+
+	ids, diags := from.Get(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	slice := make([]fabadmin.TenantSettingSecurityGroup, 0, len(ids))
+	for _, id := range ids {
+		slice = append(slice, fabadmin.TenantSettingSecurityGroup{
+			GraphID: id.ValueStringPointer(),
+		})
+	}
+
+	return slice, nil
+}
@@ -0,0 +1,440 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package capacitysettings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabadmin "github.com/microsoft/fabric-sdk-go/fabric/admin"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/armcapacity"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.ResourceWithConfigure   = (*resourceCapacityAdminSettings)(nil)
+	_ resource.ResourceWithImportState = (*resourceCapacityAdminSettings)(nil)
+	_ resource.ResourceWithIdentity    = (*resourceCapacityAdminSettings)(nil)
+)
+
+type resourceCapacityAdminSettings struct {
+	pConfigData *pconfig.ProviderData
+	client      *fabadmin.TenantsClient
+	TypeInfo    tftypeinfo.TFTypeInfo
+}
+
+func NewResourceCapacityAdminSettings() resource.Resource {
+	return &resourceCapacityAdminSettings{
+		TypeInfo: ItemTypeInfo,
+	}
+}
+
+func (r *resourceCapacityAdminSettings) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeInfo.FullTypeName(false)
+}
+
+func (r *resourceCapacityAdminSettings) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = itemSchema().GetResource(ctx)
+}
+
+func (r *resourceCapacityAdminSettings) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The Capacity ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+		},
+	}
+}
+
+func (r *resourceCapacityAdminSettings) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorResourceConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	r.pConfigData = pConfigData
+	r.client = fabadmin.NewClientFactoryWithClient(*pConfigData.FabricClient).NewTenantsClient()
+
+	if resp.Diagnostics.Append(fabricitem.IsPreviewMode(r.TypeInfo.Name, r.TypeInfo.IsPreview, r.pConfigData.Preview)...); resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceCapacityAdminSettings) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan resourceCapacityAdminSettingsModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	state := resourceCapacityAdminSettingsModel{
+		CapacityID:             plan.CapacityID,
+		TenantSettingOverrides: supertypes.NewSetNestedObjectValueOfNull[tenantSettingOverrideModel](ctx),
+	}
+
+	// The planned set is authoritative, overrides already existing on the capacity and not planned are removed.
+	if !plan.TenantSettingOverrides.IsNull() {
+		state.TenantSettingOverrides = supertypes.NewSetNestedObjectValueOfSlice(ctx, []*tenantSettingOverrideModel{})
+
+		if resp.Diagnostics.Append(r.get(ctx, &state)...); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if resp.Diagnostics.Append(r.applyTenantSettingOverrides(ctx, plan, state, utils.OperationCreate)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.manageARM() {
+		if resp.Diagnostics.Append(r.updateARM(ctx, &plan, utils.OperationCreate)...); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.ID = plan.CapacityID
+
+	if resp.Diagnostics.Append(r.get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
+
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceCapacityAdminSettings) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "start",
+	})
+
+	var state resourceCapacityAdminSettingsModel
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	diags = r.get(ctx, &state)
+	if utils.IsErrNotFound(state.CapacityID.ValueString(), &diags, fabcore.ErrCapacity.CapacityNotFound) {
+		resp.State.RemoveResource(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		return
+	}
+
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
+
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceCapacityAdminSettings) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan, state resourceCapacityAdminSettingsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if resp.Diagnostics.Append(r.applyTenantSettingOverrides(ctx, plan, state, utils.OperationUpdate)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.manageARM() && (!plan.SKU.Equal(state.SKU) || !plan.Admins.Equal(state.Admins)) {
+		if resp.Diagnostics.Append(r.updateARM(ctx, &plan, utils.OperationUpdate)...); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.ID = plan.CapacityID
+
+	if resp.Diagnostics.Append(r.get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
+
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceCapacityAdminSettings) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "start",
+	})
+
+	var state resourceCapacityAdminSettingsModel
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if !state.TenantSettingOverrides.IsNull() {
+		overrides, diags := state.TenantSettingOverrides.Get(ctx)
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
+
+		for _, override := range overrides {
+			_, err := r.client.DeleteCapacityTenantSettingOverride(ctx, state.CapacityID.ValueString(), override.SettingName.ValueString(), nil)
+			if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationDelete, nil)...); resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "end",
+	})
+}
+
+func (r *resourceCapacityAdminSettings) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "IMPORT", map[string]any{
+		"action": "start",
+	})
+	importID, diags := utils.GetImportID(ctx, req, "id")
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	capacityID, diags := customtypes.NewUUIDValueMust(importID)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), capacityID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("capacity_id"), capacityID)...)
+
+	// An empty (not null) set makes the next read populate the existing tenant setting overrides.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tenant_setting_overrides"), supertypes.NewSetNestedObjectValueOfSlice(ctx, []*tenantSettingOverrideModel{}))...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
+
+	tflog.Debug(ctx, "IMPORT", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceCapacityAdminSettings) get(ctx context.Context, model *resourceCapacityAdminSettingsModel) diag.Diagnostics {
+	if !model.TenantSettingOverrides.IsNull() {
+		tflog.Trace(ctx, "getting Capacity Tenant Setting Overrides")
+
+		respList, err := r.client.ListCapacityTenantSettingsOverridesByCapacityID(ctx, model.CapacityID.ValueString(), nil)
+		if diags := utils.GetDiagsFromError(ctx, err, utils.OperationRead, fabcore.ErrCapacity.CapacityNotFound); diags.HasError() {
+			return diags
+		}
+
+		if diags := model.setTenantSettingOverrides(ctx, respList); diags.HasError() {
+			return diags
+		}
+	}
+
+	if model.AzureResourceID.IsNull() || !model.manageARM() {
+		return nil
+	}
+
+	tflog.Trace(ctx, "getting Capacity from Azure Resource Manager")
+
+	client, diags := r.armClient()
+	if diags.HasError() {
+		return diags
+	}
+
+	capacity, err := client.Get(ctx, model.AzureResourceID.ValueString())
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationRead, nil); diags.HasError() {
+		return diags
+	}
+
+	return model.setARM(ctx, capacity)
+}
+
+// applyTenantSettingOverrides removes the overrides no longer planned and upserts the planned ones.
+// A null planned set leaves the overrides of the capacity unmanaged.
+func (r *resourceCapacityAdminSettings) applyTenantSettingOverrides(
+	ctx context.Context,
+	plan, state resourceCapacityAdminSettingsModel,
+	operation utils.Operation,
+) diag.Diagnostics {
+	if plan.TenantSettingOverrides.IsNull() {
+		return nil
+	}
+
+	capacityID := plan.CapacityID.ValueString()
+
+	planned, diags := plan.TenantSettingOverrides.Get(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	plannedNames := make(map[string]bool, len(planned))
+	for _, override := range planned {
+		plannedNames[override.SettingName.ValueString()] = true
+	}
+
+	if !state.TenantSettingOverrides.IsNull() {
+		current, diags := state.TenantSettingOverrides.Get(ctx)
+		if diags.HasError() {
+			return diags
+		}
+
+		for _, override := range current {
+			if plannedNames[override.SettingName.ValueString()] {
+				continue
+			}
+
+			tflog.Trace(ctx, "removing Capacity Tenant Setting Override", map[string]any{
+				"setting_name": override.SettingName.ValueString(),
+			})
+
+			_, err := r.client.DeleteCapacityTenantSettingOverride(ctx, capacityID, override.SettingName.ValueString(), nil)
+			if diags := utils.GetDiagsFromError(ctx, err, operation, nil); diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	for _, override := range planned {
+		tflog.Trace(ctx, "updating Capacity Tenant Setting Override", map[string]any{
+			"setting_name": override.SettingName.ValueString(),
+		})
+
+		reqUpdate, diags := override.request(ctx)
+		if diags.HasError() {
+			return diags
+		}
+
+		_, err := r.client.UpdateCapacityTenantSettingOverride(ctx, capacityID, override.SettingName.ValueString(), reqUpdate, nil)
+		if diags := utils.GetDiagsFromError(ctx, err, operation, nil); diags.HasError() {
+			return diags
+		}
+	}
+
+	return nil
+}
+
+func (r *resourceCapacityAdminSettings) updateARM(ctx context.Context, model *resourceCapacityAdminSettingsModel, operation utils.Operation) diag.Diagnostics {
+	tflog.Trace(ctx, "updating Capacity in Azure Resource Manager")
+
+	client, diags := r.armClient()
+	if diags.HasError() {
+		return diags
+	}
+
+	var reqUpdate requestUpdateCapacity
+
+	if diags := reqUpdate.set(ctx, *model); diags.HasError() {
+		return diags
+	}
+
+	_, err := client.Update(ctx, model.AzureResourceID.ValueString(), reqUpdate.CapacityUpdate)
+
+	return utils.GetDiagsFromError(ctx, err, operation, nil)
+}
+
+func (r *resourceCapacityAdminSettings) armClient() (*armcapacity.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	client, err := armcapacity.NewClient(r.pConfigData.Credential, r.pConfigData.Cloud, r.pConfigData.Version, &r.pConfigData.ClientOptions)
+	if err != nil {
+		diags.AddError(common.ErrorAzureResourceManagerHeader, err.Error())
+	}
+
+	return client, diags
+}
@@ -0,0 +1,245 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package capacitysettings_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	fabadmin "github.com/microsoft/fabric-sdk-go/fabric/admin"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testResourceItemFQN, testResourceItemHeader = testhelp.TFResource(common.ProviderTypeName, itemTypeInfo.Type, "test")
+
+func TestUnit_CapacityAdminSettingsResource_Attributes(t *testing.T) {
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no attributes
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{},
+			),
+			ExpectError: regexp.MustCompile(`The argument "capacity_id" is required, but no definition was found.`),
+		},
+		// error - unexpected attribute
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"capacity_id":     testhelp.RandomUUID(),
+					"unexpected_attr": "test",
+				},
+			),
+			ExpectError: regexp.MustCompile(`An argument named "unexpected_attr" is not expected here`),
+		},
+		// error - invalid UUID - capacity_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"capacity_id": "invalid uuid",
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - invalid Azure resource ID
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"capacity_id":       testhelp.RandomUUID(),
+					"azure_resource_id": "/subscriptions/" + testhelp.RandomUUID() + "/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/test",
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorAttValueMatch),
+		},
+		// error - sku without azure_resource_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"capacity_id": testhelp.RandomUUID(),
+					"sku":         "F2",
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorAttComboInvalid),
+		},
+	}))
+}
+
+func TestUnit_CapacityAdminSettingsResource_CRUD(t *testing.T) {
+	capacityID := testhelp.RandomUUID()
+	entity := NewRandomCapacityTenantSetting()
+	securityGroupID := testhelp.RandomUUID()
+
+	fakes.FakeServer.ServerFactory.Admin.TenantsServer.NewListCapacityTenantSettingsOverridesByCapacityIDPager = fakeListCapacityTenantSettingsOverrides()
+	fakes.FakeServer.ServerFactory.Admin.TenantsServer.UpdateCapacityTenantSettingOverride = fakeUpdateCapacityTenantSettingOverride()
+	fakes.FakeServer.ServerFactory.Admin.TenantsServer.DeleteCapacityTenantSettingOverride = fakeDeleteCapacityTenantSettingOverride()
+
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"capacity_id": capacityID,
+					"tenant_setting_overrides": []map[string]any{
+						{
+							"setting_name":               *entity.SettingName,
+							"enabled":                    *entity.Enabled,
+							"enabled_security_group_ids": []string{securityGroupID},
+						},
+					},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "id", capacityID),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "capacity_id", capacityID),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "tenant_setting_overrides.#", "1"),
+				resource.TestCheckResourceAttrPtr(testResourceItemFQN, "tenant_setting_overrides.0.setting_name", entity.SettingName),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "tenant_setting_overrides.0.enabled", strconv.FormatBool(*entity.Enabled)),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "tenant_setting_overrides.0.delegate_to_workspace", "false"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "tenant_setting_overrides.0.enabled_security_group_ids.0", securityGroupID),
+			),
+		},
+		// Update and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"capacity_id": capacityID,
+					"tenant_setting_overrides": []map[string]any{
+						{
+							"setting_name":          *entity.SettingName,
+							"enabled":               !*entity.Enabled,
+							"delegate_to_workspace": true,
+						},
+					},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "tenant_setting_overrides.#", "1"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "tenant_setting_overrides.0.enabled", strconv.FormatBool(!*entity.Enabled)),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "tenant_setting_overrides.0.delegate_to_workspace", "true"),
+				resource.TestCheckNoResourceAttr(testResourceItemFQN, "tenant_setting_overrides.0.enabled_security_group_ids"),
+			),
+		},
+	}))
+}
+
+func TestUnit_CapacityAdminSettingsResource_CRUD_ExistingOverrides(t *testing.T) {
+	capacityID := testhelp.RandomUUID()
+	entity := NewRandomCapacityTenantSetting()
+	existing := NewRandomCapacityTenantSetting()
+
+	fakes.FakeServer.ServerFactory.Admin.TenantsServer.NewListCapacityTenantSettingsOverridesByCapacityIDPager = fakeListCapacityTenantSettingsOverrides()
+	fakes.FakeServer.ServerFactory.Admin.TenantsServer.UpdateCapacityTenantSettingOverride = fakeUpdateCapacityTenantSettingOverride()
+	fakes.FakeServer.ServerFactory.Admin.TenantsServer.DeleteCapacityTenantSettingOverride = fakeDeleteCapacityTenantSettingOverride()
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// Create and Read - the override existing before create is not planned and is removed
+		{
+			ResourceName: testResourceItemFQN,
+			PreConfig: func() {
+				fakeCapacityOverridesStore[capacityID] = map[string]fabadmin.CapacityTenantSetting{
+					*existing.SettingName: existing,
+				}
+			},
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"capacity_id": capacityID,
+					"tenant_setting_overrides": []map[string]any{
+						{
+							"setting_name": *entity.SettingName,
+							"enabled":      *entity.Enabled,
+						},
+					},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "tenant_setting_overrides.#", "1"),
+				resource.TestCheckResourceAttrPtr(testResourceItemFQN, "tenant_setting_overrides.0.setting_name", entity.SettingName),
+				func(_ *terraform.State) error {
+					if _, ok := fakeCapacityOverridesStore[capacityID][*existing.SettingName]; ok {
+						return fmt.Errorf("override %s not removed", *existing.SettingName)
+					}
+
+					return nil
+				},
+			),
+		},
+	}))
+}
+
+func TestAcc_CapacityAdminSettingsResource_CRUD(t *testing.T) {
+	capacity := testhelp.WellKnown()["Capacity"].(map[string]any)
+	capacityID := capacity["id"].(string)
+
+	entity := testhelp.WellKnown()["TenantSettings"].(map[string]any)
+	settingName := entity["settingName"].(string)
+	securityGroupID := entity["securityGroupId"].(string)
+
+	resource.Test(t, testhelp.NewTestAccCase(t, &testResourceItemFQN, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"capacity_id": capacityID,
+					"tenant_setting_overrides": []map[string]any{
+						{
+							"setting_name":               settingName,
+							"enabled":                    true,
+							"enabled_security_group_ids": []string{securityGroupID},
+						},
+					},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "capacity_id", capacityID),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "tenant_setting_overrides.0.setting_name", settingName),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "tenant_setting_overrides.0.enabled", "true"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "tenant_setting_overrides.0.enabled_security_group_ids.0", securityGroupID),
+			),
+		},
+		// Update and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"capacity_id": capacityID,
+					"tenant_setting_overrides": []map[string]any{
+						{
+							"setting_name": settingName,
+							"enabled":      false,
+						},
+					},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "tenant_setting_overrides.0.enabled", "false"),
+			),
+		},
+	}))
+}
@@ -0,0 +1,155 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package capacitysettings
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema" //revive:disable-line:import-alias-naming
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/armcapacity"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+)
+
+func itemSchema() superschema.Schema {
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: fabricitem.NewResourceMarkdownDescription(ItemTypeInfo, false) +
+				"\n\nManages the admin configuration of a Fabric Capacity: the delegated tenant setting overrides (Fabric Admin API), and the capacity administrators and SKU (Azure Resource Manager)." +
+				"\n\n-> Each setting group is only managed when configured. `admins` and `sku` require `azure_resource_id` and an identity with write access to the capacity in Azure (e.g. Contributor)." +
+				"\n\n~> Deleting this resource removes the managed tenant setting overrides. The capacity administrators and SKU are left unchanged." +
+				"\n\n~> Capacity workload settings are not exposed by the Fabric public API and cannot be managed by this resource.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The " + ItemTypeInfo.Name + " ID (same as the Capacity ID).",
+					CustomType:          customtypes.UUIDType{},
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"capacity_id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The Capacity ID.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"azure_resource_id": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The Azure resource ID of the Capacity, in the `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Fabric/capacities/{capacityName}` format. Required to manage `admins` and `sku`.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(armcapacity.ResourceIDRegex, "Value must be the Azure resource ID of a Microsoft.Fabric/capacities resource."),
+					},
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"sku": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The SKU of the Capacity, to scale it up or down.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(possibleSKUValues...),
+						stringvalidator.AlsoRequires(path.MatchRoot("azure_resource_id")),
+					},
+				},
+			},
+			"admins": superschema.SuperSetAttribute{
+				Resource: &schemaR.SetAttribute{
+					MarkdownDescription: "The complete set of Capacity administrators, as user principal names (UPN) or Entra object IDs of service principals.",
+					Optional:            true,
+					CustomType: supertypes.SetTypeOf[types.String]{
+						SetType: basetypes.SetType{
+							ElemType: types.StringType,
+						},
+					},
+					ElementType: types.StringType,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+						setvalidator.AlsoRequires(path.MatchRoot("azure_resource_id")),
+					},
+				},
+			},
+			"tenant_setting_overrides": superschema.SuperSetNestedAttributeOf[tenantSettingOverrideModel]{
+				Resource: &schemaR.SetNestedAttribute{
+					MarkdownDescription: "The complete set of tenant setting overrides delegated to the Capacity. Overrides not in the set are removed.",
+					Optional:            true,
+				},
+				Attributes: superschema.Attributes{
+					"setting_name": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The name of the tenant setting, as returned by the `fabric_tenant_settings` data-source.",
+							Required:            true,
+						},
+					},
+					"enabled": superschema.BoolAttribute{
+						Resource: &schemaR.BoolAttribute{
+							MarkdownDescription: "The status of the tenant setting on the Capacity.",
+							Required:            true,
+						},
+					},
+					"delegate_to_workspace": superschema.BoolAttribute{
+						Resource: &schemaR.BoolAttribute{
+							MarkdownDescription: "Whether the tenant setting can be overridden by the Workspace admins.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+					"enabled_security_group_ids": superschema.SuperSetAttribute{
+						Resource: &schemaR.SetAttribute{
+							MarkdownDescription: "The set of security group IDs the tenant setting is enabled for. When not set, the tenant setting applies to the entire organization.",
+							Optional:            true,
+							CustomType: supertypes.SetTypeOf[customtypes.UUID]{
+								SetType: basetypes.SetType{
+									ElemType: customtypes.UUIDType{},
+								},
+							},
+							ElementType: customtypes.UUIDType{},
+						},
+					},
+					"excluded_security_group_ids": superschema.SuperSetAttribute{
+						Resource: &schemaR.SetAttribute{
+							MarkdownDescription: "The set of security group IDs excluded from the tenant setting.",
+							Optional:            true,
+							CustomType: supertypes.SetTypeOf[customtypes.UUID]{
+								SetType: basetypes.SetType{
+									ElemType: customtypes.UUIDType{},
+								},
+							},
+							ElementType: customtypes.UUIDType{},
+						},
+					},
+				},
+			},
+			"timeouts": superschema.TimeoutAttribute{
+				Resource: &superschema.ResourceTimeoutAttribute{
+					Create: true,
+					Read:   true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package capacitystate
+
+import (
+	"context"
+	"fmt"
+	"time"
+	_ "time/tzdata" // embed the time zone database for schedules evaluated on hosts without one
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/armcapacity"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.ActionWithConfigure        = (*actionCapacityState)(nil)
+	_ action.ActionWithConfigValidators = (*actionCapacityState)(nil)
+	_ action.ActionWithValidateConfig   = (*actionCapacityState)(nil)
+)
+
+type actionCapacityState struct {
+	pConfigData *pconfig.ProviderData
+	TypeInfo    tftypeinfo.TFTypeInfo
+}
+
+func NewActionCapacityState() action.Action {
+	return &actionCapacityState{
+		TypeInfo: ItemTypeInfo,
+	}
+}
+
+func (a *actionCapacityState) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = a.TypeInfo.FullTypeName(false)
+}
+
+func (a *actionCapacityState) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = itemSchema()
+}
+
+func (a *actionCapacityState) ConfigValidators(_ context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.ExactlyOneOf(
+			path.MatchRoot("state"),
+			path.MatchRoot("schedule"),
+		),
+	}
+}
+
+func (a *actionCapacityState) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config actionCapacityStateModel
+
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &config)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Schedule.IsNull() || config.Schedule.IsUnknown() {
+		return
+	}
+
+	var schedule scheduleModel
+
+	if resp.Diagnostics.Append(config.Schedule.As(ctx, &schedule, basetypes.ObjectAsOptions{})...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if schedule.TimeZone.IsUnknown() || schedule.ActiveWindows.IsUnknown() {
+		return
+	}
+
+	_, diags := schedule.toSchedule(ctx)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (a *actionCapacityState) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorActionConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	a.pConfigData = pConfigData
+
+	if resp.Diagnostics.Append(fabricitem.IsPreviewMode(a.TypeInfo.Name, a.TypeInfo.IsPreview, a.pConfigData.Preview)...); resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (a *actionCapacityState) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Debug(ctx, "INVOKE", map[string]any{
+		"action": "start",
+	})
+
+	var config actionCapacityStateModel
+
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &config)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, a.pConfigData.Timeout)
+	defer cancel()
+
+	desired, diags := config.desiredState(ctx, time.Now())
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := armcapacity.NewClient(a.pConfigData.Credential, a.pConfigData.Cloud, a.pConfigData.Version, &a.pConfigData.ClientOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(common.ErrorAzureResourceManagerHeader, err.Error())
+
+		return
+	}
+
+	resourceID := config.AzureResourceID.ValueString()
+
+	capacity, err := client.Get(ctx, resourceID)
+	if err != nil {
+		resp.Diagnostics.AddError(common.ErrorAzureResourceManagerHeader, fmt.Sprintf("Unable to read the Capacity %s: %s", resourceID, err))
+
+		return
+	}
+
+	name := resourceID
+	if capacity.Name != nil {
+		name = *capacity.Name
+	}
+
+	var current armcapacity.State
+	if capacity.Properties != nil && capacity.Properties.State != nil {
+		current = *capacity.Properties.State
+	}
+
+	tflog.Debug(ctx, "INVOKE", map[string]any{
+		"resource_id":   resourceID,
+		"current_state": current,
+		"desired_state": desired,
+	})
+
+	if current == desired {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Capacity %s is already %s", name, desired),
+		})
+
+		return
+	}
+
+	switch desired {
+	case armcapacity.StatePaused:
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Pausing Capacity %s (current state: %s)", name, current),
+		})
+
+		err = client.Suspend(ctx, resourceID)
+	default:
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Resuming Capacity %s (current state: %s)", name, current),
+		})
+
+		err = client.Resume(ctx, resourceID)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(common.ErrorAzureResourceManagerHeader, fmt.Sprintf("Unable to change the state of the Capacity %s to %s: %s", resourceID, desired, err))
+
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Capacity %s is %s", name, desired),
+	})
+
+	tflog.Debug(ctx, "INVOKE", map[string]any{
+		"action": "end",
+	})
+}
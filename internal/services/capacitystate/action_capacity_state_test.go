@@ -0,0 +1,112 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package capacitystate_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testActionItemFQN, testActionItemHeader = testhelp.TFAction(common.ProviderTypeName, itemTypeInfo.Type, "test")
+
+func TestUnit_CapacityStateAction_Attributes(t *testing.T) {
+	azureResourceID := "/subscriptions/" + testhelp.RandomUUID() + "/resourceGroups/rg/providers/Microsoft.Fabric/capacities/" + testhelp.RandomName()
+
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testActionItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no attributes
+		{
+			Config: testhelp.TFActionConfig(
+				testActionItemHeader,
+				map[string]any{},
+			),
+			ExpectError: regexp.MustCompile(`The argument "azure_resource_id" is required, but no definition was found.`),
+		},
+		// error - invalid Azure resource ID
+		{
+			Config: testhelp.TFActionConfig(
+				testActionItemHeader,
+				map[string]any{
+					"azure_resource_id": "/subscriptions/" + testhelp.RandomUUID() + "/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/test",
+					"state":             "Paused",
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorAttValueMatch),
+		},
+		// error - neither state nor schedule
+		{
+			Config: testhelp.TFActionConfig(
+				testActionItemHeader,
+				map[string]any{
+					"azure_resource_id": azureResourceID,
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorAttComboInvalid),
+		},
+		// error - both state and schedule
+		{
+			Config: testhelp.TFActionConfig(
+				testActionItemHeader,
+				map[string]any{
+					"azure_resource_id": azureResourceID,
+					"state":             "Active",
+					"schedule": map[string]any{
+						"active_windows": []map[string]any{
+							{
+								"days":  []string{"Monday"},
+								"start": "08:00",
+								"end":   "18:00",
+							},
+						},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorAttComboInvalid),
+		},
+		// error - invalid time zone
+		{
+			Config: testhelp.TFActionConfig(
+				testActionItemHeader,
+				map[string]any{
+					"azure_resource_id": azureResourceID,
+					"schedule": map[string]any{
+						"time_zone": "Mars/Olympus_Mons",
+						"active_windows": []map[string]any{
+							{
+								"days":  []string{"Monday"},
+								"start": "08:00",
+								"end":   "18:00",
+							},
+						},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorInvalidValue),
+		},
+		// error - invalid time of day
+		{
+			Config: testhelp.TFActionConfig(
+				testActionItemHeader,
+				map[string]any{
+					"azure_resource_id": azureResourceID,
+					"schedule": map[string]any{
+						"active_windows": []map[string]any{
+							{
+								"days":  []string{"Monday"},
+								"start": "8:00",
+								"end":   "24:00",
+							},
+						},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorAttValueMatch),
+		},
+	}))
+}
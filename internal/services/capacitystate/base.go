@@ -0,0 +1,19 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package capacitystate
+
+import (
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/armcapacity"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+)
+
+var ItemTypeInfo = tftypeinfo.TFTypeInfo{ //nolint:gochecknoglobals
+	Name:           "Capacity State",
+	Type:           "capacity_state",
+	DocsURL:        "https://learn.microsoft.com/fabric/enterprise/pause-resume",
+	IsPreview:      true,
+	IsSPNSupported: true,
+}
+
+var possibleStateValues = []armcapacity.State{armcapacity.StateActive, armcapacity.StatePaused} //nolint:gochecknoglobals
@@ -0,0 +1,137 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package capacitystate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/armcapacity"
+)
+
+type actionCapacityStateModel struct {
+	AzureResourceID types.String `tfsdk:"azure_resource_id"`
+	State           types.String `tfsdk:"state"`
+	Schedule        types.Object `tfsdk:"schedule"`
+}
+
+// desiredState returns the state the capacity must be in at the given time.
+func (to *actionCapacityStateModel) desiredState(ctx context.Context, now time.Time) (armcapacity.State, diag.Diagnostics) {
+	if !to.State.IsNull() {
+		return armcapacity.State(to.State.ValueString()), nil
+	}
+
+	var schedule scheduleModel
+
+	if diags := to.Schedule.As(ctx, &schedule, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return "", diags
+	}
+
+	result, diags := schedule.toSchedule(ctx)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	return result.DesiredState(now), nil
+}
+
+type scheduleModel struct {
+	TimeZone      types.String `tfsdk:"time_zone"`
+	ActiveWindows types.List   `tfsdk:"active_windows"`
+}
+
+func (to *scheduleModel) toSchedule(ctx context.Context) (armcapacity.Schedule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := armcapacity.Schedule{
+		Location: time.UTC,
+	}
+
+	if !to.TimeZone.IsNull() && !to.TimeZone.IsUnknown() {
+		location, err := time.LoadLocation(to.TimeZone.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("schedule").AtName("time_zone"),
+				common.ErrorInvalidValue,
+				fmt.Sprintf("Unable to load the %q time zone: %s", to.TimeZone.ValueString(), err),
+			)
+
+			return result, diags
+		}
+
+		result.Location = location
+	}
+
+	var windows []activeWindowModel
+
+	if diags.Append(to.ActiveWindows.ElementsAs(ctx, &windows, false)...); diags.HasError() {
+		return result, diags
+	}
+
+	for i, window := range windows {
+		w, d := window.toWindow(ctx, path.Root("schedule").AtName("active_windows").AtListIndex(i))
+		if diags.Append(d...); diags.HasError() {
+			return result, diags
+		}
+
+		result.Windows = append(result.Windows, w)
+	}
+
+	return result, diags
+}
+
+type activeWindowModel struct {
+	Days  types.Set    `tfsdk:"days"`
+	Start types.String `tfsdk:"start"`
+	End   types.String `tfsdk:"end"`
+}
+
+func (to *activeWindowModel) toWindow(ctx context.Context, attrPath path.Path) (armcapacity.Window, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := armcapacity.Window{}
+
+	var days []string
+
+	if diags.Append(to.Days.ElementsAs(ctx, &days, false)...); diags.HasError() {
+		return result, diags
+	}
+
+	for _, day := range days {
+		weekday, err := armcapacity.ParseWeekday(day)
+		if err != nil {
+			diags.AddAttributeError(attrPath.AtName("days"), common.ErrorInvalidValue, err.Error())
+
+			return result, diags
+		}
+
+		result.Days = append(result.Days, weekday)
+	}
+
+	start, err := armcapacity.ParseClock(to.Start.ValueString())
+	if err != nil {
+		diags.AddAttributeError(attrPath.AtName("start"), common.ErrorInvalidValue, err.Error())
+
+		return result, diags
+	}
+
+	end, err := armcapacity.ParseClock(to.End.ValueString())
+	if err != nil {
+		diags.AddAttributeError(attrPath.AtName("end"), common.ErrorInvalidValue, err.Error())
+
+		return result, diags
+	}
+
+	result.Start = start
+	result.End = end
+
+	return result, diags
+}
@@ -0,0 +1,90 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package capacitystate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/armcapacity"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+var clockRegex = regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`) //nolint:gochecknoglobals
+
+func itemSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "The " + ItemTypeInfo.Name + " action allows you to pause or resume a Fabric [Capacity](" + ItemTypeInfo.DocsURL + ") through Azure Resource Manager, using the provider credential." +
+			"\n\nThe target state is either set explicitly with `state`, or derived from a `schedule` at invocation time: the capacity is resumed within the active windows and paused outside of them. " +
+			"To suspend non-production capacities on a schedule, invoke the action periodically (e.g. `terraform apply -invoke=action.fabric_capacity_state.example` from a scheduled pipeline)." +
+			"\n\n-> The identity must have write access to the capacity in Azure (e.g. Contributor). The action does nothing when the capacity is already in the target state." +
+			"\n\n~> This action is in **preview**. To use it, you must explicitly enable the `preview` mode in the provider level configuration.",
+		Attributes: map[string]schema.Attribute{
+			"azure_resource_id": schema.StringAttribute{
+				MarkdownDescription: "The Azure resource ID of the Capacity, in the `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Fabric/capacities/{capacityName}` format.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(armcapacity.ResourceIDRegex, "Value must be the Azure resource ID of a Microsoft.Fabric/capacities resource."),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The target state of the Capacity. Value must be one of : `Active`, `Paused`. Exactly one of `state` or `schedule` must be set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.ConvertEnumsToStringSlices(possibleStateValues, false)...),
+				},
+			},
+			"schedule": schema.SingleNestedAttribute{
+				MarkdownDescription: "The schedule the Capacity must be active on. Outside of the active windows, the Capacity is paused.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"time_zone": schema.StringAttribute{
+						MarkdownDescription: "The IANA time zone of the active windows, e.g. `Europe/Paris`. Defaults to `UTC`.",
+						Optional:            true,
+					},
+					"active_windows": schema.ListNestedAttribute{
+						MarkdownDescription: "The recurring windows the Capacity must be active in.",
+						Required:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"days": schema.SetAttribute{
+									MarkdownDescription: "The days the window starts on. Value must be one of : `Sunday`, `Monday`, `Tuesday`, `Wednesday`, `Thursday`, `Friday`, `Saturday`.",
+									Required:            true,
+									ElementType:         types.StringType,
+									Validators: []validator.Set{
+										setvalidator.SizeAtLeast(1),
+										setvalidator.ValueStringsAre(stringvalidator.OneOf(armcapacity.PossibleWeekdayValues()...)),
+									},
+								},
+								"start": schema.StringAttribute{
+									MarkdownDescription: "The start time of the window, in the `HH:MM` 24-hour format.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(clockRegex, "Value must be a time of day in the HH:MM 24-hour format."),
+									},
+								},
+								"end": schema.StringAttribute{
+									MarkdownDescription: "The end time of the window, in the `HH:MM` 24-hour format. An end time before the start time ends the window on the next day.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(clockRegex, "Value must be a time of day in the HH:MM 24-hour format."),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package capacitywa
+
+import (
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+)
+
+var ItemTypeInfo = tftypeinfo.TFTypeInfo{ //nolint:gochecknoglobals
+	Name:           "Capacity Workspace Assignment",
+	Type:           "capacity_workspace_assignment",
+	Names:          "Capacity Workspace Assignments",
+	Types:          "capacity_workspace_assignments",
+	DocsURL:        "https://learn.microsoft.com/fabric/fundamentals/workspaces#license-mode",
+	IsPreview:      true,
+	IsSPNSupported: true,
+}
+
+const (
+	defaultMaxConcurrency = 5
+	minMaxConcurrency     = 1
+	maxMaxConcurrency     = 20
+)
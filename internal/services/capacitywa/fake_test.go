@@ -0,0 +1,136 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package capacitywa_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	fabfake "github.com/microsoft/fabric-sdk-go/fabric/fake"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+// fakeAssignmentStore keeps the Workspaces and Capacities of the tests. Workspaces are assigned concurrently, hence the lock.
+type fakeAssignmentStore struct {
+	mu               sync.Mutex
+	workspaces       map[string]fabcore.WorkspaceInfo
+	capacities       map[string]fabcore.Capacity
+	failedWorkspaces map[string]bool
+}
+
+var fakeStore = &fakeAssignmentStore{ //nolint:gochecknoglobals
+	workspaces:       map[string]fabcore.WorkspaceInfo{},
+	capacities:       map[string]fabcore.Capacity{},
+	failedWorkspaces: map[string]bool{},
+}
+
+func fakeTestUpsertCapacity(entity fabcore.Capacity) {
+	fakeStore.mu.Lock()
+	defer fakeStore.mu.Unlock()
+
+	fakeStore.capacities[*entity.ID] = entity
+}
+
+func fakeTestUpsertWorkspace(entity fabcore.WorkspaceInfo) {
+	fakeStore.mu.Lock()
+	defer fakeStore.mu.Unlock()
+
+	fakeStore.workspaces[*entity.ID] = entity
+}
+
+func fakeTestSetWorkspaceFailure(workspaceID string, failed bool) {
+	fakeStore.mu.Lock()
+	defer fakeStore.mu.Unlock()
+
+	fakeStore.failedWorkspaces[workspaceID] = failed
+}
+
+func NewRandomCapacityInRegion(region fabcore.CapacityRegion) fabcore.Capacity {
+	entity := fakes.NewRandomCapacity()
+	entity.Region = to.Ptr(string(region))
+
+	return entity
+}
+
+func NewRandomWorkspaceOnCapacity(capacity fabcore.Capacity) fabcore.WorkspaceInfo {
+	entity := fakes.NewRandomWorkspaceInfo(capacity.ID)
+	entity.CapacityRegion = to.Ptr(fabcore.CapacityRegion(*capacity.Region))
+
+	return entity
+}
+
+func configureFakes() {
+	fakes.FakeServer.ServerFactory.Core.CapacitiesServer.GetCapacity = fakeGetCapacity()
+	fakes.FakeServer.ServerFactory.Core.WorkspacesServer.GetWorkspace = fakeGetWorkspace()
+	fakes.FakeServer.ServerFactory.Core.WorkspacesServer.AssignToCapacity = fakeAssignToCapacity()
+}
+
+func fakeGetCapacity() func(ctx context.Context, capacityID string, options *fabcore.CapacitiesClientGetCapacityOptions) (resp azfake.Responder[fabcore.CapacitiesClientGetCapacityResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, capacityID string, _ *fabcore.CapacitiesClientGetCapacityOptions) (resp azfake.Responder[fabcore.CapacitiesClientGetCapacityResponse], errResp azfake.ErrorResponder) {
+		fakeStore.mu.Lock()
+		defer fakeStore.mu.Unlock()
+
+		entity, ok := fakeStore.capacities[capacityID]
+		if !ok {
+			errResp.SetError(fabfake.SetResponseError(http.StatusNotFound, fabcore.ErrCapacity.CapacityNotFound.Error(), "Capacity not found"))
+			resp.SetResponse(http.StatusNotFound, fabcore.CapacitiesClientGetCapacityResponse{}, nil)
+
+			return resp, errResp
+		}
+
+		resp.SetResponse(http.StatusOK, fabcore.CapacitiesClientGetCapacityResponse{Capacity: entity}, nil)
+
+		return resp, errResp
+	}
+}
+
+func fakeGetWorkspace() func(ctx context.Context, workspaceID string, options *fabcore.WorkspacesClientGetWorkspaceOptions) (resp azfake.Responder[fabcore.WorkspacesClientGetWorkspaceResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, workspaceID string, _ *fabcore.WorkspacesClientGetWorkspaceOptions) (resp azfake.Responder[fabcore.WorkspacesClientGetWorkspaceResponse], errResp azfake.ErrorResponder) {
+		fakeStore.mu.Lock()
+		defer fakeStore.mu.Unlock()
+
+		entity, ok := fakeStore.workspaces[workspaceID]
+		if !ok {
+			errResp.SetError(fabfake.SetResponseError(http.StatusNotFound, fabcore.ErrWorkspace.WorkspaceNotFound.Error(), "Workspace not found"))
+			resp.SetResponse(http.StatusNotFound, fabcore.WorkspacesClientGetWorkspaceResponse{}, nil)
+
+			return resp, errResp
+		}
+
+		resp.SetResponse(http.StatusOK, fabcore.WorkspacesClientGetWorkspaceResponse{WorkspaceInfo: entity}, nil)
+
+		return resp, errResp
+	}
+}
+
+func fakeAssignToCapacity() func(ctx context.Context, workspaceID string, assignRequest fabcore.AssignWorkspaceToCapacityRequest, options *fabcore.WorkspacesClientAssignToCapacityOptions) (resp azfake.Responder[fabcore.WorkspacesClientAssignToCapacityResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, workspaceID string, assignRequest fabcore.AssignWorkspaceToCapacityRequest, _ *fabcore.WorkspacesClientAssignToCapacityOptions) (resp azfake.Responder[fabcore.WorkspacesClientAssignToCapacityResponse], errResp azfake.ErrorResponder) {
+		fakeStore.mu.Lock()
+		defer fakeStore.mu.Unlock()
+
+		entity, ok := fakeStore.workspaces[workspaceID]
+		if !ok || fakeStore.failedWorkspaces[workspaceID] {
+			errResp.SetError(fabfake.SetResponseError(http.StatusForbidden, "InsufficientPrivileges", "The caller does not have sufficient permissions"))
+			resp.SetResponse(http.StatusForbidden, fabcore.WorkspacesClientAssignToCapacityResponse{}, nil)
+
+			return resp, errResp
+		}
+
+		capacity := fakeStore.capacities[*assignRequest.CapacityID]
+
+		entity.CapacityID = assignRequest.CapacityID
+		entity.CapacityRegion = to.Ptr(fabcore.CapacityRegion(*capacity.Region))
+		entity.CapacityAssignmentProgress = to.Ptr(fabcore.CapacityAssignmentProgressCompleted)
+		fakeStore.workspaces[workspaceID] = entity
+
+		resp.SetResponse(http.StatusAccepted, fabcore.WorkspacesClientAssignToCapacityResponse{}, nil)
+
+		return resp, errResp
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package capacitywa
+
+import (
+	"context"
+	"strings"
+
+	timeoutsR "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts" //revive:disable-line:import-alias-naming
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+)
+
+/*
+RESOURCE
+*/
+
+type resourceCapacityWorkspaceAssignmentsModel struct {
+	CapacityID     customtypes.UUID                                                  `tfsdk:"capacity_id"`
+	WorkspaceIDs   supertypes.SetValueOf[customtypes.UUID]                           `tfsdk:"workspace_ids"`
+	MaxConcurrency types.Int32                                                       `tfsdk:"max_concurrency"`
+	Results        supertypes.MapNestedObjectValueOf[workspaceAssignmentResultModel] `tfsdk:"results"`
+
+	Timeouts timeoutsR.Value `tfsdk:"timeouts"`
+}
+
+func (to *resourceCapacityWorkspaceAssignmentsModel) getWorkspaceIDs(ctx context.Context) ([]string, diag.Diagnostics) {
+	elements, diags := to.WorkspaceIDs.Get(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	values := make([]string, 0, len(elements))
+
+	for _, element := range elements {
+		values = append(values, element.ValueString())
+	}
+
+	return values, nil
+}
+
+func (to *resourceCapacityWorkspaceAssignmentsModel) setResults(ctx context.Context, from map[string]workspaceAssignmentResult) diag.Diagnostics {
+	results := make(map[string]*workspaceAssignmentResultModel, len(from))
+
+	for workspaceID, result := range from {
+		var model workspaceAssignmentResultModel
+
+		model.set(result)
+
+		results[workspaceID] = &model
+	}
+
+	return to.Results.Set(ctx, results)
+}
+
+// pendingWorkspaceIDs returns the planned Workspaces whose last known outcome is not a completed assignment to the Capacity.
+func (to *resourceCapacityWorkspaceAssignmentsModel) pendingWorkspaceIDs(ctx context.Context, workspaceIDs []string) ([]string, diag.Diagnostics) {
+	if to.Results.IsNull() || to.Results.IsUnknown() {
+		return workspaceIDs, nil
+	}
+
+	results, diags := to.Results.Get(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	pending := make([]string, 0, len(workspaceIDs))
+
+	for _, workspaceID := range workspaceIDs {
+		result, ok := results[workspaceID]
+		if !ok || !result.isAssignedTo(to.CapacityID.ValueString()) {
+			pending = append(pending, workspaceID)
+		}
+	}
+
+	return pending, nil
+}
+
+type workspaceAssignmentResultModel struct {
+	CapacityID                 customtypes.UUID `tfsdk:"capacity_id"`
+	CapacityRegion             types.String     `tfsdk:"capacity_region"`
+	CapacityAssignmentProgress types.String     `tfsdk:"capacity_assignment_progress"`
+	ErrorMessage               types.String     `tfsdk:"error_message"`
+}
+
+func (to *workspaceAssignmentResultModel) set(from workspaceAssignmentResult) {
+	to.CapacityID = customtypes.NewUUIDPointerValue(from.CapacityID)
+	to.CapacityRegion = types.StringPointerValue((*string)(from.CapacityRegion))
+	to.CapacityAssignmentProgress = types.StringPointerValue((*string)(from.CapacityAssignmentProgress))
+	to.ErrorMessage = types.StringNull()
+
+	if from.Err != nil {
+		to.ErrorMessage = types.StringValue(from.Err.Error())
+	}
+}
+
+func (to *workspaceAssignmentResultModel) isAssignedTo(capacityID string) bool {
+	return to.ErrorMessage.IsNull() &&
+		strings.EqualFold(to.CapacityID.ValueString(), capacityID) &&
+		to.CapacityAssignmentProgress.ValueString() == string(fabcore.CapacityAssignmentProgressCompleted)
+}
+
+/*
+HELPER MODELS
+*/
+
+// workspaceAssignmentResult is the outcome of the capacity assignment of a single Workspace.
+type workspaceAssignmentResult struct {
+	CapacityID                 *string
+	CapacityRegion             *fabcore.CapacityRegion
+	CapacityAssignmentProgress *fabcore.CapacityAssignmentProgress
+	Err                        error
+}
+
+func (to *workspaceAssignmentResult) set(from fabcore.WorkspaceInfo) {
+	to.CapacityID = from.CapacityID
+	to.CapacityRegion = from.CapacityRegion
+	to.CapacityAssignmentProgress = from.CapacityAssignmentProgress
+}
+
+func (to *workspaceAssignmentResult) isAssignedTo(capacityID string) bool {
+	return to.Err == nil &&
+		to.CapacityID != nil && strings.EqualFold(*to.CapacityID, capacityID) &&
+		to.CapacityAssignmentProgress != nil && *to.CapacityAssignmentProgress == fabcore.CapacityAssignmentProgressCompleted
+}
@@ -0,0 +1,448 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package capacitywa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.ResourceWithConfigure  = (*resourceCapacityWorkspaceAssignments)(nil)
+	_ resource.ResourceWithModifyPlan = (*resourceCapacityWorkspaceAssignments)(nil)
+)
+
+type resourceCapacityWorkspaceAssignments struct {
+	pConfigData    *pconfig.ProviderData
+	client         *fabcore.WorkspacesClient
+	clientCapacity *fabcore.CapacitiesClient
+	TypeInfo       tftypeinfo.TFTypeInfo
+}
+
+func NewResourceCapacityWorkspaceAssignments() resource.Resource {
+	return &resourceCapacityWorkspaceAssignments{
+		TypeInfo: ItemTypeInfo,
+	}
+}
+
+func (r *resourceCapacityWorkspaceAssignments) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeInfo.FullTypeName(true)
+}
+
+func (r *resourceCapacityWorkspaceAssignments) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = itemSchema().GetResource(ctx)
+}
+
+func (r *resourceCapacityWorkspaceAssignments) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorResourceConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	r.pConfigData = pConfigData
+	r.client = fabcore.NewClientFactoryWithClient(*pConfigData.FabricClient).NewWorkspacesClient()
+	r.clientCapacity = fabcore.NewClientFactoryWithClient(*pConfigData.FabricClient).NewCapacitiesClient()
+
+	if resp.Diagnostics.Append(fabricitem.IsPreviewMode(r.TypeInfo.Name, r.TypeInfo.IsPreview, r.pConfigData.Preview)...); resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceCapacityWorkspaceAssignments) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	tflog.Debug(ctx, "MODIFY PLAN", map[string]any{
+		"action": "start",
+	})
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan resourceCapacityWorkspaceAssignmentsModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.CapacityID.IsUnknown() || plan.WorkspaceIDs.IsUnknown() || plan.MaxConcurrency.IsUnknown() {
+		return
+	}
+
+	workspaceIDs, diags := plan.getWorkspaceIDs(ctx)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the Workspaces whose assignment changes are checked, the others are already assigned to the Capacity.
+	changedWorkspaceIDs := workspaceIDs
+	timeoutFn := plan.Timeouts.Create
+
+	if !req.State.Raw.IsNull() {
+		var state resourceCapacityWorkspaceAssignmentsModel
+
+		if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+			return
+		}
+
+		changedWorkspaceIDs, diags = state.pendingWorkspaceIDs(ctx, workspaceIDs)
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
+
+		timeoutFn = plan.Timeouts.Update
+	}
+
+	if len(changedWorkspaceIDs) > 0 {
+		timeout, diags := timeoutFn(ctx, r.pConfigData.Timeout)
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
+
+		ctxTimeout, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		if resp.Diagnostics.Append(r.validateRegions(ctxTimeout, plan.CapacityID.ValueString(), changedWorkspaceIDs, int(plan.MaxConcurrency.ValueInt32()))...); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Workspaces not assigned yet (e.g. a failed assignment, or a change made outside of Terraform) must be assigned again.
+	if !req.State.Raw.IsNull() && !plan.Results.IsUnknown() {
+		pending, diags := plan.pendingWorkspaceIDs(ctx, workspaceIDs)
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
+
+		if len(pending) > 0 {
+			tflog.Debug(ctx, "MODIFY PLAN", map[string]any{
+				"pending_workspace_ids": pending,
+			})
+
+			plan.Results = supertypes.NewMapNestedObjectValueOfUnknown[workspaceAssignmentResultModel](ctx)
+
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+		}
+	}
+
+	tflog.Debug(ctx, "MODIFY PLAN", map[string]any{
+		"action": "end",
+	})
+}
+
+func (r *resourceCapacityWorkspaceAssignments) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan resourceCapacityWorkspaceAssignmentsModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if resp.Diagnostics.Append(r.assign(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceCapacityWorkspaceAssignments) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "start",
+	})
+
+	var state resourceCapacityWorkspaceAssignmentsModel
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if resp.Diagnostics.Append(r.get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceCapacityWorkspaceAssignments) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan resourceCapacityWorkspaceAssignmentsModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Workspaces removed from the set are left on their current Capacity.
+	if resp.Diagnostics.Append(r.assign(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceCapacityWorkspaceAssignments) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "start",
+	})
+
+	// Unassigning the Workspaces would make their items unusable, so they are left on the Capacity.
+
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "end",
+	})
+}
+
+func (r *resourceCapacityWorkspaceAssignments) get(ctx context.Context, model *resourceCapacityWorkspaceAssignmentsModel) diag.Diagnostics {
+	workspaceIDs, diags := model.getWorkspaceIDs(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	previous := map[string]*workspaceAssignmentResultModel{}
+
+	if !model.Results.IsNull() && !model.Results.IsUnknown() {
+		if previous, diags = model.Results.Get(ctx); diags.HasError() {
+			return diags
+		}
+	}
+
+	results := forEachWorkspace(ctx, workspaceIDs, int(model.MaxConcurrency.ValueInt32()), r.readWorkspace)
+
+	// Keep the reason of a failed assignment until the Workspace is assigned.
+	for workspaceID, result := range results {
+		if result.Err == nil && !result.isAssignedTo(model.CapacityID.ValueString()) {
+			if prev, ok := previous[workspaceID]; ok && !prev.ErrorMessage.IsNull() {
+				result.Err = errors.New(prev.ErrorMessage.ValueString())
+				results[workspaceID] = result
+			}
+		}
+	}
+
+	return model.setResults(ctx, results)
+}
+
+func (r *resourceCapacityWorkspaceAssignments) assign(ctx context.Context, model *resourceCapacityWorkspaceAssignmentsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	workspaceIDs, d := model.getWorkspaceIDs(ctx)
+	if diags.Append(d...); diags.HasError() {
+		return diags
+	}
+
+	capacityID := model.CapacityID.ValueString()
+
+	results := forEachWorkspace(ctx, workspaceIDs, int(model.MaxConcurrency.ValueInt32()), func(ctx context.Context, workspaceID string) workspaceAssignmentResult {
+		return r.assignWorkspace(ctx, workspaceID, capacityID)
+	})
+
+	// A failed Workspace does not abort the batch, it is reported and retried on the next apply.
+	for _, workspaceID := range workspaceIDs {
+		if result := results[workspaceID]; result.Err != nil {
+			diags.AddAttributeWarning(
+				path.Root("results").AtMapKey(workspaceID),
+				common.WarningCapacityAssignmentHeader,
+				fmt.Sprintf(common.WarningCapacityAssignmentDetails, workspaceID, capacityID, result.Err),
+			)
+		}
+	}
+
+	diags.Append(model.setResults(ctx, results)...)
+
+	return diags
+}
+
+func (r *resourceCapacityWorkspaceAssignments) readWorkspace(ctx context.Context, workspaceID string) workspaceAssignmentResult {
+	var result workspaceAssignmentResult
+
+	respGet, err := r.client.GetWorkspace(ctx, workspaceID, nil)
+	if err != nil {
+		result.Err = err
+
+		return result
+	}
+
+	result.set(respGet.WorkspaceInfo)
+
+	return result
+}
+
+func (r *resourceCapacityWorkspaceAssignments) assignWorkspace(ctx context.Context, workspaceID, capacityID string) workspaceAssignmentResult {
+	result := r.readWorkspace(ctx, workspaceID)
+	if result.Err != nil || result.isAssignedTo(capacityID) {
+		return result
+	}
+
+	tflog.Debug(ctx, "ASSIGN CAPACITY", map[string]any{
+		"action":       "start",
+		"workspace_id": workspaceID,
+		"capacity_id":  capacityID,
+	})
+
+	_, err := r.client.AssignToCapacity(ctx, workspaceID, fabcore.AssignWorkspaceToCapacityRequest{CapacityID: &capacityID}, nil)
+	if err != nil {
+		result.Err = err
+
+		return result
+	}
+
+	workspace, err := fabricitem.WaitForCapacityAssignment(ctx, r.client, workspaceID)
+
+	result = workspaceAssignmentResult{Err: err}
+	result.set(workspace)
+
+	if result.Err != nil {
+		return result
+	}
+
+	tflog.Debug(ctx, "ASSIGN CAPACITY", map[string]any{
+		"action":       "end",
+		"workspace_id": workspaceID,
+	})
+
+	return result
+}
+
+func (r *resourceCapacityWorkspaceAssignments) validateRegions(ctx context.Context, capacityID string, workspaceIDs []string, maxConcurrency int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	respCapacity, err := r.clientCapacity.GetCapacity(ctx, capacityID, nil)
+	if diags.Append(utils.GetDiagsFromError(ctx, err, utils.OperationRead, fabcore.ErrCapacity.CapacityNotFound)...); diags.HasError() {
+		return diags
+	}
+
+	if respCapacity.Region == nil {
+		return diags
+	}
+
+	results := forEachWorkspace(ctx, workspaceIDs, maxConcurrency, r.readWorkspace)
+
+	for _, workspaceID := range workspaceIDs {
+		result := results[workspaceID]
+
+		// Unreadable Workspaces and Workspaces without a Capacity are reported when assigned.
+		if result.Err != nil || result.CapacityID == nil || result.CapacityRegion == nil || result.isAssignedTo(capacityID) {
+			continue
+		}
+
+		if !strings.EqualFold(string(*result.CapacityRegion), *respCapacity.Region) {
+			diags.AddAttributeError(
+				path.Root("workspace_ids"),
+				common.ErrorCapacityRegionHeader,
+				fmt.Sprintf(common.ErrorCapacityRegionDetails, workspaceID, *result.CapacityRegion, capacityID, *respCapacity.Region),
+			)
+		}
+	}
+
+	return diags
+}
+
+// forEachWorkspace runs fn for every Workspace, with at most maxConcurrency calls at the same time.
+func forEachWorkspace(ctx context.Context, workspaceIDs []string, maxConcurrency int, fn func(ctx context.Context, workspaceID string) workspaceAssignmentResult) map[string]workspaceAssignmentResult {
+	if maxConcurrency < minMaxConcurrency {
+		maxConcurrency = defaultMaxConcurrency
+	}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+
+	results := make(map[string]workspaceAssignmentResult, len(workspaceIDs))
+	sem := make(chan struct{}, maxConcurrency)
+
+	for _, workspaceID := range workspaceIDs {
+		sem <- struct{}{}
+
+		wg.Go(func() {
+			defer func() { <-sem }()
+
+			result := fn(ctx, workspaceID)
+
+			mu.Lock()
+			results[workspaceID] = result
+			mu.Unlock()
+		})
+	}
+
+	wg.Wait()
+
+	return results
+}
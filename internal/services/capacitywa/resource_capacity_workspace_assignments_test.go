@@ -0,0 +1,247 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package capacitywa_test
+
+import (
+	"regexp"
+	"testing"
+
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testResourceItemFQN, testResourceItemHeader = testhelp.TFResource(common.ProviderTypeName, itemTypeInfo.Types, "test")
+
+func TestUnit_CapacityWorkspaceAssignmentsResource_Attributes(t *testing.T) {
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no attributes
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{},
+			),
+			ExpectError: regexp.MustCompile(`Missing required argument`),
+		},
+		// error - unexpected attribute
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"capacity_id":     testhelp.RandomUUID(),
+					"workspace_ids":   []string{testhelp.RandomUUID()},
+					"unexpected_attr": "test",
+				},
+			),
+			ExpectError: regexp.MustCompile(`An argument named "unexpected_attr" is not expected here`),
+		},
+		// error - invalid UUID - capacity_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"capacity_id":   "invalid uuid",
+					"workspace_ids": []string{testhelp.RandomUUID()},
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - empty workspace_ids
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"capacity_id":   testhelp.RandomUUID(),
+					"workspace_ids": []string{},
+				},
+			),
+			ExpectError: regexp.MustCompile(`Attribute workspace_ids set must contain at least 1 elements`),
+		},
+		// error - max_concurrency out of range
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"capacity_id":     testhelp.RandomUUID(),
+					"workspace_ids":   []string{testhelp.RandomUUID()},
+					"max_concurrency": 0,
+				},
+			),
+			ExpectError: regexp.MustCompile(`Attribute max_concurrency value must be between 1 and 20`),
+		},
+	}))
+}
+
+func TestUnit_CapacityWorkspaceAssignmentsResource_RegionMismatch(t *testing.T) {
+	configureFakes()
+
+	capacityEU := NewRandomCapacityInRegion(fabcore.CapacityRegionWestEurope)
+	capacityUS := NewRandomCapacityInRegion(fabcore.CapacityRegionWestUS2)
+	workspace := NewRandomWorkspaceOnCapacity(capacityUS)
+	workspaceEU := NewRandomWorkspaceOnCapacity(NewRandomCapacityInRegion(fabcore.CapacityRegionWestEurope))
+
+	fakeTestUpsertCapacity(capacityEU)
+	fakeTestUpsertCapacity(capacityUS)
+	fakeTestUpsertWorkspace(workspace)
+	fakeTestUpsertWorkspace(workspaceEU)
+
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// Create - error
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"capacity_id":   *capacityEU.ID,
+					"workspace_ids": []string{*workspace.ID},
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorCapacityRegionHeader),
+		},
+		// Create - ok
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"capacity_id":   *capacityEU.ID,
+					"workspace_ids": []string{*workspaceEU.ID},
+				},
+			),
+			Check: resource.TestCheckResourceAttr(testResourceItemFQN, "results."+*workspaceEU.ID+".capacity_id", *capacityEU.ID),
+		},
+		// Update - the added Workspace is checked
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"capacity_id":   *capacityEU.ID,
+					"workspace_ids": []string{*workspaceEU.ID, *workspace.ID},
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorCapacityRegionHeader),
+		},
+	}))
+}
+
+func TestUnit_CapacityWorkspaceAssignmentsResource_CRUD(t *testing.T) {
+	configureFakes()
+
+	capacitySource := NewRandomCapacityInRegion(fabcore.CapacityRegionWestEurope)
+	capacityTarget := NewRandomCapacityInRegion(fabcore.CapacityRegionWestEurope)
+	workspace1 := NewRandomWorkspaceOnCapacity(capacitySource)
+	workspace2 := NewRandomWorkspaceOnCapacity(capacitySource)
+	workspaceFailed := NewRandomWorkspaceOnCapacity(capacitySource)
+
+	fakeTestUpsertCapacity(capacitySource)
+	fakeTestUpsertCapacity(capacityTarget)
+	fakeTestUpsertWorkspace(workspace1)
+	fakeTestUpsertWorkspace(workspace2)
+	fakeTestUpsertWorkspace(workspaceFailed)
+	fakeTestSetWorkspaceFailure(*workspaceFailed.ID, true)
+
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// Create - one Workspace fails without aborting the batch
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"capacity_id":     *capacityTarget.ID,
+					"workspace_ids":   []string{*workspace1.ID, *workspace2.ID, *workspaceFailed.ID},
+					"max_concurrency": 2,
+				},
+			),
+			ExpectNonEmptyPlan: true,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "capacity_id", *capacityTarget.ID),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "workspace_ids.#", "3"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "results.%", "3"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "results."+*workspace1.ID+".capacity_id", *capacityTarget.ID),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "results."+*workspace1.ID+".capacity_assignment_progress", string(fabcore.CapacityAssignmentProgressCompleted)),
+				resource.TestCheckNoResourceAttr(testResourceItemFQN, "results."+*workspace1.ID+".error_message"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "results."+*workspace2.ID+".capacity_id", *capacityTarget.ID),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "results."+*workspaceFailed.ID+".capacity_id", *capacitySource.ID),
+				resource.TestCheckResourceAttrSet(testResourceItemFQN, "results."+*workspaceFailed.ID+".error_message"),
+			),
+		},
+		// Update - the failed Workspace is retried
+		{
+			PreConfig: func() {
+				fakeTestSetWorkspaceFailure(*workspaceFailed.ID, false)
+			},
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"capacity_id":     *capacityTarget.ID,
+					"workspace_ids":   []string{*workspace1.ID, *workspace2.ID, *workspaceFailed.ID},
+					"max_concurrency": 2,
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "results."+*workspaceFailed.ID+".capacity_id", *capacityTarget.ID),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "results."+*workspaceFailed.ID+".capacity_assignment_progress", string(fabcore.CapacityAssignmentProgressCompleted)),
+				resource.TestCheckNoResourceAttr(testResourceItemFQN, "results."+*workspaceFailed.ID+".error_message"),
+			),
+		},
+		// Update - move the Workspaces back to the source Capacity
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"capacity_id":   *capacitySource.ID,
+					"workspace_ids": []string{*workspace1.ID, *workspace2.ID},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "max_concurrency", "5"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "results.%", "2"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "results."+*workspace1.ID+".capacity_id", *capacitySource.ID),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "results."+*workspace2.ID+".capacity_id", *capacitySource.ID),
+			),
+		},
+	}))
+}
+
+func TestAcc_CapacityWorkspaceAssignmentsResource_CRUD(t *testing.T) {
+	capacity := testhelp.WellKnown()["Capacity"].(map[string]any)
+	capacityID := capacity["id"].(string)
+
+	workspaceResourceHCL, workspaceResourceFQN := testhelp.TestAccWorkspaceResource(t, capacityID)
+
+	resource.Test(t, testhelp.NewTestAccCase(t, &testResourceItemFQN, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.JoinConfigs(
+				workspaceResourceHCL,
+				at.CompileConfig(
+					testResourceItemHeader,
+					map[string]any{
+						"capacity_id": capacityID,
+						"workspace_ids": []string{
+							testhelp.RefByFQN(workspaceResourceFQN, "id"),
+						},
+					},
+				)),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "capacity_id", capacityID),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "results.%", "1"),
+			),
+		},
+	}))
+}
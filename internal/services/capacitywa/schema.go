@@ -0,0 +1,110 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package capacitywa
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema" //revive:disable-line:import-alias-naming
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func itemSchema() superschema.Schema {
+	markdownDescriptionR := fabricitem.NewResourceMarkdownDescription(ItemTypeInfo, true)
+
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: markdownDescriptionR +
+				"\n\nAssigns a set of Workspaces to a Capacity in parallel. The region of every Workspace is validated against the region of the Capacity at plan time. " +
+				"A Workspace that cannot be assigned does not abort the batch: its outcome is reported in `results` and as a warning, and the assignment is retried on the next apply." +
+				"\n\n~> Deleting this resource does not unassign the Workspaces from the Capacity.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"capacity_id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The Capacity ID to assign the Workspaces to.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+				},
+			},
+			"workspace_ids": superschema.SuperSetAttribute{
+				Resource: &schemaR.SetAttribute{
+					MarkdownDescription: "The set of Workspace IDs.",
+					CustomType: supertypes.SetTypeOf[customtypes.UUID]{
+						SetType: basetypes.SetType{
+							ElemType: customtypes.UUIDType{},
+						},
+					},
+					ElementType: customtypes.UUIDType{},
+					Required:    true,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+					},
+				},
+			},
+			"max_concurrency": superschema.Int32Attribute{
+				Resource: &schemaR.Int32Attribute{
+					MarkdownDescription: "The maximum number of Workspaces assigned at the same time.",
+					Optional:            true,
+					Computed:            true,
+					Default:             int32default.StaticInt32(defaultMaxConcurrency),
+					Validators: []validator.Int32{
+						int32validator.Between(minMaxConcurrency, maxMaxConcurrency),
+					},
+				},
+			},
+			"results": superschema.SuperMapNestedAttributeOf[workspaceAssignmentResultModel]{
+				Resource: &schemaR.MapNestedAttribute{
+					MarkdownDescription: "The outcome of the assignment, keyed by Workspace ID.",
+					Computed:            true,
+				},
+				Attributes: map[string]superschema.Attribute{
+					"capacity_id": superschema.SuperStringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The ID of the Capacity the Workspace is assigned to.",
+							CustomType:          customtypes.UUIDType{},
+							Computed:            true,
+						},
+					},
+					"capacity_region": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The region of the Capacity the Workspace is assigned to.",
+							Computed:            true,
+						},
+					},
+					"capacity_assignment_progress": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The progress of the capacity assignment. Possible values: " +
+								utils.ConvertStringSlicesToString(fabcore.PossibleCapacityAssignmentProgressValues(), true, true) + ".",
+							Computed: true,
+						},
+					},
+					"error_message": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The reason the Workspace could not be assigned to the Capacity.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"timeouts": superschema.TimeoutAttribute{
+				Resource: &superschema.ResourceTimeoutAttribute{
+					Create: true,
+					Read:   true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		},
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
@@ -33,6 +34,7 @@ var (
 	_ resource.ResourceWithModifyPlan       = (*resourceConnection)(nil)
 	_ resource.ResourceWithConfigValidators = (*resourceConnection)(nil)
 	_ resource.ResourceWithImportState      = (*resourceConnection)(nil)
+	_ resource.ResourceWithIdentity         = (*resourceConnection)(nil)
 )
 
 type resourceConnection struct {
@@ -56,6 +58,18 @@ func (r *resourceConnection) Schema(ctx context.Context, _ resource.SchemaReques
 	resp.Schema = itemSchema(ctx, false).GetResource(ctx)
 }
 
+func (r *resourceConnection) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The Connection ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+		},
+	}
+}
+
 func (r *resourceConnection) ConfigValidators(_ context.Context) []resource.ConfigValidator {
 	return []resource.ConfigValidator{
 		resourcevalidator.Conflicting(
@@ -213,6 +227,7 @@ func (r *resourceConnection) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "CREATE", map[string]any{
 		"action": "end",
@@ -256,6 +271,7 @@ func (r *resourceConnection) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "READ", map[string]any{
 		"action": "end",
@@ -305,6 +321,7 @@ func (r *resourceConnection) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "UPDATE", map[string]any{
 		"action": "end",
@@ -354,12 +371,14 @@ func (r *resourceConnection) ImportState(ctx context.Context, req resource.Impor
 		"id": req.ID,
 	})
 
-	_, diags := customtypes.NewUUIDValueMust(req.ID)
-	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
-		return
+	if req.ID != "" {
+		_, diags := customtypes.NewUUIDValueMust(req.ID)
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 
 	tflog.Debug(ctx, "IMPORT", map[string]any{
 		"action": "end",
@@ -0,0 +1,14 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package connectionhealth
+
+import "github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+
+var ItemTypeInfo = tftypeinfo.TFTypeInfo{ //nolint:gochecknoglobals
+	Name:           "Connection Health",
+	Type:           "connection_health",
+	DocsURL:        "https://learn.microsoft.com/fabric/data-factory/data-source-management",
+	IsPreview:      true,
+	IsSPNSupported: true,
+}
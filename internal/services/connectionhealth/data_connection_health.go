@@ -0,0 +1,122 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package connectionhealth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+var _ datasource.DataSourceWithConfigure = (*dataSourceConnectionHealth)(nil)
+
+type dataSourceConnectionHealth struct {
+	pConfigData *pconfig.ProviderData
+	client      *fabcore.ConnectionsClient
+	TypeInfo    tftypeinfo.TFTypeInfo
+}
+
+func NewDataSourceConnectionHealth() datasource.DataSource {
+	return &dataSourceConnectionHealth{
+		TypeInfo: ItemTypeInfo,
+	}
+}
+
+func (d *dataSourceConnectionHealth) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeInfo.FullTypeName(false)
+}
+
+func (d *dataSourceConnectionHealth) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = itemSchema().GetDataSource(ctx)
+}
+
+func (d *dataSourceConnectionHealth) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorDataSourceConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	d.pConfigData = pConfigData
+	d.client = fabcore.NewClientFactoryWithClient(*pConfigData.FabricClient).NewConnectionsClient()
+
+	if resp.Diagnostics.Append(fabricitem.IsPreviewMode(d.TypeInfo.Name, d.TypeInfo.IsPreview, d.pConfigData.Preview)...); resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *dataSourceConnectionHealth) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "start",
+	})
+
+	var data dataSourceConnectionHealthModel
+
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Read(ctx, d.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if resp.Diagnostics.Append(d.test(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *dataSourceConnectionHealth) test(ctx context.Context, model *dataSourceConnectionHealthModel) diag.Diagnostics {
+	respGet, err := d.client.GetConnection(ctx, model.ConnectionID.ValueString(), nil)
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationRead, nil); diags.HasError() {
+		return diags
+	}
+
+	model.setConnection(respGet.GetConnection())
+
+	tflog.Trace(ctx, "testing Connection", map[string]any{
+		"connection_id": model.ConnectionID.ValueString(),
+	})
+
+	testedAt := time.Now()
+
+	respTest, err := d.client.TestConnection(ctx, model.ConnectionID.ValueString(), nil)
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationRead, nil); diags.HasError() {
+		return diags
+	}
+
+	return model.setStatus(ctx, respTest.ConnectionStatusResponse, testedAt)
+}
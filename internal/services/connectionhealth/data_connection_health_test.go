@@ -0,0 +1,172 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package connectionhealth_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testDataSourceItemFQN, testDataSourceItemHeader = testhelp.TFDataSource(common.ProviderTypeName, itemTypeInfo.Type, "test")
+
+func TestUnit_ConnectionHealthDataSource(t *testing.T) {
+	onlineEntity := fakes.NewRandomShareableCloudConnection()
+	onlineEntity.CredentialDetails.SkipTestConnection = to.Ptr(true)
+	onlineEntity.ConnectionRecency = &fabcore.ConnectionRecency{
+		LastCredentialUsedDateTime: to.Ptr(time.Date(2026, time.January, 2, 3, 4, 5, 0, time.UTC)),
+	}
+
+	offlineEntity := fakes.NewRandomShareableCloudConnection()
+
+	fakes.FakeServer.Upsert(onlineEntity)
+	fakes.FakeServer.Upsert(offlineEntity)
+
+	fakeTestUpsertStatus(*onlineEntity.ID, fabcore.ConnectionStatusResponse{
+		Status: to.Ptr(fabcore.TestConnectionStatusOnline),
+	})
+	fakeTestUpsertStatus(*offlineEntity.ID, fabcore.ConnectionStatusResponse{
+		Status: to.Ptr(fabcore.TestConnectionStatusOffline),
+		Errors: []fabcore.ConnectionStatusError{
+			{
+				ErrorCode: to.Ptr("InvalidCredentials"),
+				Message:   to.Ptr("The credentials provided are invalid or have expired."),
+			},
+		},
+	})
+
+	configureFakes()
+
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, nil, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no attributes
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{},
+			),
+			ExpectError: regexp.MustCompile(`The argument "connection_id" is required, but no definition was found`),
+		},
+		// error - connection_id - invalid UUID
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"connection_id": "invalid uuid",
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - unexpected attribute
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"connection_id":   *onlineEntity.ID,
+					"unexpected_attr": "test",
+				},
+			),
+			ExpectError: regexp.MustCompile(`An argument named "unexpected_attr" is not expected here`),
+		},
+		// read - not found
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"connection_id": testhelp.RandomUUID(),
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorReadHeader),
+		},
+		// read - online (tested even when skip_test_connection is set)
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"connection_id": *onlineEntity.ID,
+				},
+			),
+			ConfigStateChecks: []statecheck.StateCheck{
+				statecheck.ExpectKnownValue(testDataSourceItemFQN, tfjsonpath.New("display_name"), knownvalue.StringExact(*onlineEntity.DisplayName)),
+				statecheck.ExpectKnownValue(testDataSourceItemFQN, tfjsonpath.New("credential_type"), knownvalue.StringExact(string(fabcore.CredentialTypeKey))),
+				statecheck.ExpectKnownValue(testDataSourceItemFQN, tfjsonpath.New("skip_test_connection"), knownvalue.Bool(true)),
+				statecheck.ExpectKnownValue(testDataSourceItemFQN, tfjsonpath.New("credential_last_used_date_time"), knownvalue.StringExact("2026-01-02T03:04:05Z")),
+				statecheck.ExpectKnownValue(testDataSourceItemFQN, tfjsonpath.New("status"), knownvalue.StringExact(string(fabcore.TestConnectionStatusOnline))),
+				statecheck.ExpectKnownValue(testDataSourceItemFQN, tfjsonpath.New("errors"), knownvalue.ListSizeExact(0)),
+				statecheck.ExpectKnownValue(testDataSourceItemFQN, tfjsonpath.New("tested_date_time"), knownvalue.NotNull()),
+			},
+		},
+		// read - offline
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"connection_id": *offlineEntity.ID,
+				},
+			),
+			ConfigStateChecks: []statecheck.StateCheck{
+				statecheck.ExpectKnownValue(testDataSourceItemFQN, tfjsonpath.New("credential_last_used_date_time"), knownvalue.Null()),
+				statecheck.ExpectKnownValue(testDataSourceItemFQN, tfjsonpath.New("status"), knownvalue.StringExact(string(fabcore.TestConnectionStatusOffline))),
+				statecheck.ExpectKnownValue(
+					testDataSourceItemFQN,
+					tfjsonpath.New("errors"),
+					knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"error_code": knownvalue.StringExact("InvalidCredentials"),
+							"message":    knownvalue.StringExact("The credentials provided are invalid or have expired."),
+						}),
+					}),
+				),
+			},
+		},
+	}))
+}
+
+func TestAcc_ConnectionHealthDataSource(t *testing.T) {
+	shareableCloudConnection := testhelp.WellKnown()["ShareableCloudConnection"].(map[string]any)
+	shareableCloudConnectionID := shareableCloudConnection["id"].(string)
+	shareableCloudConnectionDisplayName := shareableCloudConnection["displayName"].(string)
+
+	resource.ParallelTest(t, testhelp.NewTestAccCase(t, nil, nil, []resource.TestStep{
+		// read - not found
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"connection_id": testhelp.RandomUUID(),
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorReadHeader),
+		},
+		// read
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"connection_id": shareableCloudConnectionID,
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "connection_id", shareableCloudConnectionID),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "display_name", shareableCloudConnectionDisplayName),
+				resource.TestCheckResourceAttrSet(testDataSourceItemFQN, "credential_type"),
+				resource.TestCheckResourceAttrSet(testDataSourceItemFQN, "skip_test_connection"),
+				resource.TestCheckResourceAttrSet(testDataSourceItemFQN, "status"),
+				resource.TestCheckResourceAttrSet(testDataSourceItemFQN, "tested_date_time"),
+			),
+		},
+	}))
+}
@@ -0,0 +1,56 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package connectionhealth_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	fabfake "github.com/microsoft/fabric-sdk-go/fabric/fake"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+// fakeStatusStore keeps the test connection results of the tests, keyed by Connection ID.
+type fakeStatusStore struct {
+	mu       sync.Mutex
+	statuses map[string]fabcore.ConnectionStatusResponse
+}
+
+var fakeStore = &fakeStatusStore{ //nolint:gochecknoglobals
+	statuses: map[string]fabcore.ConnectionStatusResponse{},
+}
+
+func fakeTestUpsertStatus(connectionID string, status fabcore.ConnectionStatusResponse) {
+	fakeStore.mu.Lock()
+	defer fakeStore.mu.Unlock()
+
+	fakeStore.statuses[connectionID] = status
+}
+
+func configureFakes() {
+	fakes.FakeServer.ServerFactory.Core.ConnectionsServer.BeginTestConnection = fakeTestConnection()
+}
+
+func fakeTestConnection() func(ctx context.Context, connectionID string, options *fabcore.ConnectionsClientBeginTestConnectionOptions) (resp azfake.PollerResponder[fabcore.ConnectionsClientTestConnectionResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, connectionID string, _ *fabcore.ConnectionsClientBeginTestConnectionOptions) (resp azfake.PollerResponder[fabcore.ConnectionsClientTestConnectionResponse], errResp azfake.ErrorResponder) {
+		fakeStore.mu.Lock()
+		defer fakeStore.mu.Unlock()
+
+		status, ok := fakeStore.statuses[connectionID]
+		if !ok {
+			errResp.SetError(fabfake.SetResponseError(http.StatusNotFound, fabcore.ErrCommon.EntityNotFound.Error(), fabcore.ErrCommon.EntityNotFound.Error()))
+			resp.SetTerminalResponse(http.StatusNotFound, fabcore.ConnectionsClientTestConnectionResponse{}, nil)
+
+			return resp, errResp
+		}
+
+		resp.SetTerminalResponse(http.StatusOK, fabcore.ConnectionsClientTestConnectionResponse{ConnectionStatusResponse: status}, nil)
+
+		return resp, errResp
+	}
+}
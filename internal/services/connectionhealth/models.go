@@ -0,0 +1,82 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package connectionhealth
+
+import (
+	"context"
+	"time"
+
+	timeoutsD "github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts" //revive:disable-line:import-alias-naming
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+)
+
+/*
+DATA-SOURCE
+*/
+
+type dataSourceConnectionHealthModel struct {
+	ConnectionID               customtypes.UUID                                               `tfsdk:"connection_id"`
+	DisplayName                types.String                                                   `tfsdk:"display_name"`
+	CredentialType             types.String                                                   `tfsdk:"credential_type"`
+	SkipTestConnection         types.Bool                                                     `tfsdk:"skip_test_connection"`
+	CredentialLastUsedDateTime timetypes.RFC3339                                              `tfsdk:"credential_last_used_date_time"`
+	Status                     types.String                                                   `tfsdk:"status"`
+	Errors                     supertypes.ListNestedObjectValueOf[connectionStatusErrorModel] `tfsdk:"errors"`
+	TestedDateTime             timetypes.RFC3339                                              `tfsdk:"tested_date_time"`
+
+	Timeouts timeoutsD.Value `tfsdk:"timeouts"`
+}
+
+func (to *dataSourceConnectionHealthModel) setConnection(from *fabcore.Connection) {
+	to.DisplayName = types.StringPointerValue(from.DisplayName)
+	to.CredentialType = types.StringNull()
+	to.SkipTestConnection = types.BoolNull()
+	to.CredentialLastUsedDateTime = timetypes.NewRFC3339Null()
+
+	if from.CredentialDetails != nil {
+		to.CredentialType = types.StringPointerValue((*string)(from.CredentialDetails.CredentialType))
+		to.SkipTestConnection = types.BoolPointerValue(from.CredentialDetails.SkipTestConnection)
+	}
+
+	if from.ConnectionRecency != nil {
+		to.CredentialLastUsedDateTime = timetypes.NewRFC3339TimePointerValue(from.ConnectionRecency.LastCredentialUsedDateTime)
+	}
+}
+
+func (to *dataSourceConnectionHealthModel) setStatus(ctx context.Context, from fabcore.ConnectionStatusResponse, testedAt time.Time) diag.Diagnostics {
+	to.Status = types.StringPointerValue((*string)(from.Status))
+	to.TestedDateTime = timetypes.NewRFC3339TimeValue(testedAt.UTC())
+
+	slice := make([]*connectionStatusErrorModel, 0, len(from.Errors))
+
+	for _, entity := range from.Errors {
+		var entityModel connectionStatusErrorModel
+
+		entityModel.set(entity)
+
+		slice = append(slice, &entityModel)
+	}
+
+	return to.Errors.Set(ctx, slice)
+}
+
+/*
+HELPER MODELS
+*/
+
+type connectionStatusErrorModel struct {
+	ErrorCode types.String `tfsdk:"error_code"`
+	Message   types.String `tfsdk:"message"`
+}
+
+func (to *connectionStatusErrorModel) set(from fabcore.ConnectionStatusError) {
+	to.ErrorCode = types.StringPointerValue(from.ErrorCode)
+	to.Message = types.StringPointerValue(from.Message)
+}
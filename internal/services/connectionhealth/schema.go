@@ -0,0 +1,102 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package connectionhealth
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema" //revive:disable-line:import-alias-naming
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func itemSchema() superschema.Schema {
+	markdownDescriptionD := fabricitem.NewDataSourceMarkdownDescription(ItemTypeInfo, false)
+
+	return superschema.Schema{
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: markdownDescriptionD +
+				"\n\nTests an existing Connection on every read, whether or not the Connection was created with `skip_test_connection`. " +
+				"Use it in a [`check` block](https://developer.hashicorp.com/terraform/language/checks) to be warned of an offline Connection (e.g. an expired or revoked credential) on every plan." +
+				"\n\n-> The Fabric API does not expose the expiry date of Connection credentials. An expired credential is reported as an `Offline` status with the error returned by the data source.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"connection_id": superschema.SuperStringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The Connection ID.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+				},
+			},
+			"display_name": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The Connection display name.",
+					Computed:            true,
+				},
+			},
+			"credential_type": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The credential type of the Connection. Possible values: " +
+						utils.ConvertStringSlicesToString(fabcore.PossibleCredentialTypeValues(), true, true) + ".",
+					Computed: true,
+				},
+			},
+			"skip_test_connection": superschema.BoolAttribute{
+				DataSource: &schemaD.BoolAttribute{
+					MarkdownDescription: "Whether the Connection was created or updated without testing it.",
+					Computed:            true,
+				},
+			},
+			"credential_last_used_date_time": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The date and time (UTC) the Connection credential was most recently used.",
+					CustomType:          timetypes.RFC3339Type{},
+					Computed:            true,
+				},
+			},
+			"status": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The status of the Connection test. Possible values: " +
+						utils.ConvertStringSlicesToString(fabcore.PossibleTestConnectionStatusValues(), true, true) + ".",
+					Computed: true,
+				},
+			},
+			"errors": superschema.SuperListNestedAttributeOf[connectionStatusErrorModel]{
+				DataSource: &schemaD.ListNestedAttribute{
+					MarkdownDescription: "The errors returned by the Connection test.",
+					Computed:            true,
+				},
+				Attributes: map[string]superschema.Attribute{
+					"error_code": superschema.StringAttribute{
+						DataSource: &schemaD.StringAttribute{
+							MarkdownDescription: "The error code.",
+							Computed:            true,
+						},
+					},
+					"message": superschema.StringAttribute{
+						DataSource: &schemaD.StringAttribute{
+							MarkdownDescription: "The error message.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"tested_date_time": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The date and time (UTC) the Connection was tested.",
+					CustomType:          timetypes.RFC3339Type{},
+					Computed:            true,
+				},
+			},
+			"timeouts": superschema.TimeoutAttribute{
+				DataSource: &superschema.DatasourceTimeoutAttribute{
+					Read: true,
+				},
+			},
+		},
+	}
+}
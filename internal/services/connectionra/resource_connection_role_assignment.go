@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
 
@@ -27,6 +28,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = (*resourceConnectionRoleAssignment)(nil)
 	_ resource.ResourceWithImportState = (*resourceConnectionRoleAssignment)(nil)
+	_ resource.ResourceWithIdentity    = (*resourceConnectionRoleAssignment)(nil)
 )
 
 type resourceConnectionRoleAssignment struct {
@@ -49,6 +51,23 @@ func (r *resourceConnectionRoleAssignment) Schema(ctx context.Context, _ resourc
 	resp.Schema = itemSchema(false).GetResource(ctx)
 }
 
+func (r *resourceConnectionRoleAssignment) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"connection_id": identityschema.StringAttribute{
+				Description:       "The Connection ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The Connection Role Assignment ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+		},
+	}
+}
+
 func (r *resourceConnectionRoleAssignment) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -107,6 +126,7 @@ func (r *resourceConnectionRoleAssignment) Create(ctx context.Context, req resou
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "CREATE", map[string]any{
 		"action": "end",
@@ -150,6 +170,7 @@ func (r *resourceConnectionRoleAssignment) Read(ctx context.Context, req resourc
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "READ", map[string]any{
 		"action": "end",
@@ -193,6 +214,7 @@ func (r *resourceConnectionRoleAssignment) Update(ctx context.Context, req resou
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "UPDATE", map[string]any{
 		"action": "end",
@@ -240,7 +262,12 @@ func (r *resourceConnectionRoleAssignment) ImportState(ctx context.Context, req
 		"id": req.ID,
 	})
 
-	connectionID, connectionRoleAssignmentID, found := strings.Cut(req.ID, "/")
+	importID, diags := utils.GetImportID(ctx, req, "connection_id", "id")
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectionID, connectionRoleAssignmentID, found := strings.Cut(importID, "/")
 	if !found {
 		resp.Diagnostics.AddError(
 			common.ErrorImportIdentifierHeader,
@@ -278,6 +305,7 @@ func (r *resourceConnectionRoleAssignment) ImportState(ctx context.Context, req
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "IMPORT", map[string]any{
 		"action": "end",
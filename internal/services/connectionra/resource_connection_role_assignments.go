@@ -0,0 +1,72 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package connectionra
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/microsoft/fabric-sdk-go/fabric"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/roleassignments"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func NewResourceConnectionRoleAssignments() resource.Resource {
+	return roleassignments.NewResourceRoleAssignments(roleassignments.RoleAssignmentsResourceConfig{
+		TypeInfo:            ItemTypeInfo,
+		MarkdownDescription: fabricitem.NewResourceMarkdownDescription(ItemTypeInfo, true),
+		ObjectName:          "Connection",
+		ObjectIDAttribute:   "connection_id",
+		PrincipalTypes:      utils.ConvertEnumsToStringSlices(fabcore.PossiblePrincipalTypeValues(), true),
+		Roles:               utils.ConvertEnumsToStringSlices(fabcore.PossibleConnectionRoleValues(), true),
+		NewClient: func(client *fabric.Client) roleassignments.RoleAssignmentsClient {
+			return &roleAssignmentsClient{
+				client: fabcore.NewClientFactoryWithClient(*client).NewConnectionsClient(),
+			}
+		},
+	})
+}
+
+// roleAssignmentsClient adapts the Connection role assignments API to roleassignments.RoleAssignmentsClient.
+type roleAssignmentsClient struct {
+	client *fabcore.ConnectionsClient
+}
+
+func (c *roleAssignmentsClient) List(ctx context.Context, connectionID string) ([]roleassignments.RoleAssignment, error) {
+	respList, err := c.client.ListConnectionRoleAssignments(ctx, connectionID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRoleAssignments(respList), nil
+}
+
+func (c *roleAssignmentsClient) Create(ctx context.Context, connectionID string, assignment roleassignments.RoleAssignment) error {
+	var reqCreate requestCreateConnectionRoleAssignment
+
+	reqCreate.setRoleAssignment(assignment)
+
+	_, err := c.client.AddConnectionRoleAssignment(ctx, connectionID, reqCreate.AddConnectionRoleAssignmentRequest, nil)
+
+	return err
+}
+
+func (c *roleAssignmentsClient) Update(ctx context.Context, connectionID string, assignment roleassignments.RoleAssignment) error {
+	var reqUpdate requestUpdateConnectionRoleAssignment
+
+	reqUpdate.Role = (*fabcore.ConnectionRole)(&assignment.Role)
+
+	_, err := c.client.UpdateConnectionRoleAssignment(ctx, connectionID, assignment.ID, reqUpdate.UpdateConnectionRoleAssignmentRequest, nil)
+
+	return err
+}
+
+func (c *roleAssignmentsClient) Delete(ctx context.Context, connectionID string, assignment roleassignments.RoleAssignment) error {
+	_, err := c.client.DeleteConnectionRoleAssignment(ctx, connectionID, assignment.ID, nil)
+
+	return err
+}
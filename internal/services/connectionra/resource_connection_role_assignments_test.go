@@ -0,0 +1,144 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package connectionra_test
+
+import (
+	"regexp"
+	"testing"
+
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testResourceItemsFQN, testResourceItemsHeader = testhelp.TFResource(common.ProviderTypeName, itemTypeInfo.Types, "test")
+
+func TestUnit_ConnectionRoleAssignmentsResource_ImportState(t *testing.T) {
+	testCase := at.CompileConfig(
+		testResourceItemsHeader,
+		map[string]any{},
+	)
+
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemsFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		{
+			ResourceName:  testResourceItemsFQN,
+			Config:        testCase,
+			ImportStateId: "not-valid",
+			ImportState:   true,
+			ExpectError:   regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+	}))
+}
+
+func TestAcc_ConnectionRoleAssignmentsResource_CRUD(t *testing.T) {
+	entityVirtualNetwork := testhelp.WellKnown()["GatewayVirtualNetwork"].(map[string]any)
+	entityVirtualNetworkID := entityVirtualNetwork["id"].(string)
+
+	connectionHCL := at.CompileConfig(
+		at.ResourceHeader(testhelp.TypeName(common.ProviderTypeName, "connection"), "test"),
+		map[string]any{
+			"display_name":      testhelp.RandomName(),
+			"connectivity_type": "VirtualNetworkGateway",
+			"privacy_level":     "Organizational",
+			"gateway_id":        entityVirtualNetworkID,
+			"connection_details": map[string]any{
+				"type":            "FTP",
+				"creation_method": "FTP.Contents",
+				"parameters": []map[string]any{
+					{
+						"name":  "server",
+						"value": "ftp.example.com",
+					},
+				},
+			},
+			"credential_details": map[string]any{
+				"connection_encryption": string(fabcore.ConnectionEncryptionNotEncrypted),
+				"single_sign_on_type":   string(fabcore.SingleSignOnTypeNone),
+				"skip_test_connection":  false,
+				"credential_type":       string(fabcore.CredentialTypeAnonymous),
+			},
+		},
+	)
+
+	connectionFQN := testhelp.ResourceFQN(common.ProviderTypeName, "connection", "test")
+
+	entity := testhelp.WellKnown()["Principal"].(map[string]any)
+	entityID := entity["id"].(string)
+	entityType := entity["type"].(string)
+
+	// The identity running the tests is the Owner of the new Connection and must be ignored to keep its access.
+	connectionRoleAssignmentsDataSourceHCL := at.CompileConfig(
+		at.DataSourceHeader(testhelp.TypeName(common.ProviderTypeName, itemTypeInfo.Types), "admins"),
+		map[string]any{
+			"connection_id": testhelp.RefByFQN(connectionFQN, "id"),
+		},
+	)
+
+	ignoredPrincipalIDs := at.ConfigLiteral("[for v in data.fabric_connection_role_assignments.admins.values : v.principal.id if v.role == \"Owner\"]")
+
+	resource.Test(t, testhelp.NewTestAccCase(t, &testResourceItemsFQN, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemsFQN,
+			Config: at.JoinConfigs(
+				connectionHCL,
+				connectionRoleAssignmentsDataSourceHCL,
+				at.CompileConfig(
+					testResourceItemsHeader,
+					map[string]any{
+						"connection_id": testhelp.RefByFQN(connectionFQN, "id"),
+						"assignments": []map[string]any{
+							{
+								"principal": map[string]any{
+									"id":   entityID,
+									"type": entityType,
+								},
+								"role": "User",
+							},
+						},
+						"ignored_principal_ids": ignoredPrincipalIDs,
+					},
+				),
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemsFQN, "assignments.#", "1"),
+				resource.TestCheckResourceAttr(testResourceItemsFQN, "assignments.0.principal.id", entityID),
+				resource.TestCheckResourceAttr(testResourceItemsFQN, "assignments.0.role", "User"),
+			),
+		},
+		// Update and Read
+		{
+			ResourceName: testResourceItemsFQN,
+			Config: at.JoinConfigs(
+				connectionHCL,
+				connectionRoleAssignmentsDataSourceHCL,
+				at.CompileConfig(
+					testResourceItemsHeader,
+					map[string]any{
+						"connection_id": testhelp.RefByFQN(connectionFQN, "id"),
+						"assignments": []map[string]any{
+							{
+								"principal": map[string]any{
+									"id":   entityID,
+									"type": entityType,
+								},
+								"role": "UserWithReshare",
+							},
+						},
+						"ignored_principal_ids": ignoredPrincipalIDs,
+					},
+				),
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemsFQN, "assignments.#", "1"),
+				resource.TestCheckResourceAttr(testResourceItemsFQN, "assignments.0.role", "UserWithReshare"),
+			),
+		},
+	}))
+}
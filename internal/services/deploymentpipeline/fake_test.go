@@ -39,3 +39,12 @@ func fakeGetDeploymentPipeline(
 		return resp, errResp
 	}
 }
+
+func fakeListDeploymentPipelines() func(options *fabcore.DeploymentPipelinesClientListDeploymentPipelinesOptions) (resp azfake.PagerResponder[fabcore.DeploymentPipelinesClientListDeploymentPipelinesResponse]) {
+	return func(_ *fabcore.DeploymentPipelinesClientListDeploymentPipelinesOptions) (resp azfake.PagerResponder[fabcore.DeploymentPipelinesClientListDeploymentPipelinesResponse]) {
+		resp = azfake.PagerResponder[fabcore.DeploymentPipelinesClientListDeploymentPipelinesResponse]{}
+		resp.AddPage(http.StatusOK, fabcore.DeploymentPipelinesClientListDeploymentPipelinesResponse{}, nil)
+
+		return resp
+	}
+}
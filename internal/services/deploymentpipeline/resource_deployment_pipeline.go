@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
@@ -24,8 +25,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.ResourceWithConfigure   = (*resourceDeploymentPipeline)(nil)
-	_ resource.ResourceWithImportState = (*resourceDeploymentPipeline)(nil)
+	_ resource.ResourceWithConfigure      = (*resourceDeploymentPipeline)(nil)
+	_ resource.ResourceWithImportState    = (*resourceDeploymentPipeline)(nil)
+	_ resource.ResourceWithIdentity       = (*resourceDeploymentPipeline)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceDeploymentPipeline)(nil)
+	_ resource.ResourceWithModifyPlan     = (*resourceDeploymentPipeline)(nil)
 )
 
 type resourceDeploymentPipeline struct {
@@ -48,6 +52,110 @@ func (r *resourceDeploymentPipeline) Schema(ctx context.Context, _ resource.Sche
 	resp.Schema = itemSchema(false).GetResource(ctx)
 }
 
+func (r *resourceDeploymentPipeline) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The Deployment Pipeline ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+		},
+	}
+}
+
+func (r *resourceDeploymentPipeline) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config resourceDeploymentPipelineModel
+
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &config)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Stages.IsNull() || config.Stages.IsUnknown() {
+		return
+	}
+
+	stages, diags := config.Stages.Get(ctx)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	assigned := make(map[string]int, len(stages))
+
+	for i, stage := range stages {
+		if stage.WorkspaceID.IsNull() || stage.WorkspaceID.IsUnknown() {
+			continue
+		}
+
+		workspaceID := stage.WorkspaceID.ValueString()
+
+		if order, ok := assigned[workspaceID]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("stages").AtListIndex(i).AtName("workspace_id"),
+				common.ErrorInvalidConfig,
+				fmt.Sprintf("The workspace %s is already assigned to the stage at index %d. A workspace can only be assigned to one stage.", workspaceID, order),
+			)
+
+			continue
+		}
+
+		assigned[workspaceID] = i
+	}
+}
+
+func (r *resourceDeploymentPipeline) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	tflog.Debug(ctx, "MODIFY PLAN", map[string]any{
+		"action": "start",
+	})
+
+	if !req.Plan.Raw.IsNull() {
+		var plan resourceDeploymentPipelineModel
+
+		if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+			return
+		}
+
+		var stateStages []*baseDeploymentPipelineStageModel
+
+		if !req.State.Raw.IsNull() {
+			var state resourceDeploymentPipelineModel
+
+			if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+				return
+			}
+
+			var diags diag.Diagnostics
+
+			stateStages, diags = state.Stages.Get(ctx)
+			if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		assignments, diags := changedWorkspaceAssignments(ctx, plan, stateStages)
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
+
+		// the other deployment pipelines are only scanned when a stage is assigned to another workspace
+		if len(assignments) > 0 {
+			timeout, diags := plan.Timeouts.Read(ctx, r.pConfigData.Timeout)
+			if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			resp.Diagnostics.Append(r.validateWorkspaceAssignments(ctx, plan.ID.ValueString(), assignments)...)
+		}
+	}
+
+	tflog.Debug(ctx, "MODIFY PLAN", map[string]any{
+		"action": "end",
+	})
+}
+
 func (r *resourceDeploymentPipeline) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -125,6 +233,7 @@ func (r *resourceDeploymentPipeline) Create(ctx context.Context, req resource.Cr
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "CREATE", map[string]any{
 		"action": "end",
@@ -168,6 +277,7 @@ func (r *resourceDeploymentPipeline) Read(ctx context.Context, req resource.Read
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "READ", map[string]any{
 		"action": "end",
@@ -189,6 +299,16 @@ func (r *resourceDeploymentPipeline) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	var priorStages supertypes.ListNestedObjectValueOf[baseDeploymentPipelineStageModel]
+
+	if resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("stages"), &priorStages)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(checkStagesCount(priorStages, plan.Stages)...); resp.Diagnostics.HasError() {
+		return
+	}
+
 	timeout, diags := plan.Timeouts.Update(ctx, r.pConfigData.Timeout)
 	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
 		return
@@ -229,11 +349,22 @@ func (r *resourceDeploymentPipeline) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	// unassign before assigning, so a workspace can move between stages in a single apply
+	for i, stage := range stateStages {
+		if stage.WorkspaceID.ValueString() == "" || stage.WorkspaceID.ValueString() == planStages[i].WorkspaceID.ValueString() {
+			continue
+		}
+
+		if stage.UnassignWorkspace(ctx, r.client, &state, &resp.Diagnostics, i); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	for i, stage := range planStages {
-		if stage.WorkspaceID.ValueString() == "" && stateStages[i].WorkspaceID.ValueString() != "" {
-			stage.UnassignWorkspace(ctx, r.client, &state, &resp.Diagnostics, i)
-		} else if stage.WorkspaceID.ValueString() != "" && stateStages[i].WorkspaceID.ValueString() == "" {
-			stage.AssignWorkspace(ctx, r.client, &state, &resp.Diagnostics, i)
+		if stage.WorkspaceID.ValueString() != "" && stage.WorkspaceID.ValueString() != stateStages[i].WorkspaceID.ValueString() {
+			if stage.AssignWorkspace(ctx, r.client, &state, &resp.Diagnostics, i); resp.Diagnostics.HasError() {
+				return
+			}
 		}
 
 		if stage.DisplayName.ValueString() != stateStages[i].DisplayName.ValueString() ||
@@ -257,6 +388,7 @@ func (r *resourceDeploymentPipeline) Update(ctx context.Context, req resource.Up
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "UPDATE", map[string]any{
 		"action": "end",
@@ -286,6 +418,31 @@ func (r *resourceDeploymentPipeline) Delete(ctx context.Context, req resource.De
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	// workspaces are unassigned from the stages before the deployment pipeline is deleted
+	diags = r.getByID(ctx, state.ID.ValueString(), &state)
+	if utils.IsErrNotFound(state.ID.ValueString(), &diags, fabcore.ErrCommon.EntityNotFound) {
+		return
+	}
+
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateStages, diags := state.Stages.Get(ctx)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, stage := range stateStages {
+		if stage.WorkspaceID.ValueString() == "" {
+			continue
+		}
+
+		if stage.UnassignWorkspace(ctx, r.client, &state, &resp.Diagnostics, i); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	_, err := r.client.DeleteDeploymentPipeline(ctx, state.ID.ValueString(), nil)
 	if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationDelete, nil)...); resp.Diagnostics.HasError() {
 		return
@@ -304,12 +461,14 @@ func (r *resourceDeploymentPipeline) ImportState(ctx context.Context, req resour
 		"id": req.ID,
 	})
 
-	_, diags := customtypes.NewUUIDValueMust(req.ID)
-	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
-		return
+	if req.ID != "" {
+		_, diags := customtypes.NewUUIDValueMust(req.ID)
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 
 	tflog.Debug(ctx, "IMPORT", map[string]any{
 		"action": "end",
@@ -333,6 +492,84 @@ func (r *resourceDeploymentPipeline) getByID(ctx context.Context, deploymentPipe
 	return nil
 }
 
+// changedWorkspaceAssignments returns the workspaces newly assigned in the plan, keyed by workspace ID with the stage order as value.
+func changedWorkspaceAssignments(
+	ctx context.Context,
+	plan resourceDeploymentPipelineModel,
+	stateStages []*baseDeploymentPipelineStageModel,
+) (map[string]int, diag.Diagnostics) {
+	assignments := make(map[string]int)
+
+	if plan.Stages.IsNull() || plan.Stages.IsUnknown() {
+		return assignments, nil
+	}
+
+	planStages, diags := plan.Stages.Get(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for i, stage := range planStages {
+		if stage.WorkspaceID.IsNull() || stage.WorkspaceID.IsUnknown() {
+			continue
+		}
+
+		if i < len(stateStages) && stateStages[i].WorkspaceID.ValueString() == stage.WorkspaceID.ValueString() {
+			continue
+		}
+
+		assignments[stage.WorkspaceID.ValueString()] = i
+	}
+
+	return assignments, diags
+}
+
+// validateWorkspaceAssignments checks that the given workspaces are not already assigned to a stage of another deployment pipeline.
+func (r *resourceDeploymentPipeline) validateWorkspaceAssignments(ctx context.Context, pipelineID string, assignments map[string]int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	respList, err := r.client.ListDeploymentPipelines(ctx, nil)
+	if diagsList := utils.GetDiagsFromError(ctx, err, utils.OperationList, nil); diagsList.HasError() {
+		return diagsList
+	}
+
+	for _, entity := range respList {
+		if entity.ID == nil || *entity.ID == pipelineID {
+			continue
+		}
+
+		respGet, err := r.client.GetDeploymentPipeline(ctx, *entity.ID, nil)
+		if diagsGet := utils.GetDiagsFromError(ctx, err, utils.OperationRead, nil); diagsGet.HasError() {
+			return append(diags, diagsGet...)
+		}
+
+		for _, stage := range respGet.Stages {
+			if stage.WorkspaceID == nil {
+				continue
+			}
+
+			order, ok := assignments[*stage.WorkspaceID]
+			if !ok {
+				continue
+			}
+
+			diags.AddAttributeError(
+				path.Root("stages").AtListIndex(order).AtName("workspace_id"),
+				common.ErrorInvalidConfig,
+				fmt.Sprintf(
+					"The workspace %s is already assigned to the stage '%s' of the deployment pipeline '%s' (%s). A workspace can only be assigned to one deployment pipeline.",
+					*stage.WorkspaceID,
+					utils.ValueOrZero(stage.DisplayName),
+					utils.ValueOrZero(respGet.DisplayName),
+					*entity.ID,
+				),
+			)
+		}
+	}
+
+	return diags
+}
+
 func (stage *baseDeploymentPipelineStageModel) AssignWorkspace(
 	ctx context.Context,
 	client *fabcore.DeploymentPipelinesClient,
@@ -466,3 +703,24 @@ func (stage *baseDeploymentPipelineStageModel) UpdateStage(
 		return
 	}
 }
+
+// checkStagesCount reports an error when the planned stages count differs from the current one.
+// Stages are updated in place by index, adding or removing stages requires a replacement, which is not planned
+// when the stages are unknown at plan time.
+func checkStagesCount(stateStages, planStages supertypes.ListNestedObjectValueOf[baseDeploymentPipelineStageModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(stateStages.Elements()) != len(planStages.Elements()) {
+		diags.AddAttributeError(
+			path.Root("stages"),
+			common.ErrorUpdateHeader,
+			fmt.Sprintf(
+				"The Deployment Pipeline has %d stages and %d are planned. Stages cannot be added or removed in place, plan again once the stages are known so that the Deployment Pipeline is replaced.",
+				len(stateStages.Elements()),
+				len(planStages.Elements()),
+			),
+		)
+	}
+
+	return diags
+}
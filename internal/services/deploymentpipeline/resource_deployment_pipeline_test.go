@@ -336,12 +336,16 @@ func TestUnit_DeploymentPipelineResource_CRUD_Stage_WorkspaceAssignment(t *testi
 	entityWithWorkspaceAssigned := entityBefore
 	entityWithWorkspaceAssigned.Stages[0].WorkspaceID = new(workspaceID)
 
-	fakes.FakeServer.ServerFactory.Core.DeploymentPipelinesServer.AssignWorkspaceToStage = fakeWorkspaceAssignmentStage()
-	fakes.FakeServer.ServerFactory.Core.DeploymentPipelinesServer.UnassignWorkspaceFromStage = fakeWorkspaceUnassignmentStage()
-
+	preFakeAssign := fakes.FakeServer.ServerFactory.Core.DeploymentPipelinesServer.AssignWorkspaceToStage
+	preFakeUnassign := fakes.FakeServer.ServerFactory.Core.DeploymentPipelinesServer.UnassignWorkspaceFromStage
 	preFakeGet := fakes.FakeServer.ServerFactory.Core.DeploymentPipelinesServer.GetDeploymentPipeline
+	preFakeList := fakes.FakeServer.ServerFactory.Core.DeploymentPipelinesServer.NewListDeploymentPipelinesPager
 
+	fakes.FakeServer.ServerFactory.Core.DeploymentPipelinesServer.AssignWorkspaceToStage = fakeWorkspaceAssignmentStage()
+	fakes.FakeServer.ServerFactory.Core.DeploymentPipelinesServer.UnassignWorkspaceFromStage = fakeWorkspaceUnassignmentStage()
 	fakes.FakeServer.ServerFactory.Core.DeploymentPipelinesServer.GetDeploymentPipeline = fakeGetDeploymentPipeline(entityWithWorkspaceAssigned)
+	// no other deployment pipeline has the workspace assigned, the stubbed Get would report it for every one of them
+	fakes.FakeServer.ServerFactory.Core.DeploymentPipelinesServer.NewListDeploymentPipelinesPager = fakeListDeploymentPipelines()
 
 	resource.Test(t, testhelp.NewTestUnitCaseWithState(t, nil, fakes.FakeServer.ServerFactory, testState, nil, []resource.TestStep{
 		// Update and Read - assign stage
@@ -381,7 +385,131 @@ func TestUnit_DeploymentPipelineResource_CRUD_Stage_WorkspaceAssignment(t *testi
 		},
 	}))
 
+	fakes.FakeServer.ServerFactory.Core.DeploymentPipelinesServer.AssignWorkspaceToStage = preFakeAssign
+	fakes.FakeServer.ServerFactory.Core.DeploymentPipelinesServer.UnassignWorkspaceFromStage = preFakeUnassign
 	fakes.FakeServer.ServerFactory.Core.DeploymentPipelinesServer.GetDeploymentPipeline = preFakeGet
+	fakes.FakeServer.ServerFactory.Core.DeploymentPipelinesServer.NewListDeploymentPipelinesPager = preFakeList
+}
+
+func TestUnit_DeploymentPipelineResource_CRUD_Stage_WorkspaceReassignment(t *testing.T) {
+	workspace1ID := testhelp.RandomUUID()
+	workspace2ID := testhelp.RandomUUID()
+
+	entityBefore := fakes.NewRandomDeploymentPipelineWithStages()
+
+	entityOther := fakes.NewRandomDeploymentPipelineWithStages()
+	entityOther.Stages[0].WorkspaceID = new(testhelp.RandomUUID())
+
+	fakes.FakeServer.Upsert(entityOther)
+
+	stagesConfig := func(stage0WorkspaceID, stage1WorkspaceID *string) []map[string]any {
+		stages := []map[string]any{
+			{
+				"display_name": *entityBefore.Stages[0].DisplayName,
+				"description":  *entityBefore.Stages[0].Description,
+				"is_public":    *entityBefore.Stages[0].IsPublic,
+			},
+			{
+				"display_name": *entityBefore.Stages[1].DisplayName,
+				"description":  *entityBefore.Stages[1].Description,
+				"is_public":    *entityBefore.Stages[1].IsPublic,
+			},
+		}
+
+		if stage0WorkspaceID != nil {
+			stages[0]["workspace_id"] = *stage0WorkspaceID
+		}
+
+		if stage1WorkspaceID != nil {
+			stages[1]["workspace_id"] = *stage1WorkspaceID
+		}
+
+		return stages
+	}
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - same workspace assigned to two stages
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"display_name": *entityBefore.DisplayName,
+					"stages":       stagesConfig(&workspace1ID, &workspace1ID),
+				},
+			),
+			ExpectError: regexp.MustCompile(`A workspace can only be assigned to one stage`),
+		},
+		// error - workspace assigned to a stage of another deployment pipeline
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"display_name": *entityBefore.DisplayName,
+					"stages":       stagesConfig(entityOther.Stages[0].WorkspaceID, nil),
+				},
+			),
+			ExpectError: regexp.MustCompile(`A workspace can only be assigned to one deployment pipeline`),
+		},
+		// Create and Read - assign stage
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"display_name": *entityBefore.DisplayName,
+					"description":  *entityBefore.Description,
+					"stages":       stagesConfig(&workspace1ID, nil),
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrPtr(testResourceItemFQN, "display_name", entityBefore.DisplayName),
+				resource.TestCheckResourceAttrPtr(testResourceItemFQN, "description", entityBefore.Description),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "stages.0.display_name", *entityBefore.Stages[0].DisplayName),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "stages.0.description", *entityBefore.Stages[0].Description),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "stages.0.is_public", strconv.FormatBool(*entityBefore.Stages[0].IsPublic)),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "stages.0.workspace_id", workspace1ID),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "stages.1.display_name", *entityBefore.Stages[1].DisplayName),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "stages.1.description", *entityBefore.Stages[1].Description),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "stages.1.is_public", strconv.FormatBool(*entityBefore.Stages[1].IsPublic)),
+				resource.TestCheckNoResourceAttr(testResourceItemFQN, "stages.1.workspace_id"),
+			),
+		},
+		// Update and Read - reassign stages, moving the workspace to the next stage
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"display_name": *entityBefore.DisplayName,
+					"description":  *entityBefore.Description,
+					"stages":       stagesConfig(&workspace2ID, &workspace1ID),
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "stages.0.workspace_id", workspace2ID),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "stages.1.workspace_id", workspace1ID),
+			),
+		},
+		// Update and Read - unassign stage
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"display_name": *entityBefore.DisplayName,
+					"description":  *entityBefore.Description,
+					"stages":       stagesConfig(nil, &workspace1ID),
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckNoResourceAttr(testResourceItemFQN, "stages.0.workspace_id"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "stages.1.workspace_id", workspace1ID),
+			),
+		},
+		// Delete - the remaining workspace is unassigned before the deployment pipeline is deleted
+	}))
 }
 
 func TestAcc_DeploymentPipelineResource_CRUD(t *testing.T) {
@@ -4,6 +4,8 @@
 package deploymentpipeline
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema" //revive:disable-line:import-alias-naming
@@ -40,7 +42,11 @@ func itemSchema(isList bool) superschema.Schema { //revive:disable-line:flag-par
 			Resource: &schemaR.ListNestedAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
+					listplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfStagesCountChanged,
+						"Stages cannot be added or removed, changing the number of stages requires replacement.",
+						"Stages cannot be added or removed, changing the number of stages requires replacement.",
+					),
 				},
 				Validators: []validator.List{
 					listvalidator.SizeBetween(2, 10),
@@ -111,6 +117,9 @@ func itemSchema(isList bool) superschema.Schema { //revive:disable-line:flag-par
 						CustomType:          customtypes.UUIDType{},
 					},
 					Resource: &schemaR.StringAttribute{
+						MarkdownDescription: "A workspace can only be assigned to one stage of one deployment pipeline. " +
+							"Changing the workspace unassigns the previous workspace before assigning the new one, " +
+							"and assigned workspaces are unassigned before the deployment pipeline is deleted.",
 						Optional: true,
 						Computed: false,
 					},
@@ -190,3 +199,14 @@ func itemSchema(isList bool) superschema.Schema { //revive:disable-line:flag-par
 		},
 	}
 }
+
+// requiresReplaceIfStagesCountChanged requires replacement when stages are added or removed,
+// stage properties and workspace assignments are updated in place.
+// Unknown stages cannot be counted at plan time, the count is checked again by Update.
+func requiresReplaceIfStagesCountChanged(_ context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	resp.RequiresReplace = len(req.StateValue.Elements()) != len(req.PlanValue.Elements())
+}
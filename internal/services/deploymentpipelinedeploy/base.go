@@ -0,0 +1,16 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package deploymentpipelinedeploy
+
+import "github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+
+var ItemTypeInfo = tftypeinfo.TFTypeInfo{ //nolint:gochecknoglobals
+	Name:           "Deployment Pipeline Deployment",
+	Type:           "deployment_pipeline_deployment",
+	Names:          "Deployment Pipeline Deployments",
+	Types:          "deployment_pipeline_deployments",
+	DocsURL:        "https://learn.microsoft.com/fabric/cicd/deployment-pipelines/deploy-content",
+	IsPreview:      false,
+	IsSPNSupported: true,
+}
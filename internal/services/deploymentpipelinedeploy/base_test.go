@@ -0,0 +1,10 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package deploymentpipelinedeploy_test
+
+import (
+	"github.com/microsoft/terraform-provider-fabric/internal/services/deploymentpipelinedeploy"
+)
+
+var itemTypeInfo = deploymentpipelinedeploy.ItemTypeInfo
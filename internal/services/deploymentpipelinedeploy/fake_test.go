@@ -0,0 +1,111 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package deploymentpipelinedeploy_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	azto "github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	fabfake "github.com/microsoft/fabric-sdk-go/fabric/fake"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+)
+
+// Returns a fake function that simulates a successful deployment, reporting one succeeded step per requested item.
+func fakeDeployStageContent(
+	operationID string,
+) func(ctx context.Context, deploymentPipelineID string, deployRequest fabcore.DeployRequest, options *fabcore.DeploymentPipelinesClientBeginDeployStageContentOptions) (resp azfake.PollerResponder[fabcore.DeploymentPipelinesClientDeployStageContentResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _ string, deployRequest fabcore.DeployRequest, _ *fabcore.DeploymentPipelinesClientBeginDeployStageContentOptions) (resp azfake.PollerResponder[fabcore.DeploymentPipelinesClientDeployStageContentResponse], errResp azfake.ErrorResponder) {
+		operation := NewRandomDeploymentOperation(operationID, deployRequest, fabcore.DeploymentPipelineOperationStatusSucceeded)
+
+		resp = azfake.PollerResponder[fabcore.DeploymentPipelinesClientDeployStageContentResponse]{}
+		resp.SetTerminalResponse(http.StatusOK, fabcore.DeploymentPipelinesClientDeployStageContentResponse{DeploymentPipelineOperationExtendedInfo: operation}, nil)
+
+		return resp, errResp
+	}
+}
+
+// Returns a fake function that simulates a failed deployment.
+func fakeDeployStageContentFailed() func(ctx context.Context, deploymentPipelineID string, deployRequest fabcore.DeployRequest, options *fabcore.DeploymentPipelinesClientBeginDeployStageContentOptions) (resp azfake.PollerResponder[fabcore.DeploymentPipelinesClientDeployStageContentResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _ string, _ fabcore.DeployRequest, _ *fabcore.DeploymentPipelinesClientBeginDeployStageContentOptions) (resp azfake.PollerResponder[fabcore.DeploymentPipelinesClientDeployStageContentResponse], errResp azfake.ErrorResponder) {
+		errResp.SetError(fabfake.SetResponseError(http.StatusBadRequest, "DeploymentFailed", "Deployment failed"))
+
+		return resp, errResp
+	}
+}
+
+// Returns a fake pager function that simulates listing deployment pipeline operations with a provided example response.
+func fakeListDeploymentPipelineOperations(
+	exampleResp fabcore.DeploymentPipelineOperations,
+) func(deploymentPipelineID string, options *fabcore.DeploymentPipelinesClientListDeploymentPipelineOperationsOptions) (resp azfake.PagerResponder[fabcore.DeploymentPipelinesClientListDeploymentPipelineOperationsResponse]) {
+	return func(_ string, _ *fabcore.DeploymentPipelinesClientListDeploymentPipelineOperationsOptions) (resp azfake.PagerResponder[fabcore.DeploymentPipelinesClientListDeploymentPipelineOperationsResponse]) {
+		resp = azfake.PagerResponder[fabcore.DeploymentPipelinesClientListDeploymentPipelineOperationsResponse]{}
+		resp.AddPage(http.StatusOK, fabcore.DeploymentPipelinesClientListDeploymentPipelineOperationsResponse{DeploymentPipelineOperations: exampleResp}, nil)
+
+		return resp
+	}
+}
+
+// Returns a fake function that simulates getting a deployment pipeline operation with a provided example response.
+func fakeGetDeploymentPipelineOperation(
+	exampleResp fabcore.DeploymentPipelineOperationExtendedInfo,
+) func(ctx context.Context, deploymentPipelineID, operationID string, options *fabcore.DeploymentPipelinesClientGetDeploymentPipelineOperationOptions) (resp azfake.Responder[fabcore.DeploymentPipelinesClientGetDeploymentPipelineOperationResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _, _ string, _ *fabcore.DeploymentPipelinesClientGetDeploymentPipelineOperationOptions) (resp azfake.Responder[fabcore.DeploymentPipelinesClientGetDeploymentPipelineOperationResponse], errResp azfake.ErrorResponder) {
+		resp = azfake.Responder[fabcore.DeploymentPipelinesClientGetDeploymentPipelineOperationResponse]{}
+		resp.SetResponse(http.StatusOK, fabcore.DeploymentPipelinesClientGetDeploymentPipelineOperationResponse{DeploymentPipelineOperationExtendedInfo: exampleResp}, nil)
+
+		return resp, errResp
+	}
+}
+
+func NewRandomDeploymentOperation(operationID string, deployRequest fabcore.DeployRequest, status fabcore.DeploymentPipelineOperationStatus) fabcore.DeploymentPipelineOperationExtendedInfo {
+	steps := make([]fabcore.DeploymentExecutionStep, 0, len(deployRequest.Items))
+
+	for i, item := range deployRequest.Items {
+		step := fabcore.DeploymentExecutionStep{
+			Index:                  azto.Ptr(int32(i)),
+			Description:            new("Deploy " + string(*item.ItemType)),
+			Status:                 azto.Ptr(status),
+			PreDeploymentDiffState: azto.Ptr(fabcore.ItemPreDeploymentDiffStateNew),
+			SourceAndTarget: &fabcore.DeploymentSourceAndTarget{
+				SourceItemID:          item.SourceItemID,
+				SourceItemDisplayName: new(testhelp.RandomName()),
+				ItemType:              item.ItemType,
+			},
+		}
+
+		if status == fabcore.DeploymentPipelineOperationStatusFailed {
+			step.Error = &fabcore.ErrorResponse{
+				ErrorCode: new("ItemDeploymentFailed"),
+				Message:   new("The item could not be deployed"),
+			}
+		}
+
+		steps = append(steps, step)
+	}
+
+	now := time.Now().UTC()
+
+	return fabcore.DeploymentPipelineOperationExtendedInfo{
+		ID:                 new(operationID),
+		Type:               azto.Ptr(fabcore.DeploymentPipelineOperationTypeDeploy),
+		Status:             azto.Ptr(status),
+		SourceStageID:      deployRequest.SourceStageID,
+		TargetStageID:      deployRequest.TargetStageID,
+		ExecutionStartTime: azto.Ptr(now),
+		ExecutionEndTime:   azto.Ptr(now.Add(time.Minute)),
+		PreDeploymentDiffInformation: &fabcore.PreDeploymentDiffInformation{
+			NewItemsCount:          azto.Ptr(int32(len(steps))),
+			DifferentItemsCount:    azto.Ptr(int32(0)),
+			NoDifferenceItemsCount: azto.Ptr(int32(0)),
+		},
+		ExecutionPlan: &fabcore.DeploymentExecutionPlan{
+			Steps: steps,
+		},
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package deploymentpipelinedeploy
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+)
+
+/*
+RESOURCE
+*/
+
+type resourceDeploymentPipelineDeploymentModel struct {
+	ID                   customtypes.UUID                                             `tfsdk:"id"`
+	DeploymentPipelineID customtypes.UUID                                             `tfsdk:"deployment_pipeline_id"`
+	SourceStageID        customtypes.UUID                                             `tfsdk:"source_stage_id"`
+	TargetStageID        customtypes.UUID                                             `tfsdk:"target_stage_id"`
+	Items                supertypes.SetNestedObjectValueOf[itemModel]                 `tfsdk:"items"`
+	Note                 types.String                                                 `tfsdk:"note"`
+	Options              supertypes.SingleNestedObjectValueOf[optionsModel]           `tfsdk:"options"`
+	NewWorkspace         supertypes.SingleNestedObjectValueOf[newWorkspaceModel]      `tfsdk:"new_workspace"`
+	Triggers             supertypes.MapValueOf[types.String]                          `tfsdk:"triggers"`
+	Status               types.String                                                 `tfsdk:"status"`
+	ExecutionStartTime   timetypes.RFC3339                                            `tfsdk:"execution_start_time"`
+	ExecutionEndTime     timetypes.RFC3339                                            `tfsdk:"execution_end_time"`
+	PreDeploymentDiff    supertypes.SingleNestedObjectValueOf[preDeploymentDiffModel] `tfsdk:"pre_deployment_diff"`
+	Steps                supertypes.ListNestedObjectValueOf[stepModel]                `tfsdk:"steps"`
+	Timeouts             timeouts.Value                                               `tfsdk:"timeouts"`
+}
+
+func (to *resourceDeploymentPipelineDeploymentModel) set(ctx context.Context, from fabcore.DeploymentPipelineOperationExtendedInfo) diag.Diagnostics {
+	to.ID = customtypes.NewUUIDPointerValue(from.ID)
+	to.Status = types.StringPointerValue((*string)(from.Status))
+	to.ExecutionStartTime = timetypes.NewRFC3339TimePointerValue(from.ExecutionStartTime)
+	to.ExecutionEndTime = timetypes.NewRFC3339TimePointerValue(from.ExecutionEndTime)
+
+	preDeploymentDiff := supertypes.NewSingleNestedObjectValueOfNull[preDeploymentDiffModel](ctx)
+
+	if from.PreDeploymentDiffInformation != nil {
+		preDeploymentDiffModel := &preDeploymentDiffModel{}
+		preDeploymentDiffModel.set(*from.PreDeploymentDiffInformation)
+
+		if diags := preDeploymentDiff.Set(ctx, preDeploymentDiffModel); diags.HasError() {
+			return diags
+		}
+	}
+
+	to.PreDeploymentDiff = preDeploymentDiff
+
+	steps := make([]*stepModel, 0)
+
+	if from.ExecutionPlan != nil {
+		for _, entity := range from.ExecutionPlan.Steps {
+			var entityModel stepModel
+
+			entityModel.set(entity)
+
+			steps = append(steps, &entityModel)
+		}
+	}
+
+	return to.Steps.Set(ctx, steps)
+}
+
+type requestDeployStageContent struct {
+	fabcore.DeployRequest
+}
+
+func (to *requestDeployStageContent) set(ctx context.Context, from resourceDeploymentPipelineDeploymentModel) diag.Diagnostics {
+	to.SourceStageID = from.SourceStageID.ValueStringPointer()
+	to.TargetStageID = from.TargetStageID.ValueStringPointer()
+	to.Note = from.Note.ValueStringPointer()
+
+	if !from.Items.IsNull() && !from.Items.IsUnknown() {
+		items, diags := from.Items.Get(ctx)
+		if diags.HasError() {
+			return diags
+		}
+
+		to.Items = make([]fabcore.ItemDeploymentRequest, 0, len(items))
+
+		for _, item := range items {
+			to.Items = append(to.Items, fabcore.ItemDeploymentRequest{
+				SourceItemID: item.SourceItemID.ValueStringPointer(),
+				ItemType:     (*fabcore.ItemType)(item.ItemType.ValueStringPointer()),
+			})
+		}
+	}
+
+	if !from.Options.IsNull() && !from.Options.IsUnknown() {
+		options, diags := from.Options.Get(ctx)
+		if diags.HasError() {
+			return diags
+		}
+
+		to.Options = &fabcore.DeploymentOptions{
+			AllowCrossRegionDeployment: options.AllowCrossRegionDeployment.ValueBoolPointer(),
+		}
+	}
+
+	if !from.NewWorkspace.IsNull() && !from.NewWorkspace.IsUnknown() {
+		newWorkspace, diags := from.NewWorkspace.Get(ctx)
+		if diags.HasError() {
+			return diags
+		}
+
+		to.CreatedWorkspaceDetails = &fabcore.DeploymentPipelineNewWorkspaceConfiguration{
+			Name:       newWorkspace.Name.ValueStringPointer(),
+			CapacityID: newWorkspace.CapacityID.ValueStringPointer(),
+		}
+	}
+
+	return nil
+}
+
+/*
+HELPER MODELS
+*/
+
+type itemModel struct {
+	SourceItemID customtypes.UUID `tfsdk:"source_item_id"`
+	ItemType     types.String     `tfsdk:"item_type"`
+}
+
+type optionsModel struct {
+	AllowCrossRegionDeployment types.Bool `tfsdk:"allow_cross_region_deployment"`
+}
+
+type newWorkspaceModel struct {
+	Name       types.String     `tfsdk:"name"`
+	CapacityID customtypes.UUID `tfsdk:"capacity_id"`
+}
+
+type preDeploymentDiffModel struct {
+	NewItemsCount          types.Int32 `tfsdk:"new_items_count"`
+	DifferentItemsCount    types.Int32 `tfsdk:"different_items_count"`
+	NoDifferenceItemsCount types.Int32 `tfsdk:"no_difference_items_count"`
+}
+
+func (to *preDeploymentDiffModel) set(from fabcore.PreDeploymentDiffInformation) {
+	to.NewItemsCount = types.Int32PointerValue(from.NewItemsCount)
+	to.DifferentItemsCount = types.Int32PointerValue(from.DifferentItemsCount)
+	to.NoDifferenceItemsCount = types.Int32PointerValue(from.NoDifferenceItemsCount)
+}
+
+type stepModel struct {
+	Index                  types.Int32      `tfsdk:"index"`
+	Description            types.String     `tfsdk:"description"`
+	Status                 types.String     `tfsdk:"status"`
+	PreDeploymentDiffState types.String     `tfsdk:"pre_deployment_diff_state"`
+	ItemType               types.String     `tfsdk:"item_type"`
+	SourceItemID           customtypes.UUID `tfsdk:"source_item_id"`
+	SourceItemDisplayName  types.String     `tfsdk:"source_item_display_name"`
+	TargetItemID           customtypes.UUID `tfsdk:"target_item_id"`
+	TargetItemDisplayName  types.String     `tfsdk:"target_item_display_name"`
+	ErrorMessage           types.String     `tfsdk:"error_message"`
+}
+
+func (to *stepModel) set(from fabcore.DeploymentExecutionStep) {
+	to.Index = types.Int32PointerValue(from.Index)
+	to.Description = types.StringPointerValue(from.Description)
+	to.Status = types.StringPointerValue((*string)(from.Status))
+	to.PreDeploymentDiffState = types.StringPointerValue((*string)(from.PreDeploymentDiffState))
+	to.ItemType = types.StringNull()
+	to.SourceItemID = customtypes.NewUUIDNull()
+	to.SourceItemDisplayName = types.StringNull()
+	to.TargetItemID = customtypes.NewUUIDNull()
+	to.TargetItemDisplayName = types.StringNull()
+	to.ErrorMessage = types.StringNull()
+
+	if from.SourceAndTarget != nil {
+		to.ItemType = types.StringPointerValue((*string)(from.SourceAndTarget.ItemType))
+		to.SourceItemID = customtypes.NewUUIDPointerValue(from.SourceAndTarget.SourceItemID)
+		to.SourceItemDisplayName = types.StringPointerValue(from.SourceAndTarget.SourceItemDisplayName)
+		to.TargetItemID = customtypes.NewUUIDPointerValue(from.SourceAndTarget.TargetItemID)
+		to.TargetItemDisplayName = types.StringPointerValue(from.SourceAndTarget.TargetItemDisplayName)
+	}
+
+	if from.Error != nil {
+		to.ErrorMessage = types.StringPointerValue(from.Error.Message)
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package deploymentpipelinedeploy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.ResourceWithConfigure      = (*resourceDeploymentPipelineDeployment)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceDeploymentPipelineDeployment)(nil)
+)
+
+type resourceDeploymentPipelineDeployment struct {
+	pConfigData *pconfig.ProviderData
+	client      *fabcore.DeploymentPipelinesClient
+	TypeInfo    tftypeinfo.TFTypeInfo
+}
+
+func NewResourceDeploymentPipelineDeployment() resource.Resource {
+	return &resourceDeploymentPipelineDeployment{
+		TypeInfo: ItemTypeInfo,
+	}
+}
+
+func (r *resourceDeploymentPipelineDeployment) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeInfo.FullTypeName(false)
+}
+
+func (r *resourceDeploymentPipelineDeployment) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = itemSchema().GetResource(ctx)
+}
+
+func (r *resourceDeploymentPipelineDeployment) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config resourceDeploymentPipelineDeploymentModel
+
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &config)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.SourceStageID.IsNull() || config.SourceStageID.IsUnknown() || config.TargetStageID.IsNull() || config.TargetStageID.IsUnknown() {
+		return
+	}
+
+	if config.SourceStageID.ValueString() == config.TargetStageID.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_stage_id"),
+			common.ErrorInvalidConfig,
+			"The target stage must be different from the source stage.",
+		)
+	}
+}
+
+func (r *resourceDeploymentPipelineDeployment) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorResourceConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	r.pConfigData = pConfigData
+
+	if resp.Diagnostics.Append(fabricitem.IsPreviewMode(r.TypeInfo.Name, r.TypeInfo.IsPreview, r.pConfigData.Preview)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client = fabcore.NewClientFactoryWithClient(*pConfigData.FabricClient).NewDeploymentPipelinesClient()
+}
+
+func (r *resourceDeploymentPipelineDeployment) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan resourceDeploymentPipelineDeploymentModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var reqDeploy requestDeployStageContent
+
+	if resp.Diagnostics.Append(reqDeploy.set(ctx, plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	startTime := time.Now().UTC()
+
+	respDeploy, err := r.client.DeployStageContent(ctx, plan.DeploymentPipelineID.ValueString(), reqDeploy.DeployRequest, nil)
+	if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationCreate, nil)...); resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(r.getFailedStepsDiags(ctx, plan, startTime)...)
+
+		return
+	}
+
+	if resp.Diagnostics.Append(plan.set(ctx, respDeploy.DeploymentPipelineOperationExtendedInfo)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceDeploymentPipelineDeployment) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "start",
+	})
+
+	// the deployment is a completed operation, the state is kept as is
+
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "end",
+	})
+}
+
+func (r *resourceDeploymentPipelineDeployment) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan, state resourceDeploymentPipelineDeploymentModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only the timeouts can be updated in place, every other argument requires a new deployment
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceDeploymentPipelineDeployment) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "start",
+	})
+
+	// deployed content cannot be reverted, the resource is only removed from the state
+
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "end",
+	})
+}
+
+// getFailedStepsDiags looks up the deploy operation started for the given model and returns a diagnostic for each failed step of its execution plan.
+func (r *resourceDeploymentPipelineDeployment) getFailedStepsDiags(ctx context.Context, model resourceDeploymentPipelineDeploymentModel, startTime time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	respList, err := r.client.ListDeploymentPipelineOperations(ctx, model.DeploymentPipelineID.ValueString(), nil)
+	if err != nil {
+		tflog.Debug(ctx, "Could not list deployment pipeline operations", map[string]any{
+			"error": err.Error(),
+		})
+
+		return diags
+	}
+
+	var operation *fabcore.DeploymentPipelineOperation
+
+	for i, entity := range respList {
+		if entity.Type == nil || *entity.Type != fabcore.DeploymentPipelineOperationTypeDeploy ||
+			entity.SourceStageID == nil || *entity.SourceStageID != model.SourceStageID.ValueString() ||
+			entity.TargetStageID == nil || *entity.TargetStageID != model.TargetStageID.ValueString() ||
+			entity.ExecutionStartTime == nil || entity.ExecutionStartTime.Before(startTime.Add(-time.Minute)) {
+			continue
+		}
+
+		if operation == nil || entity.ExecutionStartTime.After(*operation.ExecutionStartTime) {
+			operation = &respList[i]
+		}
+	}
+
+	if operation == nil {
+		return diags
+	}
+
+	respGet, err := r.client.GetDeploymentPipelineOperation(ctx, model.DeploymentPipelineID.ValueString(), *operation.ID, nil)
+	if err != nil || respGet.ExecutionPlan == nil {
+		return diags
+	}
+
+	for _, step := range respGet.ExecutionPlan.Steps {
+		if step.Status == nil || *step.Status != fabcore.DeploymentPipelineOperationStatusFailed {
+			continue
+		}
+
+		var stepModel stepModel
+
+		stepModel.set(step)
+
+		diags.AddError(
+			common.ErrorDeploymentStepHeader,
+			fmt.Sprintf(
+				common.ErrorDeploymentStepDetails,
+				stepModel.Index.ValueInt32(),
+				stepModel.SourceItemDisplayName.ValueString(),
+				stepModel.ItemType.ValueString(),
+				stepModel.SourceItemID.ValueString(),
+				stepModel.ErrorMessage.ValueString(),
+			),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,329 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package deploymentpipelinedeploy_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	azto "github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testResourceItemFQN, testResourceItemHeader = testhelp.TFResource(common.ProviderTypeName, itemTypeInfo.Type, "test")
+
+func TestUnit_DeploymentPipelineDeploymentResource_Attributes(t *testing.T) {
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no required attributes - deployment_pipeline_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"source_stage_id": "00000000-0000-0000-0000-000000000000",
+					"target_stage_id": "11111111-1111-1111-1111-111111111111",
+				},
+			),
+			ExpectError: regexp.MustCompile(`The argument "deployment_pipeline_id" is required, but no definition was found.`),
+		},
+		// error - no required attributes - source_stage_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"deployment_pipeline_id": "00000000-0000-0000-0000-000000000000",
+					"target_stage_id":        "11111111-1111-1111-1111-111111111111",
+				},
+			),
+			ExpectError: regexp.MustCompile(`The argument "source_stage_id" is required, but no definition was found.`),
+		},
+		// error - no required attributes - target_stage_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"deployment_pipeline_id": "00000000-0000-0000-0000-000000000000",
+					"source_stage_id":        "11111111-1111-1111-1111-111111111111",
+				},
+			),
+			ExpectError: regexp.MustCompile(`The argument "target_stage_id" is required, but no definition was found.`),
+		},
+		// error - invalid UUID - source_stage_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"deployment_pipeline_id": "00000000-0000-0000-0000-000000000000",
+					"source_stage_id":        "invalid uuid",
+					"target_stage_id":        "11111111-1111-1111-1111-111111111111",
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - same source and target stage
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"deployment_pipeline_id": "00000000-0000-0000-0000-000000000000",
+					"source_stage_id":        "11111111-1111-1111-1111-111111111111",
+					"target_stage_id":        "11111111-1111-1111-1111-111111111111",
+				},
+			),
+			ExpectError: regexp.MustCompile(`The target stage must be different from the source stage.`),
+		},
+		// error - invalid item type
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"deployment_pipeline_id": "00000000-0000-0000-0000-000000000000",
+					"source_stage_id":        "11111111-1111-1111-1111-111111111111",
+					"target_stage_id":        "22222222-2222-2222-2222-222222222222",
+					"items": []map[string]any{
+						{
+							"source_item_id": "33333333-3333-3333-3333-333333333333",
+							"item_type":      "InvalidType",
+						},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(`Attribute items\[.*\].item_type value must be one of`),
+		},
+	}))
+}
+
+func TestUnit_DeploymentPipelineDeploymentResource_CRUD(t *testing.T) {
+	operationID := testhelp.RandomUUID()
+	deploymentPipelineID := testhelp.RandomUUID()
+	sourceStageID := testhelp.RandomUUID()
+	targetStageID := testhelp.RandomUUID()
+	notebookID := testhelp.RandomUUID()
+	lakehouseID := testhelp.RandomUUID()
+
+	fakes.FakeServer.ServerFactory.Core.DeploymentPipelinesServer.BeginDeployStageContent = fakeDeployStageContent(operationID)
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"deployment_pipeline_id": deploymentPipelineID,
+					"source_stage_id":        sourceStageID,
+					"target_stage_id":        targetStageID,
+					"note":                   "Release 1.0",
+					"items": []map[string]any{
+						{
+							"source_item_id": notebookID,
+							"item_type":      string(fabcore.ItemTypeNotebook),
+						},
+						{
+							"source_item_id": lakehouseID,
+							"item_type":      string(fabcore.ItemTypeLakehouse),
+						},
+					},
+					"options": map[string]any{
+						"allow_cross_region_deployment": true,
+					},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "id", operationID),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "status", string(fabcore.DeploymentPipelineOperationStatusSucceeded)),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "pre_deployment_diff.new_items_count", "2"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "steps.#", "2"),
+				resource.TestCheckResourceAttrSet(testResourceItemFQN, "steps.0.source_item_id"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "steps.0.status", string(fabcore.DeploymentPipelineOperationStatusSucceeded)),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "steps.1.status", string(fabcore.DeploymentPipelineOperationStatusSucceeded)),
+				resource.TestCheckResourceAttrSet(testResourceItemFQN, "execution_start_time"),
+			),
+		},
+		// Update triggers - new deployment
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"deployment_pipeline_id": deploymentPipelineID,
+					"source_stage_id":        sourceStageID,
+					"target_stage_id":        targetStageID,
+					"note":                   "Release 1.0",
+					"items": []map[string]any{
+						{
+							"source_item_id": notebookID,
+							"item_type":      string(fabcore.ItemTypeNotebook),
+						},
+						{
+							"source_item_id": lakehouseID,
+							"item_type":      string(fabcore.ItemTypeLakehouse),
+						},
+					},
+					"options": map[string]any{
+						"allow_cross_region_deployment": true,
+					},
+					"triggers": map[string]any{
+						"version": "2",
+					},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "triggers.version", "2"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "steps.#", "2"),
+			),
+		},
+	}))
+}
+
+func TestUnit_DeploymentPipelineDeploymentResource_Failed(t *testing.T) {
+	operationID := testhelp.RandomUUID()
+	deploymentPipelineID := testhelp.RandomUUID()
+	sourceStageID := testhelp.RandomUUID()
+	targetStageID := testhelp.RandomUUID()
+	notebookID := testhelp.RandomUUID()
+
+	failedOperation := NewRandomDeploymentOperation(operationID, fabcore.DeployRequest{
+		SourceStageID: new(sourceStageID),
+		TargetStageID: new(targetStageID),
+		Items: []fabcore.ItemDeploymentRequest{
+			{
+				SourceItemID: new(notebookID),
+				ItemType:     azto.Ptr(fabcore.ItemTypeNotebook),
+			},
+		},
+	}, fabcore.DeploymentPipelineOperationStatusFailed)
+	failedOperation.ExecutionStartTime = azto.Ptr(time.Now().UTC().Add(time.Hour))
+
+	fakes.FakeServer.ServerFactory.Core.DeploymentPipelinesServer.BeginDeployStageContent = fakeDeployStageContentFailed()
+	fakes.FakeServer.ServerFactory.Core.DeploymentPipelinesServer.NewListDeploymentPipelineOperationsPager = fakeListDeploymentPipelineOperations(
+		fabcore.DeploymentPipelineOperations{
+			Value: []fabcore.DeploymentPipelineOperation{
+				{
+					ID:                 failedOperation.ID,
+					Type:               failedOperation.Type,
+					Status:             failedOperation.Status,
+					SourceStageID:      failedOperation.SourceStageID,
+					TargetStageID:      failedOperation.TargetStageID,
+					ExecutionStartTime: failedOperation.ExecutionStartTime,
+				},
+			},
+		},
+	)
+	fakes.FakeServer.ServerFactory.Core.DeploymentPipelinesServer.GetDeploymentPipelineOperation = fakeGetDeploymentPipelineOperation(failedOperation)
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"deployment_pipeline_id": deploymentPipelineID,
+					"source_stage_id":        sourceStageID,
+					"target_stage_id":        targetStageID,
+					"items": []map[string]any{
+						{
+							"source_item_id": notebookID,
+							"item_type":      string(fabcore.ItemTypeNotebook),
+						},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorDeploymentStepHeader),
+		},
+	}))
+}
+
+func TestAcc_DeploymentPipelineDeploymentResource_CRUD(t *testing.T) {
+	capacity := testhelp.WellKnown()["Capacity"].(map[string]any)
+	capacityID := capacity["id"].(string)
+
+	workspaceSourceResourceHCL, workspaceSourceResourceFQN := testhelp.TestAccWorkspaceResource(t, capacityID)
+
+	workspaceTargetResourceFQN := testhelp.ResourceFQN(common.ProviderTypeName, "workspace", "target")
+	workspaceTargetResourceHCL := at.CompileConfig(
+		at.ResourceHeader(testhelp.TypeName(common.ProviderTypeName, "workspace"), "target"),
+		map[string]any{
+			"display_name": testhelp.RandomName(),
+			"description":  "testacc",
+			"capacity_id":  capacityID,
+		},
+	)
+
+	lakehouseResourceFQN := testhelp.ResourceFQN(common.ProviderTypeName, "lakehouse", "test")
+	lakehouseResourceHCL := at.CompileConfig(
+		at.ResourceHeader(testhelp.TypeName(common.ProviderTypeName, "lakehouse"), "test"),
+		map[string]any{
+			"display_name": testhelp.RandomName(),
+			"workspace_id": testhelp.RefByFQN(workspaceSourceResourceFQN, "id"),
+		},
+	)
+
+	deploymentPipelineResourceFQN := testhelp.ResourceFQN(common.ProviderTypeName, "deployment_pipeline", "test")
+	deploymentPipelineResourceHCL := at.CompileConfig(
+		at.ResourceHeader(testhelp.TypeName(common.ProviderTypeName, "deployment_pipeline"), "test"),
+		map[string]any{
+			"display_name": testhelp.RandomName(),
+			"stages": []map[string]any{
+				{
+					"display_name": "Development",
+					"is_public":    false,
+					"workspace_id": testhelp.RefByFQN(workspaceSourceResourceFQN, "id"),
+				},
+				{
+					"display_name": "Test",
+					"is_public":    false,
+					"workspace_id": testhelp.RefByFQN(workspaceTargetResourceFQN, "id"),
+				},
+			},
+		},
+	)
+
+	resource.Test(t, testhelp.NewTestAccCase(t, &testResourceItemFQN, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.JoinConfigs(
+				workspaceSourceResourceHCL,
+				workspaceTargetResourceHCL,
+				lakehouseResourceHCL,
+				deploymentPipelineResourceHCL,
+				at.CompileConfig(
+					testResourceItemHeader,
+					map[string]any{
+						"deployment_pipeline_id": testhelp.RefByFQN(deploymentPipelineResourceFQN, "id"),
+						"source_stage_id":        testhelp.RefByFQN(deploymentPipelineResourceFQN, "stages[0].id"),
+						"target_stage_id":        testhelp.RefByFQN(deploymentPipelineResourceFQN, "stages[1].id"),
+						"note":                   "testacc",
+						"items": []map[string]any{
+							{
+								"source_item_id": testhelp.RefByFQN(lakehouseResourceFQN, "id"),
+								"item_type":      string(fabcore.ItemTypeLakehouse),
+							},
+						},
+					},
+				),
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrSet(testResourceItemFQN, "id"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "status", string(fabcore.DeploymentPipelineOperationStatusSucceeded)),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "steps.#", "1"),
+				resource.TestCheckResourceAttrPair(testResourceItemFQN, "steps.0.source_item_id", lakehouseResourceFQN, "id"),
+			),
+		},
+	}))
+}
@@ -0,0 +1,320 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package deploymentpipelinedeploy
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema" //revive:disable-line:import-alias-naming
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func itemSchema() superschema.Schema {
+	markdownDescriptionR := fabricitem.NewResourceMarkdownDescription(ItemTypeInfo, false) +
+		"\n\n-> The deployment runs once, when the resource is created. Any change to the arguments, including `triggers`, runs a new deployment. " +
+		"Destroying the resource only removes it from the Terraform state, deployed content is left in the target stage."
+
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: markdownDescriptionR,
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The deployment operation ID.",
+					CustomType:          customtypes.UUIDType{},
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"deployment_pipeline_id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The Deployment Pipeline ID.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"source_stage_id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the source stage.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"target_stage_id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the target stage.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"items": superschema.SuperSetNestedAttributeOf[itemModel]{
+				Resource: &schemaR.SetNestedAttribute{
+					MarkdownDescription: "The set of items to be deployed. If not set, all supported stage items are deployed.",
+					Optional:            true,
+					PlanModifiers: []planmodifier.Set{
+						setplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+					},
+				},
+				Attributes: superschema.Attributes{
+					"source_item_id": superschema.SuperStringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The ID of the item in the source stage.",
+							CustomType:          customtypes.UUIDType{},
+							Required:            true,
+						},
+					},
+					"item_type": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The type of the item.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(utils.ConvertEnumsToStringSlices(fabcore.PossibleItemTypeValues(), true)...),
+							},
+						},
+					},
+				},
+			},
+			"note": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "A note describing the deployment.",
+					Optional:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.String{
+						stringvalidator.LengthAtMost(1024),
+					},
+				},
+			},
+			"options": superschema.SuperSingleNestedAttributeOf[optionsModel]{
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The options that control the behavior of the deployment.",
+					Optional:            true,
+					PlanModifiers: []planmodifier.Object{
+						objectplanmodifier.RequiresReplace(),
+					},
+				},
+				Attributes: superschema.Attributes{
+					"allow_cross_region_deployment": superschema.BoolAttribute{
+						Resource: &schemaR.BoolAttribute{
+							MarkdownDescription: "Allow deployment between stages with workspaces assigned to capacities in different regions.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"new_workspace": superschema.SuperSingleNestedAttributeOf[newWorkspaceModel]{
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The configuration of the workspace to create, when deploying to a stage without an assigned workspace. Ignored otherwise.",
+					Optional:            true,
+					PlanModifiers: []planmodifier.Object{
+						objectplanmodifier.RequiresReplace(),
+					},
+				},
+				Attributes: superschema.Attributes{
+					"name": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The name of the new workspace.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(256),
+							},
+						},
+					},
+					"capacity_id": superschema.SuperStringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The ID of the capacity the new workspace is assigned to.",
+							CustomType:          customtypes.UUIDType{},
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"triggers": superschema.SuperMapAttribute{
+				Resource: &schemaR.MapAttribute{
+					MarkdownDescription: "A map of arbitrary values that, when changed, run a new deployment.",
+					CustomType:          supertypes.MapTypeOf[types.String]{MapType: types.MapType{ElemType: types.StringType}},
+					ElementType:         types.StringType,
+					Optional:            true,
+					PlanModifiers: []planmodifier.Map{
+						mapplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.Map{
+						mapvalidator.NoNullValues(),
+					},
+				},
+			},
+			"status": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The status of the deployment operation.",
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"execution_start_time": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The date and time that the deployment started.",
+					CustomType:          timetypes.RFC3339Type{},
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"execution_end_time": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The date and time that the deployment ended.",
+					CustomType:          timetypes.RFC3339Type{},
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"pre_deployment_diff": superschema.SuperSingleNestedAttributeOf[preDeploymentDiffModel]{
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The number of items in the source stage that were new, different or identical to the items in the target stage, before the deployment.",
+					Computed:            true,
+					PlanModifiers: []planmodifier.Object{
+						objectplanmodifier.UseStateForUnknown(),
+					},
+				},
+				Attributes: superschema.Attributes{
+					"new_items_count": superschema.Int32Attribute{
+						Resource: &schemaR.Int32Attribute{
+							MarkdownDescription: "The number of new items deployed to the target stage.",
+							Computed:            true,
+						},
+					},
+					"different_items_count": superschema.Int32Attribute{
+						Resource: &schemaR.Int32Attribute{
+							MarkdownDescription: "The number of deployed items with differences between the source and target stages.",
+							Computed:            true,
+						},
+					},
+					"no_difference_items_count": superschema.Int32Attribute{
+						Resource: &schemaR.Int32Attribute{
+							MarkdownDescription: "The number of deployed items identical in the source and target stages.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"steps": superschema.SuperListNestedAttributeOf[stepModel]{
+				Resource: &schemaR.ListNestedAttribute{
+					MarkdownDescription: "The per-item results of the deployment execution plan.",
+					Computed:            true,
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.UseStateForUnknown(),
+					},
+				},
+				Attributes: superschema.Attributes{
+					"index": superschema.Int32Attribute{
+						Resource: &schemaR.Int32Attribute{
+							MarkdownDescription: "The step index.",
+							Computed:            true,
+						},
+					},
+					"description": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The step description.",
+							Computed:            true,
+						},
+					},
+					"status": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The status of the step.",
+							Computed:            true,
+						},
+					},
+					"pre_deployment_diff_state": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "Whether the item was new, different or identical to the item in the target stage, before the deployment.",
+							Computed:            true,
+						},
+					},
+					"item_type": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The type of the item.",
+							Computed:            true,
+						},
+					},
+					"source_item_id": superschema.SuperStringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The ID of the item in the source stage.",
+							CustomType:          customtypes.UUIDType{},
+							Computed:            true,
+						},
+					},
+					"source_item_display_name": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The display name of the item in the source stage.",
+							Computed:            true,
+						},
+					},
+					"target_item_id": superschema.SuperStringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The ID of the item overwritten in the target stage.",
+							CustomType:          customtypes.UUIDType{},
+							Computed:            true,
+						},
+					},
+					"target_item_display_name": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The display name of the item overwritten in the target stage.",
+							Computed:            true,
+						},
+					},
+					"error_message": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The error message, when the step failed.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"timeouts": superschema.TimeoutAttribute{
+				Resource: &superschema.ResourceTimeoutAttribute{
+					Create: true,
+					Read:   true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		},
+	}
+}
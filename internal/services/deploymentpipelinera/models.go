@@ -14,6 +14,8 @@ import (
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 
 	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/roleassignments"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
 )
 
 /*
@@ -113,3 +115,40 @@ func (to *principalModel) set(from fabcore.Principal) {
 	to.ID = customtypes.NewUUIDPointerValue(from.ID)
 	to.Type = types.StringPointerValue((*string)(from.Type))
 }
+
+func (to *requestCreateDeploymentPipelineRoleAssignment) setRoleAssignment(from roleassignments.RoleAssignment) {
+	to.Principal = &fabcore.Principal{
+		ID:   &from.PrincipalID,
+		Type: (*fabcore.PrincipalType)(&from.PrincipalType),
+	}
+	to.Role = (*fabcore.DeploymentPipelineRole)(&from.Role)
+}
+
+/*
+RESOURCE (authoritative set)
+*/
+
+func toRoleAssignments(from []fabcore.DeploymentPipelineRoleAssignment) []roleassignments.RoleAssignment {
+	result := make([]roleassignments.RoleAssignment, 0, len(from))
+
+	for _, entity := range from {
+		if entity.Principal == nil {
+			continue
+		}
+
+		principal := entity.Principal.GetPrincipal()
+		if principal == nil || principal.ID == nil {
+			continue
+		}
+
+		// Deployment Pipeline role assignments are addressed by principal ID.
+		result = append(result, roleassignments.RoleAssignment{
+			ID:            *principal.ID,
+			PrincipalID:   *principal.ID,
+			PrincipalType: string(utils.ValueOrZero(principal.Type)),
+			Role:          string(utils.ValueOrZero(entity.Role)),
+		})
+	}
+
+	return result
+}
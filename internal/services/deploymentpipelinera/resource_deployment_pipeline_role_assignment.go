@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
 
@@ -27,6 +28,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = (*resourceDeploymentPipelineRoleAssignment)(nil)
 	_ resource.ResourceWithImportState = (*resourceDeploymentPipelineRoleAssignment)(nil)
+	_ resource.ResourceWithIdentity    = (*resourceDeploymentPipelineRoleAssignment)(nil)
 )
 
 type resourceDeploymentPipelineRoleAssignment struct {
@@ -49,6 +51,23 @@ func (r *resourceDeploymentPipelineRoleAssignment) Schema(ctx context.Context, _
 	resp.Schema = itemSchema(false).GetResource(ctx)
 }
 
+func (r *resourceDeploymentPipelineRoleAssignment) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"deployment_pipeline_id": identityschema.StringAttribute{
+				Description:       "The Deployment Pipeline ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The Deployment Pipeline Role Assignment ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+		},
+	}
+}
+
 func (r *resourceDeploymentPipelineRoleAssignment) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -107,6 +126,7 @@ func (r *resourceDeploymentPipelineRoleAssignment) Create(ctx context.Context, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "CREATE", map[string]any{
 		"action": "end",
@@ -150,6 +170,7 @@ func (r *resourceDeploymentPipelineRoleAssignment) Read(ctx context.Context, req
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "READ", map[string]any{
 		"action": "end",
@@ -217,7 +238,12 @@ func (r *resourceDeploymentPipelineRoleAssignment) ImportState(ctx context.Conte
 		"id": req.ID,
 	})
 
-	deploymentPipelineID, deploymentPipelineRoleAssignmentID, found := strings.Cut(req.ID, "/")
+	importID, diags := utils.GetImportID(ctx, req, "deployment_pipeline_id", "id")
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	deploymentPipelineID, deploymentPipelineRoleAssignmentID, found := strings.Cut(importID, "/")
 	if !found {
 		resp.Diagnostics.AddError(
 			common.ErrorImportIdentifierHeader,
@@ -255,6 +281,7 @@ func (r *resourceDeploymentPipelineRoleAssignment) ImportState(ctx context.Conte
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "IMPORT", map[string]any{
 		"action": "end",
@@ -0,0 +1,75 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package deploymentpipelinera
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/microsoft/fabric-sdk-go/fabric"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/roleassignments"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func NewResourceDeploymentPipelineRoleAssignments() resource.Resource {
+	return roleassignments.NewResourceRoleAssignments(roleassignments.RoleAssignmentsResourceConfig{
+		TypeInfo:            ItemTypeInfo,
+		MarkdownDescription: fabricitem.NewResourceMarkdownDescription(ItemTypeInfo, true),
+		ObjectName:          "Deployment Pipeline",
+		ObjectIDAttribute:   "deployment_pipeline_id",
+		PrincipalTypes: utils.ConvertEnumsToStringSlices(utils.RemoveSlicesByValues(
+			fabcore.PossiblePrincipalTypeValues(),
+			[]fabcore.PrincipalType{fabcore.PrincipalTypeEntireTenant},
+		), true),
+		Roles: utils.ConvertEnumsToStringSlices(fabcore.PossibleDeploymentPipelineRoleValues(), true),
+		NewClient: func(client *fabric.Client) roleassignments.RoleAssignmentsClient {
+			return &roleAssignmentsClient{
+				client: fabcore.NewClientFactoryWithClient(*client).NewDeploymentPipelinesClient(),
+			}
+		},
+	})
+}
+
+// roleAssignmentsClient adapts the Deployment Pipeline role assignments API to roleassignments.RoleAssignmentsClient.
+// Deployment Pipeline role assignments are addressed by principal ID.
+type roleAssignmentsClient struct {
+	client *fabcore.DeploymentPipelinesClient
+}
+
+func (c *roleAssignmentsClient) List(ctx context.Context, deploymentPipelineID string) ([]roleassignments.RoleAssignment, error) {
+	respList, err := c.client.ListDeploymentPipelineRoleAssignments(ctx, deploymentPipelineID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRoleAssignments(respList), nil
+}
+
+func (c *roleAssignmentsClient) Create(ctx context.Context, deploymentPipelineID string, assignment roleassignments.RoleAssignment) error {
+	var reqCreate requestCreateDeploymentPipelineRoleAssignment
+
+	reqCreate.setRoleAssignment(assignment)
+
+	_, err := c.client.AddDeploymentPipelineRoleAssignment(ctx, deploymentPipelineID, reqCreate.AddDeploymentPipelineRoleAssignmentRequest, nil)
+
+	return err
+}
+
+// Update removes the principal and adds it back with the new role, Deployment Pipelines have no update API.
+func (c *roleAssignmentsClient) Update(ctx context.Context, deploymentPipelineID string, assignment roleassignments.RoleAssignment) error {
+	if err := c.Delete(ctx, deploymentPipelineID, assignment); err != nil {
+		return err
+	}
+
+	return c.Create(ctx, deploymentPipelineID, assignment)
+}
+
+func (c *roleAssignmentsClient) Delete(ctx context.Context, deploymentPipelineID string, assignment roleassignments.RoleAssignment) error {
+	_, err := c.client.DeleteDeploymentPipelineRoleAssignment(ctx, deploymentPipelineID, assignment.PrincipalID, nil)
+
+	return err
+}
@@ -0,0 +1,143 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package deploymentpipelinera_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testResourceItemsFQN, testResourceItemsHeader = testhelp.TFResource(common.ProviderTypeName, itemTypeInfo.Types, "test")
+
+func TestUnit_DeploymentPipelineRoleAssignmentsResource_ImportState(t *testing.T) {
+	testCase := at.CompileConfig(
+		testResourceItemsHeader,
+		map[string]any{},
+	)
+
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemsFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		{
+			ResourceName:  testResourceItemsFQN,
+			Config:        testCase,
+			ImportStateId: "not-valid",
+			ImportState:   true,
+			ExpectError:   regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+	}))
+}
+
+func TestAcc_DeploymentPipelineRoleAssignmentsResource_CRUD(t *testing.T) {
+	deploymentPipelineResourceHCL := at.CompileConfig(
+		at.ResourceHeader(testhelp.TypeName(common.ProviderTypeName, "deployment_pipeline"), "test"),
+		map[string]any{
+			"display_name": testhelp.RandomName(),
+			"description":  testhelp.RandomName(),
+			"stages": []map[string]any{
+				{
+					"display_name": testhelp.RandomName(),
+					"description":  testhelp.RandomName(),
+					"is_public":    testhelp.RandomBool(),
+				},
+				{
+					"display_name": testhelp.RandomName(),
+					"description":  testhelp.RandomName(),
+					"is_public":    testhelp.RandomBool(),
+				},
+			},
+		},
+	)
+
+	deploymentPipelineResourceFQN := testhelp.ResourceFQN(common.ProviderTypeName, "deployment_pipeline", "test")
+
+	entity := testhelp.WellKnown()["Principal"].(map[string]any)
+	entityID := entity["id"].(string)
+	entityType := entity["type"].(string)
+
+	groupEntity := testhelp.WellKnown()["Group"].(map[string]any)
+	groupEntityID := groupEntity["id"].(string)
+	groupEntityType := groupEntity["type"].(string)
+
+	// The identity running the tests is the Admin of the new Deployment Pipeline and must be ignored to keep its access.
+	deploymentPipelineRoleAssignmentsDataSourceHCL := at.CompileConfig(
+		at.DataSourceHeader(testhelp.TypeName(common.ProviderTypeName, itemTypeInfo.Types), "admins"),
+		map[string]any{
+			"deployment_pipeline_id": testhelp.RefByFQN(deploymentPipelineResourceFQN, "id"),
+		},
+	)
+
+	ignoredPrincipalIDs := at.ConfigLiteral(fmt.Sprintf(
+		"[for v in data.fabric_deployment_pipeline_role_assignments.admins.values : v.principal.id if !contains([%q, %q], v.principal.id)]",
+		entityID,
+		groupEntityID,
+	))
+
+	resource.Test(t, testhelp.NewTestAccCase(t, &testResourceItemsFQN, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemsFQN,
+			Config: at.JoinConfigs(
+				deploymentPipelineResourceHCL,
+				deploymentPipelineRoleAssignmentsDataSourceHCL,
+				at.CompileConfig(
+					testResourceItemsHeader,
+					map[string]any{
+						"deployment_pipeline_id": testhelp.RefByFQN(deploymentPipelineResourceFQN, "id"),
+						"assignments": []map[string]any{
+							{
+								"principal": map[string]any{
+									"id":   entityID,
+									"type": entityType,
+								},
+								"role": (string)(fabcore.DeploymentPipelineRoleAdmin),
+							},
+						},
+						"ignored_principal_ids": ignoredPrincipalIDs,
+					},
+				),
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemsFQN, "assignments.#", "1"),
+				resource.TestCheckResourceAttr(testResourceItemsFQN, "assignments.0.principal.id", entityID),
+			),
+		},
+		// Update and Read
+		{
+			ResourceName: testResourceItemsFQN,
+			Config: at.JoinConfigs(
+				deploymentPipelineResourceHCL,
+				deploymentPipelineRoleAssignmentsDataSourceHCL,
+				at.CompileConfig(
+					testResourceItemsHeader,
+					map[string]any{
+						"deployment_pipeline_id": testhelp.RefByFQN(deploymentPipelineResourceFQN, "id"),
+						"assignments": []map[string]any{
+							{
+								"principal": map[string]any{
+									"id":   groupEntityID,
+									"type": groupEntityType,
+								},
+								"role": (string)(fabcore.DeploymentPipelineRoleAdmin),
+							},
+						},
+						"ignored_principal_ids": ignoredPrincipalIDs,
+					},
+				),
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemsFQN, "assignments.#", "1"),
+				resource.TestCheckResourceAttr(testResourceItemsFQN, "assignments.0.principal.id", groupEntityID),
+			),
+		},
+	}))
+}
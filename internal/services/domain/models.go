@@ -74,7 +74,12 @@ RESOURCE
 type resourceDomainModel struct {
 	baseDomainModel
 
-	Timeouts timeoutsR.Value `tfsdk:"timeouts"`
+	DeleteSafeguards supertypes.SingleNestedObjectValueOf[deleteSafeguardsModel] `tfsdk:"delete_safeguards"`
+	Timeouts         timeoutsR.Value                                             `tfsdk:"timeouts"`
+}
+
+type deleteSafeguardsModel struct {
+	Force types.Bool `tfsdk:"force"`
 }
 
 type requestCreateDomain struct {
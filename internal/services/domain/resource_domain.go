@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	fabadmin "github.com/microsoft/fabric-sdk-go/fabric/admin"
 	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
@@ -26,6 +27,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = (*resourceDomain)(nil)
 	_ resource.ResourceWithImportState = (*resourceDomain)(nil)
+	_ resource.ResourceWithIdentity    = (*resourceDomain)(nil)
 )
 
 type resourceDomain struct {
@@ -48,6 +50,18 @@ func (r *resourceDomain) Schema(ctx context.Context, _ resource.SchemaRequest, r
 	resp.Schema = itemSchema(false).GetResource(ctx)
 }
 
+func (r *resourceDomain) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The Domain ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+		},
+	}
+}
+
 func (r *resourceDomain) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -91,6 +105,7 @@ func (r *resourceDomain) Create(ctx context.Context, req resource.CreateRequest,
 	defer cancel()
 
 	state.Timeouts = plan.Timeouts
+	state.DeleteSafeguards = plan.DeleteSafeguards
 
 	var reqCreate requestCreateDomain
 
@@ -106,6 +121,7 @@ func (r *resourceDomain) Create(ctx context.Context, req resource.CreateRequest,
 	// Save state immediately after resource creation
 	// This ensures Terraform can track the resource even if subsequent operations fail
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -170,6 +186,7 @@ func (r *resourceDomain) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "READ", map[string]any{
 		"action": "end",
@@ -222,6 +239,7 @@ func (r *resourceDomain) Update(ctx context.Context, req resource.UpdateRequest,
 	plan.set(respUpdate.Domain)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "UPDATE", map[string]any{
 		"action": "end",
@@ -247,6 +265,10 @@ func (r *resourceDomain) Delete(ctx context.Context, req resource.DeleteRequest,
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	if resp.Diagnostics.Append(r.checkDeleteSafeguards(ctx, state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.DeleteDomain(ctx, state.ID.ValueString(), nil)
 	if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationDelete, nil)...); resp.Diagnostics.HasError() {
 		return
@@ -265,12 +287,14 @@ func (r *resourceDomain) ImportState(ctx context.Context, req resource.ImportSta
 		"id": req.ID,
 	})
 
-	_, diags := customtypes.NewUUIDValueMust(req.ID)
-	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
-		return
+	if req.ID != "" {
+		_, diags := customtypes.NewUUIDValueMust(req.ID)
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 
 	tflog.Debug(ctx, "IMPORT", map[string]any{
 		"action": "end",
@@ -291,3 +315,49 @@ func (r *resourceDomain) get(ctx context.Context, model *resourceDomainModel) di
 
 	return nil
 }
+
+func (r *resourceDomain) checkDeleteSafeguards(ctx context.Context, model resourceDomainModel) diag.Diagnostics {
+	if model.DeleteSafeguards.IsNull() || model.DeleteSafeguards.IsUnknown() {
+		return nil
+	}
+
+	safeguards, diags := model.DeleteSafeguards.Get(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	if safeguards.Force.ValueBool() {
+		tflog.Info(ctx, "Delete safeguards are forced, the Domain is deleted regardless of its workspaces", map[string]any{
+			"id": model.ID.ValueString(),
+		})
+
+		return nil
+	}
+
+	tflog.Debug(ctx, "CHECK DELETE SAFEGUARDS", map[string]any{
+		"action": "start",
+		"id":     model.ID.ValueString(),
+	})
+
+	workspaces, err := r.client.ListDomainWorkspaces(ctx, model.ID.ValueString(), nil)
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationDelete, nil); diags.HasError() {
+		return diags
+	}
+
+	unmanagedWorkspaces := make([]string, 0, len(workspaces))
+
+	for _, workspace := range workspaces {
+		unmanagedWorkspaces = append(unmanagedWorkspaces, fmt.Sprintf("%s (%s)", utils.ValueOrZero(workspace.DisplayName), utils.ValueOrZero(workspace.ID)))
+	}
+
+	if diags := utils.CheckDeleteSafeguard(ItemTypeInfo.Name, model.ID.ValueString(), "workspaces", unmanagedWorkspaces); diags.HasError() {
+		return diags
+	}
+
+	tflog.Debug(ctx, "CHECK DELETE SAFEGUARDS", map[string]any{
+		"action": "end",
+		"id":     model.ID.ValueString(),
+	})
+
+	return nil
+}
@@ -5,12 +5,15 @@ package domain_test
 
 import (
 	"errors"
+	"net/http"
 	"regexp"
 	"testing"
 
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	fabadmin "github.com/microsoft/fabric-sdk-go/fabric/admin"
 
 	"github.com/microsoft/terraform-provider-fabric/internal/common"
 	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
@@ -254,6 +257,69 @@ func TestUnit_DomainResource_DefaultLabelID(t *testing.T) {
 	}))
 }
 
+func TestUnit_DomainResource_DeleteSafeguards(t *testing.T) {
+	entity := fakes.NewRandomDomain()
+
+	var assignedWorkspaces []fabadmin.DomainWorkspace
+
+	fakes.FakeServer.ServerFactory.Admin.DomainsServer.NewListDomainWorkspacesPager = func(_ string, _ *fabadmin.DomainsClientListDomainWorkspacesOptions) (resp azfake.PagerResponder[fabadmin.DomainsClientListDomainWorkspacesResponse]) {
+		resp.AddPage(http.StatusOK, fabadmin.DomainsClientListDomainWorkspacesResponse{
+			DomainWorkspaces: fabadmin.DomainWorkspaces{
+				Value: assignedWorkspaces,
+			},
+		}, nil)
+
+		return resp
+	}
+
+	config := func(force bool) string {
+		return at.CompileConfig(
+			testResourceItemHeader,
+			map[string]any{
+				"display_name": *entity.DisplayName,
+				"delete_safeguards": map[string]any{
+					"force": force,
+				},
+			},
+		)
+	}
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config:       config(false),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "delete_safeguards.force", "false"),
+			),
+		},
+		// error - delete - unmanaged workspace assigned to the Domain
+		{
+			ResourceName: testResourceItemFQN,
+			PreConfig: func() {
+				assignedWorkspaces = []fabadmin.DomainWorkspace{
+					{
+						ID:          new(testhelp.RandomUUID()),
+						DisplayName: new(testhelp.RandomName()),
+					},
+				}
+			},
+			Config:      config(false),
+			Destroy:     true,
+			ExpectError: regexp.MustCompile(common.ErrorDeleteSafeguardHeader),
+		},
+		// Update and Read - force the deletion
+		{
+			ResourceName: testResourceItemFQN,
+			Config:       config(true),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "delete_safeguards.force", "true"),
+			),
+		},
+		// Delete testing automatically occurs in TestCase
+	}))
+}
+
 func TestAcc_DomainResource_CRUD(t *testing.T) {
 	entityCreateDisplayName := testhelp.RandomName()
 	entityUpdateDisplayName := testhelp.RandomName()
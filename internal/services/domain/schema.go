@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema" //revive:disable-line:import-alias-naming
 	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"   //revive:disable-line:import-alias-naming
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -113,6 +114,24 @@ func itemSchema(isList bool) superschema.Schema { //revive:disable-line:flag-par
 					Computed: true,
 				},
 			},
+			"delete_safeguards": superschema.SuperSingleNestedAttributeOf[deleteSafeguardsModel]{
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "Checks run before the " + ItemTypeInfo.Name + " is deleted. When set, the deletion is refused while workspaces are still assigned to the " + ItemTypeInfo.Name + ". " +
+						"Workspace assignments managed by Terraform are destroyed before the " + ItemTypeInfo.Name + ", so the remaining ones are not managed by Terraform. " +
+						"Changes to this attribute must be applied before the " + ItemTypeInfo.Name + " is deleted.",
+					Optional: true,
+				},
+				Attributes: map[string]superschema.Attribute{
+					"force": superschema.BoolAttribute{
+						Resource: &schemaR.BoolAttribute{
+							MarkdownDescription: "Whether to delete the " + ItemTypeInfo.Name + " even if the checks fail.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
 			"timeouts": superschema.TimeoutAttribute{
 				Resource: &superschema.ResourceTimeoutAttribute{
 					Create: true,
@@ -76,7 +76,12 @@ RESOURCE
 type resourceFolderModel struct {
 	baseFolderModel
 
-	Timeouts timeoutsR.Value `tfsdk:"timeouts"`
+	DeleteSafeguards supertypes.SingleNestedObjectValueOf[deleteSafeguardsModel] `tfsdk:"delete_safeguards"`
+	Timeouts         timeoutsR.Value                                             `tfsdk:"timeouts"`
+}
+
+type deleteSafeguardsModel struct {
+	Force types.Bool `tfsdk:"force"`
 }
 
 type requestCreateFolder struct {
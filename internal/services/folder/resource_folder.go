@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
 
@@ -27,11 +28,13 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = (*resourceFolder)(nil)
 	_ resource.ResourceWithImportState = (*resourceFolder)(nil)
+	_ resource.ResourceWithIdentity    = (*resourceFolder)(nil)
 )
 
 type resourceFolder struct {
 	pConfigData *pconfig.ProviderData
 	client      *fabcore.FoldersClient
+	clientItems *fabcore.ItemsClient
 	TypeInfo    tftypeinfo.TFTypeInfo
 }
 
@@ -49,6 +52,23 @@ func (r *resourceFolder) Schema(ctx context.Context, _ resource.SchemaRequest, r
 	resp.Schema = itemSchema(false).GetResource(ctx)
 }
 
+func (r *resourceFolder) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_id": identityschema.StringAttribute{
+				Description:       "The Workspace ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The Folder ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+		},
+	}
+}
+
 func (r *resourceFolder) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -71,6 +91,7 @@ func (r *resourceFolder) Configure(_ context.Context, req resource.ConfigureRequ
 	}
 
 	r.client = fabcore.NewClientFactoryWithClient(*pConfigData.FabricClient).NewFoldersClient()
+	r.clientItems = fabcore.NewClientFactoryWithClient(*pConfigData.FabricClient).NewItemsClient()
 }
 
 func (r *resourceFolder) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -93,6 +114,7 @@ func (r *resourceFolder) Create(ctx context.Context, req resource.CreateRequest,
 	defer cancel()
 
 	state.Timeouts = plan.Timeouts
+	state.DeleteSafeguards = plan.DeleteSafeguards
 
 	var reqCreate requestCreateFolder
 
@@ -105,6 +127,7 @@ func (r *resourceFolder) Create(ctx context.Context, req resource.CreateRequest,
 
 	state.set(respCreate.Folder)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "CREATE", map[string]any{
 		"action": "end",
@@ -148,6 +171,7 @@ func (r *resourceFolder) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "READ", map[string]any{
 		"action": "end",
@@ -219,9 +243,14 @@ func (r *resourceFolder) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	plan.set(*updatedFolder)
+	if updatedFolder != nil {
+		plan.set(*updatedFolder)
+	} else {
+		plan.baseFolderModel = state.baseFolderModel
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "UPDATE", map[string]any{
 		"action": "end",
@@ -251,6 +280,10 @@ func (r *resourceFolder) Delete(ctx context.Context, req resource.DeleteRequest,
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	if resp.Diagnostics.Append(r.checkDeleteSafeguards(ctx, state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.DeleteFolder(ctx, state.WorkspaceID.ValueString(), state.ID.ValueString(), nil)
 
 	if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationDelete, nil)...); resp.Diagnostics.HasError() {
@@ -267,7 +300,12 @@ func (r *resourceFolder) ImportState(ctx context.Context, req resource.ImportSta
 		"action": "start",
 	})
 
-	workspaceID, poolID, found := strings.Cut(req.ID, "/")
+	importID, diags := utils.GetImportID(ctx, req, "workspace_id", "id")
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID, poolID, found := strings.Cut(importID, "/")
 	if !found {
 		resp.Diagnostics.AddError(
 			common.ErrorImportIdentifierHeader,
@@ -305,6 +343,7 @@ func (r *resourceFolder) ImportState(ctx context.Context, req resource.ImportSta
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "IMPORT", map[string]any{
 		"action": "end",
@@ -325,3 +364,68 @@ func (r *resourceFolder) getByID(ctx context.Context, workspaceID, folderID stri
 
 	return nil
 }
+
+func (r *resourceFolder) checkDeleteSafeguards(ctx context.Context, model resourceFolderModel) diag.Diagnostics {
+	if model.DeleteSafeguards.IsNull() || model.DeleteSafeguards.IsUnknown() {
+		return nil
+	}
+
+	safeguards, diags := model.DeleteSafeguards.Get(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	if safeguards.Force.ValueBool() {
+		tflog.Info(ctx, "Delete safeguards are forced, the Folder is deleted regardless of its contents", map[string]any{
+			"id": model.ID.ValueString(),
+		})
+
+		return nil
+	}
+
+	tflog.Debug(ctx, "CHECK DELETE SAFEGUARDS", map[string]any{
+		"action": "start",
+		"id":     model.ID.ValueString(),
+	})
+
+	items, err := r.clientItems.ListItems(ctx, model.WorkspaceID.ValueString(), &fabcore.ItemsClientListItemsOptions{
+		RootFolderID: model.ID.ValueStringPointer(),
+		Recursive:    new(true),
+	})
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationDelete, nil); diags.HasError() {
+		return diags
+	}
+
+	folders, err := r.client.ListFolders(ctx, model.WorkspaceID.ValueString(), &fabcore.FoldersClientListFoldersOptions{
+		RootFolderID: model.ID.ValueStringPointer(),
+		Recursive:    new(true),
+	})
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationDelete, nil); diags.HasError() {
+		return diags
+	}
+
+	unmanaged := make([]string, 0, len(items)+len(folders))
+
+	for _, item := range items {
+		unmanaged = append(unmanaged, fmt.Sprintf("%s (%s, %s)", utils.ValueOrZero(item.DisplayName), utils.ValueOrZero(item.Type), utils.ValueOrZero(item.ID)))
+	}
+
+	for _, folder := range folders {
+		if strings.EqualFold(utils.ValueOrZero(folder.ID), model.ID.ValueString()) {
+			continue
+		}
+
+		unmanaged = append(unmanaged, fmt.Sprintf("%s (Folder, %s)", utils.ValueOrZero(folder.DisplayName), utils.ValueOrZero(folder.ID)))
+	}
+
+	if diags := utils.CheckDeleteSafeguard(ItemTypeInfo.Name, model.ID.ValueString(), "items or folders", unmanaged); diags.HasError() {
+		return diags
+	}
+
+	tflog.Debug(ctx, "CHECK DELETE SAFEGUARDS", map[string]any{
+		"action": "end",
+		"id":     model.ID.ValueString(),
+	})
+
+	return nil
+}
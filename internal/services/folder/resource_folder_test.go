@@ -296,6 +296,62 @@ func TestUnit_FolderResource_CRUD(t *testing.T) {
 	}))
 }
 
+func TestUnit_FolderResource_DeleteSafeguards(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	entity := fakes.NewRandomFolderWithWorkspace(workspaceID)
+
+	var folderID string
+
+	config := func(force bool) string {
+		return at.CompileConfig(
+			testResourceItemHeader,
+			map[string]any{
+				"workspace_id": workspaceID,
+				"display_name": *entity.DisplayName,
+				"delete_safeguards": map[string]any{
+					"force": force,
+				},
+			},
+		)
+	}
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config:       config(false),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "delete_safeguards.force", "false"),
+				func(s *terraform.State) error {
+					folderID = s.RootModule().Resources[testResourceItemFQN].Primary.ID
+
+					return nil
+				},
+			),
+		},
+		// error - delete - unmanaged subfolder in the Folder
+		{
+			ResourceName: testResourceItemFQN,
+			PreConfig: func() {
+				fakes.FakeServer.Upsert(fakes.NewRandomSubfolder(workspaceID, folderID))
+			},
+			Config:      config(false),
+			Destroy:     true,
+			ExpectError: regexp.MustCompile(common.ErrorDeleteSafeguardHeader),
+		},
+		// Update and Read - force the deletion
+		{
+			ResourceName: testResourceItemFQN,
+			Config:       config(true),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "delete_safeguards.force", "true"),
+				resource.TestCheckResourceAttrPtr(testResourceItemFQN, "display_name", entity.DisplayName),
+			),
+		},
+		// Delete testing automatically occurs in TestCase
+	}))
+}
+
 func TestAcc_FolderResource_CRUD(t *testing.T) {
 	workspace := testhelp.WellKnown()["WorkspaceRS"].(map[string]any)
 	workspaceID := workspace["id"].(string)
@@ -14,6 +14,8 @@ import (
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 
 	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/roleassignments"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
 )
 
 /*
@@ -114,6 +116,14 @@ func (to *requestCreateGatewayRoleAssignment) set(ctx context.Context, from reso
 	return nil
 }
 
+func (to *requestCreateGatewayRoleAssignment) setRoleAssignment(from roleassignments.RoleAssignment) {
+	to.Principal = &fabcore.Principal{
+		ID:   &from.PrincipalID,
+		Type: (*fabcore.PrincipalType)(&from.PrincipalType),
+	}
+	to.Role = (*fabcore.GatewayRole)(&from.Role)
+}
+
 type requestUpdateGatewayRoleAssignment struct {
 	fabcore.UpdateGatewayRoleAssignmentRequest
 }
@@ -135,3 +145,31 @@ func (to *principalModel) set(from fabcore.Principal) {
 	to.ID = customtypes.NewUUIDPointerValue(from.ID)
 	to.Type = types.StringPointerValue((*string)(from.Type))
 }
+
+/*
+RESOURCE (authoritative set)
+*/
+
+func toRoleAssignments(from []fabcore.GatewayRoleAssignment) []roleassignments.RoleAssignment {
+	result := make([]roleassignments.RoleAssignment, 0, len(from))
+
+	for _, entity := range from {
+		if entity.Principal == nil {
+			continue
+		}
+
+		principal := entity.Principal.GetPrincipal()
+		if principal == nil || principal.ID == nil {
+			continue
+		}
+
+		result = append(result, roleassignments.RoleAssignment{
+			ID:            utils.ValueOrZero(entity.ID),
+			PrincipalID:   *principal.ID,
+			PrincipalType: string(utils.ValueOrZero(principal.Type)),
+			Role:          string(utils.ValueOrZero(entity.Role)),
+		})
+	}
+
+	return result
+}
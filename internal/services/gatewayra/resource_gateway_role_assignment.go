@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
 
@@ -27,6 +28,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = (*resourceGatewayRoleAssignment)(nil)
 	_ resource.ResourceWithImportState = (*resourceGatewayRoleAssignment)(nil)
+	_ resource.ResourceWithIdentity    = (*resourceGatewayRoleAssignment)(nil)
 )
 
 type resourceGatewayRoleAssignment struct {
@@ -49,6 +51,23 @@ func (r *resourceGatewayRoleAssignment) Schema(ctx context.Context, _ resource.S
 	resp.Schema = itemSchema(false).GetResource(ctx)
 }
 
+func (r *resourceGatewayRoleAssignment) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"gateway_id": identityschema.StringAttribute{
+				Description:       "The Gateway ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The Gateway Role Assignment ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+		},
+	}
+}
+
 func (r *resourceGatewayRoleAssignment) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -107,6 +126,7 @@ func (r *resourceGatewayRoleAssignment) Create(ctx context.Context, req resource
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "CREATE", map[string]any{
 		"action": "end",
@@ -150,6 +170,7 @@ func (r *resourceGatewayRoleAssignment) Read(ctx context.Context, req resource.R
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "READ", map[string]any{
 		"action": "end",
@@ -193,6 +214,7 @@ func (r *resourceGatewayRoleAssignment) Update(ctx context.Context, req resource
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "UPDATE", map[string]any{
 		"action": "end",
@@ -240,7 +262,12 @@ func (r *resourceGatewayRoleAssignment) ImportState(ctx context.Context, req res
 		"id": req.ID,
 	})
 
-	gatewayID, gatewayRoleAssignmentID, found := strings.Cut(req.ID, "/")
+	importID, diags := utils.GetImportID(ctx, req, "gateway_id", "id")
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	gatewayID, gatewayRoleAssignmentID, found := strings.Cut(importID, "/")
 	if !found {
 		resp.Diagnostics.AddError(
 			common.ErrorImportIdentifierHeader,
@@ -278,6 +305,7 @@ func (r *resourceGatewayRoleAssignment) ImportState(ctx context.Context, req res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "IMPORT", map[string]any{
 		"action": "end",
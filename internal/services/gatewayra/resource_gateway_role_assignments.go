@@ -0,0 +1,75 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package gatewayra
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/microsoft/fabric-sdk-go/fabric"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/roleassignments"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func NewResourceGatewayRoleAssignments() resource.Resource {
+	return roleassignments.NewResourceRoleAssignments(roleassignments.RoleAssignmentsResourceConfig{
+		TypeInfo:            ItemTypeInfo,
+		MarkdownDescription: fabricitem.NewResourceMarkdownDescription(ItemTypeInfo, true),
+		ObjectName:          "Gateway",
+		ObjectIDAttribute:   "gateway_id",
+		PrincipalTypes: utils.ConvertEnumsToStringSlices(utils.RemoveSlicesByValues(
+			fabcore.PossiblePrincipalTypeValues(),
+			[]fabcore.PrincipalType{fabcore.PrincipalTypeEntireTenant},
+		), true),
+		Roles: utils.ConvertEnumsToStringSlices(fabcore.PossibleGatewayRoleValues(), true),
+		NewClient: func(client *fabric.Client) roleassignments.RoleAssignmentsClient {
+			return &roleAssignmentsClient{
+				client: fabcore.NewClientFactoryWithClient(*client).NewGatewaysClient(),
+			}
+		},
+	})
+}
+
+// roleAssignmentsClient adapts the Gateway role assignments API to roleassignments.RoleAssignmentsClient.
+type roleAssignmentsClient struct {
+	client *fabcore.GatewaysClient
+}
+
+func (c *roleAssignmentsClient) List(ctx context.Context, gatewayID string) ([]roleassignments.RoleAssignment, error) {
+	respList, err := c.client.ListGatewayRoleAssignments(ctx, gatewayID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRoleAssignments(respList), nil
+}
+
+func (c *roleAssignmentsClient) Create(ctx context.Context, gatewayID string, assignment roleassignments.RoleAssignment) error {
+	var reqCreate requestCreateGatewayRoleAssignment
+
+	reqCreate.setRoleAssignment(assignment)
+
+	_, err := c.client.AddGatewayRoleAssignment(ctx, gatewayID, reqCreate.AddGatewayRoleAssignmentRequest, nil)
+
+	return err
+}
+
+func (c *roleAssignmentsClient) Update(ctx context.Context, gatewayID string, assignment roleassignments.RoleAssignment) error {
+	var reqUpdate requestUpdateGatewayRoleAssignment
+
+	reqUpdate.Role = (*fabcore.GatewayRole)(&assignment.Role)
+
+	_, err := c.client.UpdateGatewayRoleAssignment(ctx, gatewayID, assignment.ID, reqUpdate.UpdateGatewayRoleAssignmentRequest, nil)
+
+	return err
+}
+
+func (c *roleAssignmentsClient) Delete(ctx context.Context, gatewayID string, assignment roleassignments.RoleAssignment) error {
+	_, err := c.client.DeleteGatewayRoleAssignment(ctx, gatewayID, assignment.ID, nil)
+
+	return err
+}
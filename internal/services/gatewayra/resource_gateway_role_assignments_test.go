@@ -0,0 +1,150 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package gatewayra_test
+
+import (
+	"regexp"
+	"testing"
+
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/services/gateway"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testResourceItemsFQN, testResourceItemsHeader = testhelp.TFResource(common.ProviderTypeName, itemTypeInfo.Types, "test")
+
+func TestUnit_GatewayRoleAssignmentsResource_ImportState(t *testing.T) {
+	testCase := at.CompileConfig(
+		testResourceItemsHeader,
+		map[string]any{},
+	)
+
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemsFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		{
+			ResourceName:  testResourceItemsFQN,
+			Config:        testCase,
+			ImportStateId: "not-valid",
+			ImportState:   true,
+			ExpectError:   regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+	}))
+}
+
+func TestAcc_GatewayRoleAssignmentsResource_CRUD(t *testing.T) {
+	if testhelp.ShouldSkipTest(t) {
+		t.Skip("No SPN support")
+	}
+
+	gatewayType := string(fabcore.GatewayTypeVirtualNetwork)
+	gatewayCreateDisplayName := testhelp.RandomName()
+	gatewayCreateInactivityMinutesBeforeSleep := int(testhelp.RandomElement(gateway.PossibleInactivityMinutesBeforeSleepValues))
+	gatewayCreateNumberOfMemberGateways := int(testhelp.RandomIntRange(gateway.MinNumberOfMemberGatewaysValues, gateway.MaxNumberOfMemberGatewaysValues))
+
+	capacity := testhelp.WellKnown()["Capacity"].(map[string]any)
+	capacityID := capacity["id"].(string)
+
+	virtualNetworkAzureResource := testhelp.WellKnown()["VirtualNetwork01"].(map[string]any)
+	virtualNetworkName := virtualNetworkAzureResource["name"].(string)
+	resourceGroupName := virtualNetworkAzureResource["resourceGroupName"].(string)
+	subnetName := virtualNetworkAzureResource["subnetName"].(string)
+	subscriptionID := virtualNetworkAzureResource["subscriptionId"].(string)
+
+	gatewayResourceHCL := at.CompileConfig(
+		at.ResourceHeader(testhelp.TypeName("fabric", "gateway"), "test"),
+		map[string]any{
+			"type":                            gatewayType,
+			"display_name":                    gatewayCreateDisplayName,
+			"inactivity_minutes_before_sleep": gatewayCreateInactivityMinutesBeforeSleep,
+			"number_of_member_gateways":       gatewayCreateNumberOfMemberGateways,
+			"virtual_network_azure_resource": map[string]any{
+				"virtual_network_name": virtualNetworkName,
+				"resource_group_name":  resourceGroupName,
+				"subnet_name":          subnetName,
+				"subscription_id":      subscriptionID,
+			},
+			"capacity_id": capacityID,
+		},
+	)
+	gatewayResourceFQN := testhelp.ResourceFQN("fabric", "gateway", "test")
+
+	entity := testhelp.WellKnown()["Principal"].(map[string]any)
+	entityID := entity["id"].(string)
+	entityType := entity["type"].(string)
+
+	// The identity running the tests is the Admin of the new Gateway and must be ignored to keep its access.
+	gatewayRoleAssignmentsDataSourceHCL := at.CompileConfig(
+		at.DataSourceHeader(testhelp.TypeName(common.ProviderTypeName, itemTypeInfo.Types), "admins"),
+		map[string]any{
+			"gateway_id": testhelp.RefByFQN(gatewayResourceFQN, "id"),
+		},
+	)
+
+	ignoredPrincipalIDs := at.ConfigLiteral("[for v in data.fabric_gateway_role_assignments.admins.values : v.principal.id if v.role == \"Admin\"]")
+
+	resource.Test(t, testhelp.NewTestAccCase(t, &testResourceItemsFQN, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemsFQN,
+			Config: at.JoinConfigs(
+				gatewayResourceHCL,
+				gatewayRoleAssignmentsDataSourceHCL,
+				at.CompileConfig(
+					testResourceItemsHeader,
+					map[string]any{
+						"gateway_id": testhelp.RefByFQN(gatewayResourceFQN, "id"),
+						"assignments": []map[string]any{
+							{
+								"principal": map[string]any{
+									"id":   entityID,
+									"type": entityType,
+								},
+								"role": "ConnectionCreatorWithResharing",
+							},
+						},
+						"ignored_principal_ids": ignoredPrincipalIDs,
+					},
+				),
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemsFQN, "assignments.#", "1"),
+				resource.TestCheckResourceAttr(testResourceItemsFQN, "assignments.0.principal.id", entityID),
+				resource.TestCheckResourceAttr(testResourceItemsFQN, "assignments.0.role", "ConnectionCreatorWithResharing"),
+			),
+		},
+		// Update and Read
+		{
+			ResourceName: testResourceItemsFQN,
+			Config: at.JoinConfigs(
+				gatewayResourceHCL,
+				gatewayRoleAssignmentsDataSourceHCL,
+				at.CompileConfig(
+					testResourceItemsHeader,
+					map[string]any{
+						"gateway_id": testhelp.RefByFQN(gatewayResourceFQN, "id"),
+						"assignments": []map[string]any{
+							{
+								"principal": map[string]any{
+									"id":   entityID,
+									"type": entityType,
+								},
+								"role": "ConnectionCreator",
+							},
+						},
+						"ignored_principal_ids": ignoredPrincipalIDs,
+					},
+				),
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemsFQN, "assignments.#", "1"),
+				resource.TestCheckResourceAttr(testResourceItemsFQN, "assignments.0.role", "ConnectionCreator"),
+			),
+		},
+	}))
+}
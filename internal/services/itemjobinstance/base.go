@@ -0,0 +1,16 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package itemjobinstance
+
+import (
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+)
+
+var ItemTypeInfo = tftypeinfo.TFTypeInfo{ //nolint:gochecknoglobals
+	Name:           "Item Job Instance",
+	Type:           "item_job_instance",
+	DocsURL:        "https://learn.microsoft.com/rest/api/fabric/core/job-scheduler/run-on-demand-item-job",
+	IsPreview:      false,
+	IsSPNSupported: true,
+}
@@ -0,0 +1,72 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package itemjobinstance_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	azto "github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+)
+
+// Returns a fake function that starts a job instance, returning its location, and records the received request.
+func fakeRunOnDemandItemJob(
+	jobInstanceID string,
+	received *fabcore.RunOnDemandItemJobRequest,
+) func(ctx context.Context, workspaceID, itemID, jobType string, options *fabcore.JobSchedulerClientRunOnDemandItemJobOptions) (resp azfake.Responder[fabcore.JobSchedulerClientRunOnDemandItemJobResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, workspaceID, itemID, _ string, options *fabcore.JobSchedulerClientRunOnDemandItemJobOptions) (resp azfake.Responder[fabcore.JobSchedulerClientRunOnDemandItemJobResponse], errResp azfake.ErrorResponder) {
+		if options != nil && options.RunOnDemandItemJobRequest != nil {
+			*received = *options.RunOnDemandItemJobRequest
+		}
+
+		header := http.Header{}
+		header.Set("Location", "https://api.fabric.microsoft.com/v1/workspaces/"+workspaceID+"/items/"+itemID+"/jobs/instances/"+jobInstanceID)
+
+		resp = azfake.Responder[fabcore.JobSchedulerClientRunOnDemandItemJobResponse]{}
+		resp.SetResponse(http.StatusAccepted, fabcore.JobSchedulerClientRunOnDemandItemJobResponse{}, &azfake.SetResponseOptions{Header: header})
+
+		return resp, errResp
+	}
+}
+
+// Returns a fake function that simulates getting a job instance with a provided example response.
+func fakeGetItemJobInstance(
+	exampleResp fabcore.ItemJobInstance,
+) func(ctx context.Context, workspaceID, itemID, jobInstanceID string, options *fabcore.JobSchedulerClientGetItemJobInstanceOptions) (resp azfake.Responder[fabcore.JobSchedulerClientGetItemJobInstanceResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _, _, _ string, _ *fabcore.JobSchedulerClientGetItemJobInstanceOptions) (resp azfake.Responder[fabcore.JobSchedulerClientGetItemJobInstanceResponse], errResp azfake.ErrorResponder) {
+		resp = azfake.Responder[fabcore.JobSchedulerClientGetItemJobInstanceResponse]{}
+		resp.SetResponse(http.StatusOK, fabcore.JobSchedulerClientGetItemJobInstanceResponse{ItemJobInstance: exampleResp}, nil)
+
+		return resp, errResp
+	}
+}
+
+func NewRandomItemJobInstance(jobInstanceID, itemID, jobType string, status fabcore.ItemJobStatus) fabcore.ItemJobInstance {
+	now := time.Now().UTC()
+
+	entity := fabcore.ItemJobInstance{
+		ID:             new(jobInstanceID),
+		ItemID:         new(itemID),
+		JobType:        new(jobType),
+		InvokeType:     azto.Ptr(fabcore.InvokeTypeManual),
+		Status:         azto.Ptr(status),
+		RootActivityID: new(testhelp.RandomUUID()),
+		StartTimeUTC:   new(now.Format(time.RFC3339)),
+		EndTimeUTC:     new(now.Add(time.Minute).Format(time.RFC3339)),
+	}
+
+	if status == fabcore.ItemJobStatusFailed {
+		entity.FailureReason = &fabcore.ErrorResponse{
+			ErrorCode: new("JobInstanceStatusFailed"),
+			Message:   new("The notebook execution failed"),
+		}
+	}
+
+	return entity
+}
@@ -0,0 +1,136 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package itemjobinstance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+)
+
+/*
+RESOURCE
+*/
+
+type resourceItemJobInstanceModel struct {
+	ID             customtypes.UUID                                   `tfsdk:"id"`
+	WorkspaceID    customtypes.UUID                                   `tfsdk:"workspace_id"`
+	ItemID         customtypes.UUID                                   `tfsdk:"item_id"`
+	JobType        types.String                                       `tfsdk:"job_type"`
+	ExecutionData  types.String                                       `tfsdk:"execution_data"`
+	Parameters     supertypes.ListNestedObjectValueOf[parameterModel] `tfsdk:"parameters"`
+	Triggers       supertypes.MapValueOf[types.String]                `tfsdk:"triggers"`
+	Status         types.String                                       `tfsdk:"status"`
+	InvokeType     types.String                                       `tfsdk:"invoke_type"`
+	RootActivityID types.String                                       `tfsdk:"root_activity_id"`
+	StartTimeUTC   types.String                                       `tfsdk:"start_time_utc"`
+	EndTimeUTC     types.String                                       `tfsdk:"end_time_utc"`
+	FailureReason  types.String                                       `tfsdk:"failure_reason"`
+	Timeouts       timeouts.Value                                     `tfsdk:"timeouts"`
+}
+
+func (to *resourceItemJobInstanceModel) set(from fabcore.ItemJobInstance) {
+	to.ID = customtypes.NewUUIDPointerValue(from.ID)
+	to.Status = types.StringPointerValue((*string)(from.Status))
+	to.InvokeType = types.StringPointerValue((*string)(from.InvokeType))
+	to.RootActivityID = types.StringPointerValue(from.RootActivityID)
+	to.StartTimeUTC = types.StringPointerValue(from.StartTimeUTC)
+	to.EndTimeUTC = types.StringPointerValue(from.EndTimeUTC)
+	to.FailureReason = types.StringNull()
+
+	if from.FailureReason != nil {
+		to.FailureReason = types.StringPointerValue(from.FailureReason.Message)
+	}
+}
+
+type requestRunOnDemandItemJob struct {
+	fabcore.RunOnDemandItemJobRequest
+}
+
+func (to *requestRunOnDemandItemJob) set(ctx context.Context, from resourceItemJobInstanceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !from.ExecutionData.IsNull() && !from.ExecutionData.IsUnknown() {
+		to.ExecutionData = json.RawMessage(from.ExecutionData.ValueString())
+	}
+
+	if from.Parameters.IsNull() || from.Parameters.IsUnknown() {
+		return diags
+	}
+
+	parameters, diags := from.Parameters.Get(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	to.Parameters = make([]fabcore.Parameter, 0, len(parameters))
+
+	for _, parameter := range parameters {
+		value, err := parameter.typedValue()
+		if err != nil {
+			diags.AddError(common.ErrorInvalidConfig, err.Error())
+
+			return diags
+		}
+
+		to.Parameters = append(to.Parameters, fabcore.Parameter{
+			Name:  parameter.Name.ValueStringPointer(),
+			Type:  (*fabcore.ItemJobParameterType)(parameter.Type.ValueStringPointer()),
+			Value: value,
+		})
+	}
+
+	return diags
+}
+
+/*
+HELPER MODELS
+*/
+
+type parameterModel struct {
+	Name  types.String `tfsdk:"name"`
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+// typedValue converts the string value of the parameter to the JSON type expected for its parameter type.
+func (m parameterModel) typedValue() (any, error) {
+	value := m.Value.ValueString()
+
+	switch fabcore.ItemJobParameterType(m.Type.ValueString()) {
+	case fabcore.ItemJobParameterTypeBoolean:
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("the value '%s' of parameter '%s' is not a valid %s", value, m.Name.ValueString(), fabcore.ItemJobParameterTypeBoolean)
+		}
+
+		return v, nil
+	case fabcore.ItemJobParameterTypeInteger:
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("the value '%s' of parameter '%s' is not a valid %s", value, m.Name.ValueString(), fabcore.ItemJobParameterTypeInteger)
+		}
+
+		return v, nil
+	case fabcore.ItemJobParameterTypeNumber:
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("the value '%s' of parameter '%s' is not a valid %s", value, m.Name.ValueString(), fabcore.ItemJobParameterTypeNumber)
+		}
+
+		return v, nil
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package itemjobinstance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/transforms"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.ResourceWithConfigure      = (*resourceItemJobInstance)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceItemJobInstance)(nil)
+)
+
+type resourceItemJobInstance struct {
+	pConfigData *pconfig.ProviderData
+	client      *fabcore.JobSchedulerClient
+	TypeInfo    tftypeinfo.TFTypeInfo
+}
+
+func NewResourceItemJobInstance() resource.Resource {
+	return &resourceItemJobInstance{
+		TypeInfo: ItemTypeInfo,
+	}
+}
+
+func (r *resourceItemJobInstance) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeInfo.FullTypeName(false)
+}
+
+func (r *resourceItemJobInstance) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = itemSchema().GetResource(ctx)
+}
+
+func (r *resourceItemJobInstance) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config resourceItemJobInstanceModel
+
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &config)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ExecutionData.IsNull() && !config.ExecutionData.IsUnknown() && !transforms.IsJSON(config.ExecutionData.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("execution_data"),
+			common.ErrorInvalidConfig,
+			"The execution data must be a valid JSON encoded string.",
+		)
+	}
+
+	if config.Parameters.IsNull() || config.Parameters.IsUnknown() {
+		return
+	}
+
+	parameters, diags := config.Parameters.Get(ctx)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := make([]string, 0, len(parameters))
+
+	for i, parameter := range parameters {
+		if parameter.Name.IsUnknown() || parameter.Type.IsUnknown() || parameter.Value.IsUnknown() {
+			continue
+		}
+
+		name := strings.ToLower(parameter.Name.ValueString())
+		if slices.Contains(names, name) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("parameters").AtListIndex(i).AtName("name"),
+				common.ErrorInvalidConfig,
+				fmt.Sprintf("The parameter name '%s' is not unique. Parameter names are case-insensitive.", parameter.Name.ValueString()),
+			)
+		}
+
+		names = append(names, name)
+
+		if _, err := parameter.typedValue(); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("parameters").AtListIndex(i).AtName("value"),
+				common.ErrorInvalidConfig,
+				err.Error(),
+			)
+		}
+	}
+}
+
+func (r *resourceItemJobInstance) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorResourceConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	r.pConfigData = pConfigData
+
+	if resp.Diagnostics.Append(fabricitem.IsPreviewMode(r.TypeInfo.Name, r.TypeInfo.IsPreview, r.pConfigData.Preview)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client = fabcore.NewClientFactoryWithClient(*pConfigData.FabricClient).NewJobSchedulerClient()
+}
+
+func (r *resourceItemJobInstance) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan resourceItemJobInstanceModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var reqRun requestRunOnDemandItemJob
+
+	if resp.Diagnostics.Append(reqRun.set(ctx, plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the job instance ID is only returned in the Location header of the response
+	var respRaw *http.Response
+
+	_, err := r.client.RunOnDemandItemJob(
+		runtime.WithCaptureResponse(ctx, &respRaw),
+		plan.WorkspaceID.ValueString(),
+		plan.ItemID.ValueString(),
+		plan.JobType.ValueString(),
+		&fabcore.JobSchedulerClientRunOnDemandItemJobOptions{
+			RunOnDemandItemJobRequest: &reqRun.RunOnDemandItemJobRequest,
+		},
+	)
+	if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationCreate, nil)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobInstanceID := utils.GetItemJobInstanceID(respRaw)
+	if jobInstanceID == "" {
+		resp.Diagnostics.AddError(
+			common.ErrorCreateHeader,
+			"The job instance ID was not returned by the service.",
+		)
+
+		return
+	}
+
+	jobInstance, err := utils.WaitForItemJobInstance(ctx, r.client, plan.WorkspaceID.ValueString(), plan.ItemID.ValueString(), jobInstanceID)
+
+	plan.set(jobInstance)
+
+	// the state is kept to record the job instance ID and its last known status, Terraform taints the resource because of the error
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationCreate, nil)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if *jobInstance.Status != fabcore.ItemJobStatusCompleted {
+		resp.Diagnostics.AddError(
+			common.ErrorItemJobHeader,
+			fmt.Sprintf(common.ErrorItemJobDetails, jobInstanceID, plan.Status.ValueString(), utils.GetItemJobFailureReason(jobInstance)),
+		)
+	}
+
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceItemJobInstance) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "start",
+	})
+
+	// the job instance is a completed operation, the state is kept as is
+
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "end",
+	})
+}
+
+func (r *resourceItemJobInstance) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan, state resourceItemJobInstanceModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only the timeouts can be updated in place, every other argument requires a new job instance
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceItemJobInstance) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "start",
+	})
+
+	// finished job instances cannot be removed, the resource is only removed from the state
+
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "end",
+	})
+}
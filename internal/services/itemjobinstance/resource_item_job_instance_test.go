@@ -0,0 +1,310 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package itemjobinstance_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testResourceItemFQN, testResourceItemHeader = testhelp.TFResource(common.ProviderTypeName, itemTypeInfo.Type, "test")
+
+func TestUnit_ItemJobInstanceResource_Attributes(t *testing.T) {
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no required attributes - workspace_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"item_id":  "00000000-0000-0000-0000-000000000000",
+					"job_type": "RunNotebook",
+				},
+			),
+			ExpectError: regexp.MustCompile(`The argument "workspace_id" is required, but no definition was found.`),
+		},
+		// error - no required attributes - item_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"job_type":     "RunNotebook",
+				},
+			),
+			ExpectError: regexp.MustCompile(`The argument "item_id" is required, but no definition was found.`),
+		},
+		// error - no required attributes - job_type
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"item_id":      "11111111-1111-1111-1111-111111111111",
+				},
+			),
+			ExpectError: regexp.MustCompile(`The argument "job_type" is required, but no definition was found.`),
+		},
+		// error - invalid UUID - item_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"item_id":      "invalid uuid",
+					"job_type":     "RunNotebook",
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - invalid execution_data
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":   "00000000-0000-0000-0000-000000000000",
+					"item_id":        "11111111-1111-1111-1111-111111111111",
+					"job_type":       "RunNotebook",
+					"execution_data": "not json",
+				},
+			),
+			ExpectError: regexp.MustCompile(`The execution data must be a valid JSON encoded string.`),
+		},
+		// error - invalid parameter type
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"item_id":      "11111111-1111-1111-1111-111111111111",
+					"job_type":     "Pipeline",
+					"parameters": []map[string]any{
+						{
+							"name":  "count",
+							"type":  "Invalid",
+							"value": "1",
+						},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(`Attribute parameters\[0\].type value must be one of`),
+		},
+		// error - parameter value not matching the type
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"item_id":      "11111111-1111-1111-1111-111111111111",
+					"job_type":     "Pipeline",
+					"parameters": []map[string]any{
+						{
+							"name":  "count",
+							"type":  string(fabcore.ItemJobParameterTypeInteger),
+							"value": "one",
+						},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(`the value 'one' of parameter 'count' is not a valid Integer`),
+		},
+		// error - duplicated parameter name
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"item_id":      "11111111-1111-1111-1111-111111111111",
+					"job_type":     "Pipeline",
+					"parameters": []map[string]any{
+						{
+							"name":  "count",
+							"type":  string(fabcore.ItemJobParameterTypeInteger),
+							"value": "1",
+						},
+						{
+							"name":  "Count",
+							"type":  string(fabcore.ItemJobParameterTypeInteger),
+							"value": "2",
+						},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(`The parameter name 'Count' is not unique.`),
+		},
+	}))
+}
+
+func TestUnit_ItemJobInstanceResource_CRUD(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	itemID := testhelp.RandomUUID()
+	jobInstanceID := testhelp.RandomUUID()
+	entity := NewRandomItemJobInstance(jobInstanceID, itemID, "Pipeline", fabcore.ItemJobStatusCompleted)
+
+	var received fabcore.RunOnDemandItemJobRequest
+
+	fakes.FakeServer.ServerFactory.Core.JobSchedulerServer.RunOnDemandItemJob = fakeRunOnDemandItemJob(jobInstanceID, &received)
+	fakes.FakeServer.ServerFactory.Core.JobSchedulerServer.GetItemJobInstance = fakeGetItemJobInstance(entity)
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":   workspaceID,
+					"item_id":        itemID,
+					"job_type":       "Pipeline",
+					"execution_data": `{\"parameters\":{\"env\":\"test\"}}`,
+					"parameters": []map[string]any{
+						{
+							"name":  "count",
+							"type":  string(fabcore.ItemJobParameterTypeInteger),
+							"value": "10",
+						},
+						{
+							"name":  "enabled",
+							"type":  string(fabcore.ItemJobParameterTypeBoolean),
+							"value": "true",
+						},
+					},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "id", jobInstanceID),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "status", string(fabcore.ItemJobStatusCompleted)),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "invoke_type", string(fabcore.InvokeTypeManual)),
+				resource.TestCheckResourceAttrPtr(testResourceItemFQN, "root_activity_id", entity.RootActivityID),
+				resource.TestCheckResourceAttrPtr(testResourceItemFQN, "start_time_utc", entity.StartTimeUTC),
+				resource.TestCheckResourceAttrPtr(testResourceItemFQN, "end_time_utc", entity.EndTimeUTC),
+				resource.TestCheckNoResourceAttr(testResourceItemFQN, "failure_reason"),
+				func(_ *terraform.State) error {
+					if len(received.Parameters) != 2 {
+						return errors.New("expected the job to be started with 2 parameters")
+					}
+
+					if v, ok := received.Parameters[0].Value.(float64); !ok || v != 10 {
+						return errors.New("expected the Integer parameter to be sent as a number")
+					}
+
+					if v, ok := received.Parameters[1].Value.(bool); !ok || !v {
+						return errors.New("expected the Boolean parameter to be sent as a boolean")
+					}
+
+					if received.ExecutionData == nil {
+						return errors.New("expected the job to be started with execution data")
+					}
+
+					return nil
+				},
+			),
+		},
+		// Update timeouts - no new job instance
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":   workspaceID,
+					"item_id":        itemID,
+					"job_type":       "Pipeline",
+					"execution_data": `{\"parameters\":{\"env\":\"test\"}}`,
+					"parameters": []map[string]any{
+						{
+							"name":  "count",
+							"type":  string(fabcore.ItemJobParameterTypeInteger),
+							"value": "10",
+						},
+						{
+							"name":  "enabled",
+							"type":  string(fabcore.ItemJobParameterTypeBoolean),
+							"value": "true",
+						},
+					},
+					"timeouts": map[string]any{
+						"create": "2h",
+					},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "id", jobInstanceID),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "timeouts.create", "2h"),
+			),
+		},
+	}))
+}
+
+func TestUnit_ItemJobInstanceResource_Failed(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	itemID := testhelp.RandomUUID()
+	jobInstanceID := testhelp.RandomUUID()
+	entity := NewRandomItemJobInstance(jobInstanceID, itemID, "RunNotebook", fabcore.ItemJobStatusFailed)
+
+	var received fabcore.RunOnDemandItemJobRequest
+
+	fakes.FakeServer.ServerFactory.Core.JobSchedulerServer.RunOnDemandItemJob = fakeRunOnDemandItemJob(jobInstanceID, &received)
+	fakes.FakeServer.ServerFactory.Core.JobSchedulerServer.GetItemJobInstance = fakeGetItemJobInstance(entity)
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+					"item_id":      itemID,
+					"job_type":     "RunNotebook",
+				},
+			),
+			ExpectError: regexp.MustCompile(`finished with status 'Failed': JobInstanceStatusFailed: The notebook execution failed`),
+		},
+	}))
+}
+
+func TestAcc_ItemJobInstanceResource_CRUD(t *testing.T) {
+	workspace := testhelp.WellKnown()["WorkspaceDS"].(map[string]any)
+	workspaceID := workspace["id"].(string)
+
+	entity := testhelp.WellKnown()["Notebook"].(map[string]any)
+	entityID := entity["id"].(string)
+
+	resource.Test(t, testhelp.NewTestAccCase(t, &testResourceItemFQN, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+					"item_id":      entityID,
+					"job_type":     "RunNotebook",
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrSet(testResourceItemFQN, "id"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "status", string(fabcore.ItemJobStatusCompleted)),
+				resource.TestCheckResourceAttrSet(testResourceItemFQN, "start_time_utc"),
+			),
+		},
+	}))
+}
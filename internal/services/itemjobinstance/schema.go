@@ -0,0 +1,205 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package itemjobinstance
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema" //revive:disable-line:import-alias-naming
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func itemSchema() superschema.Schema {
+	markdownDescriptionR := fabricitem.NewResourceMarkdownDescription(ItemTypeInfo, false) +
+		"\n\n-> The job runs once, when the resource is created, and the apply waits for the job instance to finish. " +
+		"Any change to the arguments, including `triggers`, runs a new job instance. " +
+		"If the job instance does not complete successfully, the apply fails with the failure reason and the resource is marked as tainted. " +
+		"Destroying the resource only removes it from the Terraform state."
+
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: markdownDescriptionR,
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The " + ItemTypeInfo.Name + " ID.",
+					CustomType:          customtypes.UUIDType{},
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"workspace_id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The Workspace ID.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"item_id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the item to run the job for.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"job_type": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The type of the job, as defined by the item type. For example `RunNotebook` for a Notebook, `Pipeline` for a Data Pipeline or `sparkjob` for a Spark Job Definition.",
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+			},
+			"execution_data": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The execution data of the job, as a JSON encoded string. The expected content is defined by the item job type. For example `jsonencode({ parameters = { name = { value = \"value\", type = \"string\" } } })` for a Notebook.",
+					Optional:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"parameters": superschema.SuperListNestedAttributeOf[parameterModel]{
+				Resource: &schemaR.ListNestedAttribute{
+					MarkdownDescription: "The list of parameters of the job. Not all item job types support parameters.",
+					Optional:            true,
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+				},
+				Attributes: superschema.Attributes{
+					"name": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The name of the parameter.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 256),
+							},
+						},
+					},
+					"type": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The type of the parameter.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(utils.ConvertEnumsToStringSlices(fabcore.PossibleItemJobParameterTypeValues(), true)...),
+							},
+						},
+					},
+					"value": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The value of the parameter. `Boolean`, `Integer` and `Number` values are converted to the matching JSON type.",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"triggers": superschema.SuperMapAttribute{
+				Resource: &schemaR.MapAttribute{
+					MarkdownDescription: "A map of arbitrary values that, when changed, run a new job instance.",
+					CustomType:          supertypes.MapTypeOf[types.String]{MapType: types.MapType{ElemType: types.StringType}},
+					ElementType:         types.StringType,
+					Optional:            true,
+					PlanModifiers: []planmodifier.Map{
+						mapplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.Map{
+						mapvalidator.NoNullValues(),
+					},
+				},
+			},
+			"status": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The status of the job instance.",
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"invoke_type": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The invoke type of the job instance.",
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"root_activity_id": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The root activity ID, used to trace requests across services.",
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"start_time_utc": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The start time of the job instance, in UTC.",
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"end_time_utc": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The end time of the job instance, in UTC.",
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"failure_reason": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The failure reason, when the job instance failed.",
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"timeouts": superschema.TimeoutAttribute{
+				Resource: &superschema.ResourceTimeoutAttribute{
+					Create: true,
+					Read:   true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		},
+	}
+}
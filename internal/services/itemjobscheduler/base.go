@@ -18,7 +18,31 @@ var ItemTypeInfo = tftypeinfo.TFTypeInfo{ //nolint:gochecknoglobals
 }
 
 var AllowedJobTypesByItemType = map[string][]string{ //nolint:gochecknoglobals
-	"dataflow":     {"Execute", "ApplyChanges"},
-	"datapipeline": {"Execute"},
-	"lakehouse":    {"RefreshMaterializedLakeViews"},
+	"copyjob":            {"CopyJob"},
+	"dataflow":           {"Execute", "ApplyChanges"},
+	"datapipeline":       {"Execute"},
+	"lakehouse":          {"RefreshMaterializedLakeViews"},
+	"notebook":           {"RunNotebook"},
+	"sparkjobdefinition": {"sparkjob"},
 }
+
+type executionDataTarget struct {
+	itemType string
+	jobType  string
+}
+
+// executionDataTargets maps each execution_data block to the item type and job type it applies to.
+var executionDataTargets = map[string]executionDataTarget{ //nolint:gochecknoglobals
+	"data_pipeline":        {itemType: "datapipeline", jobType: "Execute"},
+	"notebook":             {itemType: "notebook", jobType: "RunNotebook"},
+	"spark_job_definition": {itemType: "sparkjobdefinition", jobType: "sparkjob"},
+}
+
+const (
+	parameterTypeBool   = "Bool"
+	parameterTypeFloat  = "Float"
+	parameterTypeInt    = "Int"
+	parameterTypeString = "String"
+)
+
+var possibleParameterTypeValues = []string{parameterTypeBool, parameterTypeFloat, parameterTypeInt, parameterTypeString} //nolint:gochecknoglobals
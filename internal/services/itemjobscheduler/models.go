@@ -6,13 +6,17 @@ package itemjobscheduler
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	timeoutsD "github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts" //revive:disable-line:import-alias-naming
 	timeoutsR "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"   //revive:disable-line:import-alias-naming
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	fabsparkjobdefinition "github.com/microsoft/fabric-sdk-go/fabric/sparkjobdefinition"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 
 	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
@@ -239,7 +243,243 @@ RESOURCE
 type resourceJobScheduleModel struct {
 	baseItemJobSchedulerModel
 
-	Timeouts timeoutsR.Value `tfsdk:"timeouts"`
+	ExecutionData supertypes.SingleNestedObjectValueOf[executionDataModel] `tfsdk:"execution_data"`
+	Timeouts      timeoutsR.Value                                          `tfsdk:"timeouts"`
+}
+
+type executionDataModel struct {
+	Notebook           supertypes.SingleNestedObjectValueOf[notebookExecutionDataModel]           `tfsdk:"notebook"`
+	DataPipeline       supertypes.SingleNestedObjectValueOf[dataPipelineExecutionDataModel]       `tfsdk:"data_pipeline"`
+	SparkJobDefinition supertypes.SingleNestedObjectValueOf[sparkJobDefinitionExecutionDataModel] `tfsdk:"spark_job_definition"`
+}
+
+type notebookExecutionDataModel struct {
+	Parameters         supertypes.MapNestedObjectValueOf[executionParameterModel] `tfsdk:"parameters"`
+	DefaultLakehouseID customtypes.UUID                                           `tfsdk:"default_lakehouse_id"`
+	EnvironmentID      customtypes.UUID                                           `tfsdk:"environment_id"`
+}
+
+type dataPipelineExecutionDataModel struct {
+	Parameters supertypes.MapNestedObjectValueOf[executionParameterModel] `tfsdk:"parameters"`
+}
+
+type sparkJobDefinitionExecutionDataModel struct {
+	ExecutableFile        types.String                         `tfsdk:"executable_file"`
+	MainClass             types.String                         `tfsdk:"main_class"`
+	CommandLineArguments  types.String                         `tfsdk:"command_line_arguments"`
+	AdditionalLibraryURIs supertypes.ListValueOf[types.String] `tfsdk:"additional_library_uris"`
+	DefaultLakehouseID    customtypes.UUID                     `tfsdk:"default_lakehouse_id"`
+	EnvironmentID         customtypes.UUID                     `tfsdk:"environment_id"`
+}
+
+type executionParameterModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+// blockName returns the name of the execution_data block that is set, or an empty string when none is known yet.
+func (to *executionDataModel) blockName() string {
+	switch {
+	case !to.Notebook.IsNull() && !to.Notebook.IsUnknown():
+		return "notebook"
+	case !to.DataPipeline.IsNull() && !to.DataPipeline.IsUnknown():
+		return "data_pipeline"
+	case !to.SparkJobDefinition.IsNull() && !to.SparkJobDefinition.IsUnknown():
+		return "spark_job_definition"
+	default:
+		return ""
+	}
+}
+
+// getExecutionData converts the execution_data attribute to the job-type specific payload of the schedule request.
+// It returns nil when execution_data is not set.
+func getExecutionData(ctx context.Context, workspaceID string, from supertypes.SingleNestedObjectValueOf[executionDataModel]) (any, diag.Diagnostics) {
+	if from.IsNull() || from.IsUnknown() {
+		return nil, nil
+	}
+
+	executionData, diags := from.Get(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	switch executionData.blockName() {
+	case "notebook":
+		notebook, diags := executionData.Notebook.Get(ctx)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return notebook.get(ctx)
+	case "data_pipeline":
+		dataPipeline, diags := executionData.DataPipeline.Get(ctx)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return dataPipeline.get(ctx)
+	case "spark_job_definition":
+		sparkJobDefinition, diags := executionData.SparkJobDefinition.Get(ctx)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return sparkJobDefinition.get(ctx, workspaceID)
+	default:
+		return nil, nil
+	}
+}
+
+func (to *notebookExecutionDataModel) get(ctx context.Context) (map[string]any, diag.Diagnostics) {
+	result := map[string]any{}
+
+	parameters, diags := getExecutionParameters(ctx, path.Root("execution_data").AtName("notebook").AtName("parameters"), to.Parameters)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if parameters != nil {
+		parameterModels, diags := to.Parameters.Get(ctx)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		notebookParameters := make(map[string]any, len(parameters))
+
+		for k, v := range parameters {
+			notebookParameters[k] = map[string]any{
+				"value": v,
+				"type":  strings.ToLower(parameterModels[k].Type.ValueString()),
+			}
+		}
+
+		result["parameters"] = notebookParameters
+	}
+
+	configuration := map[string]any{}
+
+	if id := to.DefaultLakehouseID.ValueString(); id != "" {
+		configuration["defaultLakehouse"] = map[string]any{"id": id}
+	}
+
+	if id := to.EnvironmentID.ValueString(); id != "" {
+		configuration["environment"] = map[string]any{"id": id}
+	}
+
+	if len(configuration) > 0 {
+		result["configuration"] = configuration
+	}
+
+	return result, nil
+}
+
+func (to *dataPipelineExecutionDataModel) get(ctx context.Context) (map[string]any, diag.Diagnostics) {
+	parameters, diags := getExecutionParameters(ctx, path.Root("execution_data").AtName("data_pipeline").AtName("parameters"), to.Parameters)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if parameters == nil {
+		return map[string]any{}, nil
+	}
+
+	return map[string]any{
+		"parameters": parameters,
+	}, nil
+}
+
+func (to *sparkJobDefinitionExecutionDataModel) get(ctx context.Context, workspaceID string) (*fabsparkjobdefinition.ExecutionData, diag.Diagnostics) {
+	result := &fabsparkjobdefinition.ExecutionData{
+		ExecutableFile:       to.ExecutableFile.ValueStringPointer(),
+		MainClass:            to.MainClass.ValueStringPointer(),
+		CommandLineArguments: to.CommandLineArguments.ValueStringPointer(),
+	}
+
+	if !to.AdditionalLibraryURIs.IsNull() && !to.AdditionalLibraryURIs.IsUnknown() {
+		uris, diags := to.AdditionalLibraryURIs.Get(ctx)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result.AdditionalLibraryUris = make([]string, 0, len(uris))
+		for _, uri := range uris {
+			result.AdditionalLibraryUris = append(result.AdditionalLibraryUris, uri.ValueString())
+		}
+	}
+
+	referenceType := fabsparkjobdefinition.ItemReferenceTypeByID
+
+	if id := to.DefaultLakehouseID.ValueStringPointer(); id != nil {
+		result.DefaultLakehouseID = &fabsparkjobdefinition.ItemReferenceByID{
+			ItemID:        id,
+			ReferenceType: &referenceType,
+			WorkspaceID:   &workspaceID,
+		}
+	}
+
+	if id := to.EnvironmentID.ValueStringPointer(); id != nil {
+		result.EnvironmentID = &fabsparkjobdefinition.ItemReferenceByID{
+			ItemID:        id,
+			ReferenceType: &referenceType,
+			WorkspaceID:   &workspaceID,
+		}
+	}
+
+	return result, nil
+}
+
+// getExecutionParameters converts the parameter values to their declared type. It returns nil when no parameters are set.
+func getExecutionParameters(ctx context.Context, attrPath path.Path, from supertypes.MapNestedObjectValueOf[executionParameterModel]) (map[string]any, diag.Diagnostics) {
+	if from.IsNull() || from.IsUnknown() {
+		return nil, nil
+	}
+
+	parameters, diags := from.Get(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result := make(map[string]any, len(parameters))
+
+	for name, p := range parameters {
+		if p == nil || p.Type.IsUnknown() || p.Value.IsUnknown() {
+			continue
+		}
+
+		value, err := parseParameterValue(p.Type.ValueString(), p.Value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				attrPath.AtMapKey(name).AtName("value"),
+				"Invalid parameter value",
+				fmt.Sprintf("The value of parameter '%s' is not a valid %s: %s.", name, p.Type.ValueString(), err),
+			)
+
+			continue
+		}
+
+		result[name] = value
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return result, nil
+}
+
+func parseParameterValue(parameterType, value string) (any, error) {
+	switch parameterType {
+	case parameterTypeBool:
+		return strconv.ParseBool(value)
+	case parameterTypeFloat:
+		return strconv.ParseFloat(value, 64)
+	case parameterTypeInt:
+		return strconv.ParseInt(value, 10, 64)
+	case parameterTypeString:
+		return value, nil
+	default:
+		return nil, fmt.Errorf("unsupported parameter type '%s'", parameterType)
+	}
 }
 
 type requestCreateJobSchedule struct {
@@ -385,8 +625,14 @@ func (to *requestCreateJobSchedule) set(ctx context.Context, from resourceJobSch
 		return diags
 	}
 
+	executionData, executionDataDiags := getExecutionData(ctx, from.WorkspaceID.ValueString(), from.ExecutionData)
+	if executionDataDiags.HasError() {
+		return executionDataDiags
+	}
+
 	to.Configuration = reqConfiguration
 	to.Enabled = from.Enabled.ValueBoolPointer()
+	to.ExecutionData = executionData
 
 	return nil
 }
@@ -536,8 +782,14 @@ func (to *requestUpdateJobSchedule) set(ctx context.Context, from resourceJobSch
 		return diags
 	}
 
+	executionData, executionDataDiags := getExecutionData(ctx, from.WorkspaceID.ValueString(), from.ExecutionData)
+	if executionDataDiags.HasError() {
+		return executionDataDiags
+	}
+
 	to.Configuration = reqConfiguration
 	to.Enabled = from.Enabled.ValueBoolPointer()
+	to.ExecutionData = executionData
 
 	return nil
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
@@ -28,6 +29,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = (*resourceItemJobScheduler)(nil)
 	_ resource.ResourceWithImportState = (*resourceItemJobScheduler)(nil)
+	_ resource.ResourceWithIdentity    = (*resourceItemJobScheduler)(nil)
 	_ resource.ResourceWithModifyPlan  = (*resourceItemJobScheduler)(nil)
 )
 
@@ -52,6 +54,32 @@ func (r *resourceItemJobScheduler) Schema(ctx context.Context, _ resource.Schema
 	resp.Schema = itemSchema(false).GetResource(ctx)
 }
 
+func (r *resourceItemJobScheduler) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_id": identityschema.StringAttribute{
+				Description:       "The Workspace ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+			"item_id": identityschema.StringAttribute{
+				Description:       "The Item ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+			"job_type": identityschema.StringAttribute{
+				Description:       "The Job type.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The Job Schedule ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+		},
+	}
+}
+
 func (r *resourceItemJobScheduler) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -98,6 +126,22 @@ func (r *resourceItemJobScheduler) ModifyPlan(ctx context.Context, req resource.
 			return
 		}
 
+		if resp.Diagnostics.Append(r.validateExecutionData(ctx, plan)...); resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Reject unsupported job types at plan time, as soon as the target item is known.
+		if !plan.WorkspaceID.IsUnknown() && !plan.ItemID.IsUnknown() && !plan.JobType.IsUnknown() {
+			respItem, err := r.fabricClient.GetItem(ctx, plan.WorkspaceID.ValueString(), plan.ItemID.ValueString(), nil)
+			if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationRead, nil)...); resp.Diagnostics.HasError() {
+				return
+			}
+
+			if resp.Diagnostics.Append(r.validateJobType(respItem.Type, plan.JobType.ValueString())...); resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
 		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 	}
 
@@ -154,6 +198,7 @@ func (r *resourceItemJobScheduler) Create(ctx context.Context, req resource.Crea
 
 	state.set(ctx, plan.WorkspaceID.ValueString(), plan.ItemID.ValueString(), plan.JobType.ValueString(), respCreate.ItemSchedule)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "CREATE", map[string]any{
 		"action": "end",
@@ -197,6 +242,7 @@ func (r *resourceItemJobScheduler) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "READ", map[string]any{
 		"action": "end",
@@ -262,6 +308,7 @@ func (r *resourceItemJobScheduler) Update(ctx context.Context, req resource.Upda
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "UPDATE", map[string]any{
 		"action": "end",
@@ -309,7 +356,12 @@ func (r *resourceItemJobScheduler) ImportState(ctx context.Context, req resource
 		"id": req.ID,
 	})
 
-	parts := strings.Split(req.ID, "/")
+	importID, diags := utils.GetImportID(ctx, req, "workspace_id", "item_id", "job_type", "id")
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts := strings.Split(importID, "/")
 	if len(parts) != 4 {
 		resp.Diagnostics.AddError(
 			common.ErrorImportIdentifierHeader,
@@ -354,6 +406,7 @@ func (r *resourceItemJobScheduler) ImportState(ctx context.Context, req resource
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "IMPORT", map[string]any{
 		"action": "end",
@@ -397,6 +450,37 @@ func (r *resourceItemJobScheduler) validateJobType(itemType *fabcore.ItemType, j
 	return nil
 }
 
+// validateExecutionData checks that the execution_data block matches the job type and that the parameter values match their declared type.
+func (r *resourceItemJobScheduler) validateExecutionData(ctx context.Context, model resourceJobScheduleModel) diag.Diagnostics {
+	if model.ExecutionData.IsNull() || model.ExecutionData.IsUnknown() {
+		return nil
+	}
+
+	executionData, diags := model.ExecutionData.Get(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	blockName := executionData.blockName()
+	if blockName == "" {
+		return nil
+	}
+
+	if target := executionDataTargets[blockName]; !model.JobType.IsUnknown() && model.JobType.ValueString() != target.jobType {
+		diags.AddAttributeError(
+			path.Root("execution_data").AtName(blockName),
+			"Invalid execution_data",
+			fmt.Sprintf("The execution_data.%s block only applies to the '%s' job type of '%s' items, the job type is '%s'.", blockName, target.jobType, target.itemType, model.JobType.ValueString()),
+		)
+
+		return diags
+	}
+
+	_, diags = getExecutionData(ctx, model.WorkspaceID.ValueString(), model.ExecutionData)
+
+	return diags
+}
+
 func (r *resourceItemJobScheduler) get(ctx context.Context, model *resourceJobScheduleModel) diag.Diagnostics {
 	respGet, err := r.client.GetItemSchedule(ctx, model.WorkspaceID.ValueString(), model.ItemID.ValueString(), model.JobType.ValueString(), model.ID.ValueString(), nil)
 	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationRead, fabcore.ErrCommon.EntityNotFound); diags.HasError() {
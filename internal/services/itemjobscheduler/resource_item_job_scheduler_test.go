@@ -658,6 +658,28 @@ func TestUnit_ItemJobSchedulerResource_CRUD(t *testing.T) {
 				resource.TestCheckResourceAttr(testResourceItemFQN, "configuration.weekdays.0", weekdaysStr[0]),
 			),
 		},
+		// Import with the resource identity
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+					"item_id":      itemID,
+					"job_type":     jobType,
+					"enabled":      *entityUpdate.Enabled,
+					"configuration": map[string]any{
+						"start_date_time": entityUpdate.Configuration.GetScheduleConfig().StartDateTime.Format(time.RFC3339),
+						"end_date_time":   entityUpdate.Configuration.GetScheduleConfig().EndDateTime.Format(time.RFC3339),
+						"type":            string(*entityUpdate.Configuration.GetScheduleConfig().Type),
+						"times":           entityUpdate.Configuration.(*fabcore.WeeklyScheduleConfig).Times,
+						"weekdays":        weekdaysStr,
+					},
+				},
+			),
+			ImportState:     true,
+			ImportStateKind: resource.ImportBlockWithResourceIdentity,
+		},
 		// Delete testing automatically occurs in TestCase
 	}))
 }
@@ -741,3 +763,136 @@ func TestAcc_ItemJobSchedulerResource_CRUD(t *testing.T) {
 		// Delete testing automatically occurs in TestCase
 	}))
 }
+
+func TestUnit_ItemJobSchedulerResource_ExecutionData(t *testing.T) {
+	itemType := "notebook"
+	workspaceID := testhelp.RandomUUID()
+	itemID := testhelp.RandomUUID()
+	jobType := itemjobscheduler.AllowedJobTypesByItemType[itemType][0]
+	entity := NewRandomItemSchedule(fabcore.ScheduleTypeCron)
+	lakehouseID := testhelp.RandomUUID()
+
+	configuration := map[string]any{
+		"start_date_time": entity.Configuration.GetScheduleConfig().StartDateTime.Format(time.RFC3339),
+		"end_date_time":   entity.Configuration.GetScheduleConfig().EndDateTime.Format(time.RFC3339),
+		"type":            string(*entity.Configuration.GetScheduleConfig().Type),
+		"interval":        int(*entity.Configuration.(*fabcore.CronScheduleConfig).Interval),
+	}
+
+	fakes.FakeServer.ServerFactory.Core.ItemsServer.GetItem = fakeGetFabricItem(itemType)
+	fakes.FakeServer.ServerFactory.Core.JobSchedulerServer.GetItemSchedule = fakeGetItemScheduleFunc()
+	fakes.FakeServer.ServerFactory.Core.JobSchedulerServer.DeleteItemSchedule = fakeDeleteItemScheduleFunc()
+	fakes.FakeServer.ServerFactory.Core.JobSchedulerServer.UpdateItemSchedule = fakeUpdateItemScheduleFunc()
+	fakes.FakeServer.ServerFactory.Core.JobSchedulerServer.CreateItemSchedule = fakeCreateItemScheduleFunc()
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - job type not supported by the item type
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":  workspaceID,
+					"item_id":       itemID,
+					"job_type":      "Execute",
+					"enabled":       true,
+					"configuration": configuration,
+				},
+			),
+			ExpectError: regexp.MustCompile("Invalid Job Type"),
+		},
+		// error - more than one execution_data block
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":  workspaceID,
+					"item_id":       itemID,
+					"job_type":      jobType,
+					"enabled":       true,
+					"configuration": configuration,
+					"execution_data": map[string]any{
+						"notebook":      map[string]any{},
+						"data_pipeline": map[string]any{},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+		},
+		// error - execution_data block not matching the job type
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":  workspaceID,
+					"item_id":       itemID,
+					"job_type":      jobType,
+					"enabled":       true,
+					"configuration": configuration,
+					"execution_data": map[string]any{
+						"data_pipeline": map[string]any{},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile("Invalid execution_data"),
+		},
+		// error - parameter value not matching its type
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":  workspaceID,
+					"item_id":       itemID,
+					"job_type":      jobType,
+					"enabled":       true,
+					"configuration": configuration,
+					"execution_data": map[string]any{
+						"notebook": map[string]any{
+							"parameters": map[string]any{
+								"batch_size": map[string]any{
+									"type":  "Int",
+									"value": "ten",
+								},
+							},
+						},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile("Invalid parameter value"),
+		},
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":  workspaceID,
+					"item_id":       itemID,
+					"job_type":      jobType,
+					"enabled":       *entity.Enabled,
+					"configuration": configuration,
+					"execution_data": map[string]any{
+						"notebook": map[string]any{
+							"parameters": map[string]any{
+								"batch_size": map[string]any{
+									"type":  "Int",
+									"value": "10",
+								},
+							},
+							"default_lakehouse_id": lakehouseID,
+						},
+					},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "job_type", jobType),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "execution_data.notebook.parameters.batch_size.type", "Int"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "execution_data.notebook.parameters.batch_size.value", "10"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "execution_data.notebook.default_lakehouse_id", lakehouseID),
+			),
+		},
+	}))
+}
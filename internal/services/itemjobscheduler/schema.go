@@ -5,12 +5,16 @@ package itemjobscheduler
 
 import (
 	"fmt"
+	"maps"
 	"regexp"
 	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -46,7 +50,8 @@ func itemSchema(isList bool) superschema.Schema { //revive:disable-line:flag-par
 
 	return superschema.Schema{
 		Resource: superschema.SchemaDetails{
-			MarkdownDescription: fabricitem.NewResourceMarkdownDescription(ItemTypeInfo, false),
+			MarkdownDescription: fabricitem.NewResourceMarkdownDescription(ItemTypeInfo, false) +
+				"\n\n-> Semantic Model refreshes are not scheduled by the Fabric Job Scheduler, use the `fabric_semantic_model_refresh_schedule` resource instead.",
 		},
 		DataSource: superschema.SchemaDetails{
 			MarkdownDescription: fabricitem.NewDataSourceMarkdownDescription(ItemTypeInfo, isList),
@@ -126,8 +131,9 @@ func itemSchema(isList bool) superschema.Schema { //revive:disable-line:flag-par
 					Computed: true,
 				},
 			},
-			"owner":         ownerSchema(),
-			"configuration": configurationSchema(),
+			"owner":          ownerSchema(),
+			"configuration":  configurationSchema(),
+			"execution_data": executionDataSchema(),
 			"timeouts": superschema.TimeoutAttribute{
 				Resource: &superschema.ResourceTimeoutAttribute{
 					Create: true,
@@ -474,6 +480,141 @@ func occurrenceSchema() superschema.SuperSingleNestedAttributeOf[occurrenceModel
 	}
 }
 
+func executionDataSchema() superschema.SuperSingleNestedAttributeOf[executionDataModel] {
+	return superschema.SuperSingleNestedAttributeOf[executionDataModel]{
+		Resource: &schemaR.SingleNestedAttribute{
+			MarkdownDescription: "The execution data passed to each scheduled job. Exactly one block matching the item type and job type must be set. " +
+				"The execution data is write-only: it is sent when the schedule is created or updated, but never read back from the schedule. " +
+				"Changes made outside of Terraform are not detected, and it is not set when the schedule is imported.",
+			Optional: true,
+		},
+		Attributes: map[string]superschema.Attribute{
+			"notebook": superschema.SuperSingleNestedAttributeOf[notebookExecutionDataModel]{
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The execution data of a Notebook `RunNotebook` job.",
+					Optional:            true,
+					Validators:          executionDataValidators("notebook"),
+				},
+				Attributes: map[string]superschema.Attribute{
+					"parameters":           executionParametersSchema("The Notebook parameters, keyed by the parameter name. The names must match the parameters cell of the Notebook."),
+					"default_lakehouse_id": executionItemIDSchema("The default Lakehouse ID of the Notebook run."),
+					"environment_id":       executionItemIDSchema("The Environment ID of the Notebook run."),
+				},
+			},
+			"data_pipeline": superschema.SuperSingleNestedAttributeOf[dataPipelineExecutionDataModel]{
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The execution data of a Data Pipeline `Execute` job.",
+					Optional:            true,
+					Validators:          executionDataValidators("data_pipeline"),
+				},
+				Attributes: map[string]superschema.Attribute{
+					"parameters": executionParametersSchema("The Data Pipeline parameters, keyed by the parameter name."),
+				},
+			},
+			"spark_job_definition": superschema.SuperSingleNestedAttributeOf[sparkJobDefinitionExecutionDataModel]{
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The execution data of a Spark Job Definition `sparkjob` job. Unset attributes use the values of the Spark Job Definition.",
+					Optional:            true,
+					Validators:          executionDataValidators("spark_job_definition"),
+				},
+				Attributes: map[string]superschema.Attribute{
+					"executable_file": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The main executable file, as an `abfss://` path.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^abfss://`), "The executable file must be an abfss:// path."),
+							},
+						},
+					},
+					"main_class": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The main class name. Not needed for Python and R executable files.",
+							Optional:            true,
+						},
+					},
+					"command_line_arguments": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The space separated command line arguments.",
+							Optional:            true,
+						},
+					},
+					"additional_library_uris": superschema.SuperListAttribute{
+						Resource: &schemaR.ListAttribute{
+							MarkdownDescription: "The additional library paths needed for execution.",
+							CustomType: supertypes.ListTypeOf[types.String]{
+								ListType: basetypes.ListType{
+									ElemType: types.StringType,
+								},
+							},
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+					"default_lakehouse_id": executionItemIDSchema("The default Lakehouse ID of the Spark job. The Lakehouse must be in the same Workspace as the Spark Job Definition."),
+					"environment_id":       executionItemIDSchema("The Environment ID of the Spark job. The Environment must be in the same Workspace as the Spark Job Definition."),
+				},
+			},
+		},
+	}
+}
+
+func executionDataValidators(name string) []validator.Object {
+	others := make([]path.Expression, 0, len(executionDataTargets)-1)
+
+	for _, k := range slices.Sorted(maps.Keys(executionDataTargets)) {
+		if k != name {
+			others = append(others, path.MatchRelative().AtParent().AtName(k))
+		}
+	}
+
+	return []validator.Object{
+		objectvalidator.ExactlyOneOf(others...),
+	}
+}
+
+func executionParametersSchema(markdownDescription string) superschema.SuperMapNestedAttributeOf[executionParameterModel] {
+	return superschema.SuperMapNestedAttributeOf[executionParameterModel]{
+		Resource: &schemaR.MapNestedAttribute{
+			MarkdownDescription: markdownDescription,
+			Optional:            true,
+			Validators: []validator.Map{
+				mapvalidator.SizeAtLeast(1),
+			},
+		},
+		Attributes: map[string]superschema.Attribute{
+			"type": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The parameter type. Value must be one of : " + utils.ConvertStringSlicesToString(possibleParameterTypeValues, true, true) + ".",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(possibleParameterTypeValues...),
+					},
+				},
+			},
+			"value": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The parameter value, as a string. It is converted to the parameter type.",
+					Required:            true,
+				},
+			},
+		},
+	}
+}
+
+func executionItemIDSchema(markdownDescription string) superschema.SuperStringAttribute {
+	return superschema.SuperStringAttribute{
+		Resource: &schemaR.StringAttribute{
+			MarkdownDescription: markdownDescription,
+			CustomType:          customtypes.UUIDType{},
+			Optional:            true,
+		},
+	}
+}
+
 func allowedJobTypesMarkdownDescription() string {
 	var b strings.Builder
 	_, _ = b.WriteString("Allowed job types per item type: ")
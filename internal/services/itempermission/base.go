@@ -0,0 +1,18 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package itempermission
+
+import (
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+)
+
+var ItemTypeInfo = tftypeinfo.TFTypeInfo{ //nolint:gochecknoglobals
+	Name:           "Item Permission",
+	Type:           "item_permission",
+	Names:          "Item Permissions",
+	Types:          "item_permissions",
+	DocsURL:        "https://learn.microsoft.com/fabric/fundamentals/share-items",
+	IsPreview:      true,
+	IsSPNSupported: true,
+}
@@ -0,0 +1,116 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package itempermission
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabadmin "github.com/microsoft/fabric-sdk-go/fabric/admin"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+var _ datasource.DataSourceWithConfigure = (*dataSourceItemPermissions)(nil)
+
+type dataSourceItemPermissions struct {
+	pConfigData *pconfig.ProviderData
+	client      *fabadmin.ItemsClient
+	TypeInfo    tftypeinfo.TFTypeInfo
+}
+
+func NewDataSourceItemPermissions() datasource.DataSource {
+	return &dataSourceItemPermissions{
+		TypeInfo: ItemTypeInfo,
+	}
+}
+
+func (d *dataSourceItemPermissions) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeInfo.FullTypeName(true)
+}
+
+func (d *dataSourceItemPermissions) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = itemsSchema().GetDataSource(ctx)
+}
+
+func (d *dataSourceItemPermissions) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorDataSourceConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	d.pConfigData = pConfigData
+	d.client = fabadmin.NewClientFactoryWithClient(*pConfigData.FabricClient).NewItemsClient()
+
+	if resp.Diagnostics.Append(fabricitem.IsPreviewMode(d.TypeInfo.Name, d.TypeInfo.IsPreview, d.pConfigData.Preview)...); resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *dataSourceItemPermissions) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "start",
+	})
+
+	var data dataSourceItemPermissionsModel
+
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Read(ctx, d.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if resp.Diagnostics.Append(d.list(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *dataSourceItemPermissions) list(ctx context.Context, model *dataSourceItemPermissionsModel) diag.Diagnostics {
+	var options *fabadmin.ItemsClientListItemAccessDetailsOptions
+
+	if !model.ItemType.IsNull() {
+		options = &fabadmin.ItemsClientListItemAccessDetailsOptions{
+			Type: model.ItemType.ValueStringPointer(),
+		}
+	}
+
+	respList, err := d.client.ListItemAccessDetails(ctx, model.WorkspaceID.ValueString(), model.ItemID.ValueString(), options)
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationList, nil); diags.HasError() {
+		return diags
+	}
+
+	return model.setValues(ctx, respList.AccessDetails)
+}
@@ -0,0 +1,135 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package itempermission_test
+
+import (
+	"regexp"
+	"testing"
+
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	fabadmin "github.com/microsoft/fabric-sdk-go/fabric/admin"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testDataSourceItemsFQN, testDataSourceItemsHeader = testhelp.TFDataSource(common.ProviderTypeName, itemTypeInfo.Types, "test")
+
+func TestUnit_ItemPermissionsDataSource(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	itemID := testhelp.RandomUUID()
+
+	user := NewRandomUserPrincipal()
+	group := NewRandomGroupPrincipal()
+
+	fakeTestUpsert(
+		workspaceID,
+		itemID,
+		NewRandomItemAccessDetails(user, []fabadmin.ItemPermissions{fabadmin.ItemPermissionsRead, fabadmin.ItemPermissionsReshare}, []string{"ReadAll", "Build"}),
+		NewRandomItemAccessDetails(group, []fabadmin.ItemPermissions{fabadmin.ItemPermissionsRead}, nil),
+	)
+
+	fakes.FakeServer.ServerFactory.Admin.ItemsServer.ListItemAccessDetails = fakeListItemAccessDetails()
+
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, nil, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no attributes
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemsHeader,
+				map[string]any{},
+			),
+			ExpectError: regexp.MustCompile(`The argument "workspace_id" is required, but no definition was found`),
+		},
+		// error - item_id - invalid UUID
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemsHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+					"item_id":      "invalid uuid",
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - item_type - invalid value
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemsHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+					"item_id":      itemID,
+					"item_type":    "InvalidType",
+				},
+			),
+			ExpectError: regexp.MustCompile(`Attribute item_type value must be one of`),
+		},
+		// error - not found
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemsHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+					"item_id":      testhelp.RandomUUID(),
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorListHeader),
+		},
+		// read
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemsHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+					"item_id":      itemID,
+					"item_type":    string(fabadmin.ItemTypeSemanticModel),
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testDataSourceItemsFQN, "workspace_id", workspaceID),
+				resource.TestCheckResourceAttr(testDataSourceItemsFQN, "item_id", itemID),
+				resource.TestCheckResourceAttr(testDataSourceItemsFQN, "values.#", "2"),
+				// Group sorts before User
+				resource.TestCheckResourceAttrPtr(testDataSourceItemsFQN, "values.0.principal.id", group.ID),
+				resource.TestCheckResourceAttr(testDataSourceItemsFQN, "values.0.principal.type", string(fabadmin.PrincipalTypeGroup)),
+				resource.TestCheckResourceAttrPtr(testDataSourceItemsFQN, "values.0.display_name", group.DisplayName),
+				resource.TestCheckResourceAttr(testDataSourceItemsFQN, "values.0.permissions.#", "1"),
+				resource.TestCheckResourceAttr(testDataSourceItemsFQN, "values.0.additional_permissions.#", "0"),
+				resource.TestCheckResourceAttrPtr(testDataSourceItemsFQN, "values.1.principal.id", user.ID),
+				resource.TestCheckResourceAttr(testDataSourceItemsFQN, "values.1.permissions.#", "2"),
+				resource.TestCheckTypeSetElemAttr(testDataSourceItemsFQN, "values.1.permissions.*", string(fabadmin.ItemPermissionsReshare)),
+				resource.TestCheckTypeSetElemAttr(testDataSourceItemsFQN, "values.1.additional_permissions.*", "ReadAll"),
+			),
+		},
+	}))
+}
+
+func TestAcc_ItemPermissionsDataSource(t *testing.T) {
+	workspace := testhelp.WellKnown()["WorkspaceDS"].(map[string]any)
+	workspaceID := workspace["id"].(string)
+
+	entity := testhelp.WellKnown()["SemanticModel"].(map[string]any)
+	entityID := entity["id"].(string)
+
+	resource.ParallelTest(t, testhelp.NewTestAccCase(t, nil, nil, []resource.TestStep{
+		// read
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemsHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+					"item_id":      entityID,
+					"item_type":    string(fabadmin.ItemTypeSemanticModel),
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testDataSourceItemsFQN, "item_id", entityID),
+				resource.TestCheckResourceAttrSet(testDataSourceItemsFQN, "values.0.principal.id"),
+			),
+		},
+	},
+	))
+}
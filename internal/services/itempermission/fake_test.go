@@ -0,0 +1,73 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package itempermission_test
+
+import (
+	"context"
+	"net/http"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	fabadmin "github.com/microsoft/fabric-sdk-go/fabric/admin"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	fabfake "github.com/microsoft/fabric-sdk-go/fabric/fake"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+)
+
+var fakeItemAccessDetailsStore = map[string][]fabadmin.ItemAccessDetails{}
+
+func fakeListItemAccessDetails() func(ctx context.Context, workspaceID, itemID string, options *fabadmin.ItemsClientListItemAccessDetailsOptions) (resp azfake.Responder[fabadmin.ItemsClientListItemAccessDetailsResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, workspaceID, itemID string, _ *fabadmin.ItemsClientListItemAccessDetailsOptions) (resp azfake.Responder[fabadmin.ItemsClientListItemAccessDetailsResponse], errResp azfake.ErrorResponder) {
+		accessDetails, ok := fakeItemAccessDetailsStore[workspaceID+"/"+itemID]
+		if !ok {
+			errResp.SetError(fabfake.SetResponseError(http.StatusNotFound, fabcore.ErrItem.ItemNotFound.Error(), "Item not found"))
+			resp.SetResponse(http.StatusNotFound, fabadmin.ItemsClientListItemAccessDetailsResponse{}, nil)
+
+			return resp, errResp
+		}
+
+		resp.SetResponse(
+			http.StatusOK,
+			fabadmin.ItemsClientListItemAccessDetailsResponse{
+				ItemAccessDetailsResponse: fabadmin.ItemAccessDetailsResponse{
+					AccessDetails: accessDetails,
+				},
+			},
+			nil,
+		)
+
+		return resp, errResp
+	}
+}
+
+func fakeTestUpsert(workspaceID, itemID string, entities ...fabadmin.ItemAccessDetails) {
+	fakeItemAccessDetailsStore[workspaceID+"/"+itemID] = entities
+}
+
+func NewRandomItemAccessDetails(principal fabadmin.PrincipalClassification, permissions []fabadmin.ItemPermissions, additionalPermissions []string) fabadmin.ItemAccessDetails {
+	return fabadmin.ItemAccessDetails{
+		Principal: principal,
+		ItemAccessDetails: &fabadmin.ItemAccessDetail{
+			Type:                  new(fabadmin.ItemTypeSemanticModel),
+			Permissions:           permissions,
+			AdditionalPermissions: additionalPermissions,
+		},
+	}
+}
+
+func NewRandomUserPrincipal() *fabadmin.UserPrincipal {
+	return &fabadmin.UserPrincipal{
+		ID:          new(testhelp.RandomUUID()),
+		Type:        new(fabadmin.PrincipalTypeUser),
+		DisplayName: new(testhelp.RandomName()),
+	}
+}
+
+func NewRandomGroupPrincipal() *fabadmin.GroupPrincipal {
+	return &fabadmin.GroupPrincipal{
+		ID:          new(testhelp.RandomUUID()),
+		Type:        new(fabadmin.PrincipalTypeGroup),
+		DisplayName: new(testhelp.RandomName()),
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package itempermission
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	timeoutsR "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts" //revive:disable-line:import-alias-naming
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fabadmin "github.com/microsoft/fabric-sdk-go/fabric/admin"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/powerbi"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+/*
+DATA-SOURCE
+*/
+
+type dataSourceItemPermissionsModel struct {
+	WorkspaceID customtypes.UUID                                        `tfsdk:"workspace_id"`
+	ItemID      customtypes.UUID                                        `tfsdk:"item_id"`
+	ItemType    types.String                                            `tfsdk:"item_type"`
+	Values      supertypes.ListNestedObjectValueOf[itemPermissionModel] `tfsdk:"values"`
+	Timeouts    timeouts.Value                                          `tfsdk:"timeouts"`
+}
+
+func (to *dataSourceItemPermissionsModel) setValues(ctx context.Context, from []fabadmin.ItemAccessDetails) diag.Diagnostics {
+	entities := make([]fabadmin.ItemAccessDetails, 0, len(from))
+
+	for _, entity := range from {
+		if entity.Principal == nil {
+			continue
+		}
+
+		if principal := entity.Principal.GetPrincipal(); principal != nil && principal.ID != nil && principal.Type != nil {
+			entities = append(entities, entity)
+		}
+	}
+
+	slices.SortFunc(entities, func(a, b fabadmin.ItemAccessDetails) int {
+		pa, pb := a.Principal.GetPrincipal(), b.Principal.GetPrincipal()
+
+		return cmp.Or(
+			cmp.Compare(string(*pa.Type), string(*pb.Type)),
+			cmp.Compare(*pa.ID, *pb.ID),
+		)
+	})
+
+	slice := make([]*itemPermissionModel, 0, len(entities))
+
+	for _, entity := range entities {
+		var entityModel itemPermissionModel
+
+		if diags := entityModel.set(ctx, entity); diags.HasError() {
+			return diags
+		}
+
+		slice = append(slice, &entityModel)
+	}
+
+	return to.Values.Set(ctx, slice)
+}
+
+/*
+RESOURCE
+*/
+
+type resourceItemPermissionModel struct {
+	ID          types.String                                         `tfsdk:"id"`
+	WorkspaceID customtypes.UUID                                     `tfsdk:"workspace_id"`
+	ItemID      customtypes.UUID                                     `tfsdk:"item_id"`
+	Principal   supertypes.SingleNestedObjectValueOf[principalModel] `tfsdk:"principal"`
+	Permission  types.String                                         `tfsdk:"permission"`
+	Timeouts    timeoutsR.Value                                      `tfsdk:"timeouts"`
+}
+
+func (to *resourceItemPermissionModel) set(ctx context.Context, from powerbi.DatasetUser) diag.Diagnostics {
+	principal := &principalModel{
+		ID:   types.StringPointerValue(from.Identifier),
+		Type: types.StringValue(toFabricPrincipalType(utils.ValueOrZero(from.PrincipalType))),
+	}
+
+	// the permission is matched by the known principal identifier, which is kept as the service may return another one
+	if !to.Principal.IsNull() && !to.Principal.IsUnknown() {
+		current, diags := to.Principal.Get(ctx)
+		if diags.HasError() {
+			return diags
+		}
+
+		principal.ID = current.ID
+	}
+
+	to.Principal = supertypes.NewSingleNestedObjectValueOfNull[principalModel](ctx)
+	if diags := to.Principal.Set(ctx, principal); diags.HasError() {
+		return diags
+	}
+
+	to.ID = principal.ID
+	to.Permission = types.StringValue(string(utils.ValueOrZero(from.DatasetUserAccessRight)))
+
+	return nil
+}
+
+type requestUpdateItemPermission struct {
+	powerbi.DatasetUser
+}
+
+func (to *requestUpdateItemPermission) set(ctx context.Context, from resourceItemPermissionModel) diag.Diagnostics {
+	principal, diags := from.Principal.Get(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	to.Identifier = principal.ID.ValueStringPointer()
+	to.PrincipalType = new(toPowerBIPrincipalType(principal.Type.ValueString()))
+	to.DatasetUserAccessRight = (*powerbi.DatasetUserAccessRight)(from.Permission.ValueStringPointer())
+
+	return nil
+}
+
+/*
+HELPER MODELS
+*/
+
+type principalModel struct {
+	ID   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+
+// principalTypes returns the Fabric principal types that can be granted item permissions.
+func principalTypes() []string {
+	return []string{
+		string(fabcore.PrincipalTypeGroup),
+		string(fabcore.PrincipalTypeServicePrincipal),
+		string(fabcore.PrincipalTypeUser),
+	}
+}
+
+// toPowerBIPrincipalType converts a Fabric principal type to the Power BI REST API one, which names Service Principals "App".
+func toPowerBIPrincipalType(from string) powerbi.PrincipalType {
+	if from == string(fabcore.PrincipalTypeServicePrincipal) {
+		return powerbi.PrincipalTypeApp
+	}
+
+	return powerbi.PrincipalType(from)
+}
+
+func toFabricPrincipalType(from powerbi.PrincipalType) string {
+	if from == powerbi.PrincipalTypeApp {
+		return string(fabcore.PrincipalTypeServicePrincipal)
+	}
+
+	return string(from)
+}
+
+// findDatasetUser returns the permission of the principal, matched by identifier, object ID or email address.
+func findDatasetUser(users []powerbi.DatasetUser, principalID string) *powerbi.DatasetUser {
+	for _, user := range users {
+		for _, id := range []*string{user.Identifier, user.GraphID, user.EmailAddress} {
+			if id != nil && strings.EqualFold(*id, principalID) {
+				return &user
+			}
+		}
+	}
+
+	return nil
+}
+
+type itemPermissionModel struct {
+	Principal             supertypes.SingleNestedObjectValueOf[common.PrincipalModel] `tfsdk:"principal"`
+	DisplayName           types.String                                                `tfsdk:"display_name"`
+	Permissions           supertypes.SetValueOf[types.String]                         `tfsdk:"permissions"`
+	AdditionalPermissions supertypes.SetValueOf[types.String]                         `tfsdk:"additional_permissions"`
+}
+
+func (to *itemPermissionModel) set(ctx context.Context, from fabadmin.ItemAccessDetails) diag.Diagnostics {
+	principal := from.Principal.GetPrincipal()
+
+	principalModel := &common.PrincipalModel{
+		ID:   customtypes.NewUUIDPointerValue(principal.ID),
+		Type: types.StringPointerValue((*string)(principal.Type)),
+	}
+
+	to.Principal = supertypes.NewSingleNestedObjectValueOfNull[common.PrincipalModel](ctx)
+	if diags := to.Principal.Set(ctx, principalModel); diags.HasError() {
+		return diags
+	}
+
+	to.DisplayName = types.StringPointerValue(principal.DisplayName)
+
+	var permissions, additionalPermissions []types.String
+
+	if from.ItemAccessDetails != nil {
+		for _, permission := range from.ItemAccessDetails.Permissions {
+			permissions = append(permissions, types.StringValue(string(permission)))
+		}
+
+		for _, permission := range from.ItemAccessDetails.AdditionalPermissions {
+			additionalPermissions = append(additionalPermissions, types.StringValue(permission))
+		}
+	}
+
+	to.Permissions = supertypes.NewSetValueOfNull[types.String](ctx)
+	if diags := to.Permissions.Set(ctx, permissions); diags.HasError() {
+		return diags
+	}
+
+	to.AdditionalPermissions = supertypes.NewSetValueOfNull[types.String](ctx)
+
+	return to.AdditionalPermissions.Set(ctx, additionalPermissions)
+}
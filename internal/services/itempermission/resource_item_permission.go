@@ -0,0 +1,425 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package itempermission
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/powerbi"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.ResourceWithConfigure   = (*resourceItemPermission)(nil)
+	_ resource.ResourceWithImportState = (*resourceItemPermission)(nil)
+	_ resource.ResourceWithIdentity    = (*resourceItemPermission)(nil)
+)
+
+type resourceItemPermission struct {
+	pConfigData *pconfig.ProviderData
+	TypeInfo    tftypeinfo.TFTypeInfo
+}
+
+func NewResourceItemPermission() resource.Resource {
+	return &resourceItemPermission{
+		TypeInfo: ItemTypeInfo,
+	}
+}
+
+func (r *resourceItemPermission) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeInfo.FullTypeName(false)
+}
+
+func (r *resourceItemPermission) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = itemSchema().GetResource(ctx)
+}
+
+func (r *resourceItemPermission) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_id": identityschema.StringAttribute{
+				Description:       "The Workspace ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+			"item_id": identityschema.StringAttribute{
+				Description:       "The Semantic Model ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The Item Permission ID, the identifier of the principal.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *resourceItemPermission) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorResourceConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	r.pConfigData = pConfigData
+
+	if resp.Diagnostics.Append(fabricitem.IsPreviewMode(r.TypeInfo.Name, r.TypeInfo.IsPreview, r.pConfigData.Preview)...); resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceItemPermission) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan resourceItemPermissionModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if resp.Diagnostics.Append(r.grant(ctx, &plan, utils.OperationCreate)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
+
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceItemPermission) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "start",
+	})
+
+	var state resourceItemPermissionModel
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	found, diags := r.get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.Diagnostics.AddWarning(
+			"Resource not found",
+			fmt.Sprintf("Item Permission of principal %s on Item %s not found. It may have been revoked outside of Terraform. Removing object from state.", state.ID.ValueString(), state.ItemID.ValueString()),
+		)
+
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
+
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceItemPermission) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan resourceItemPermissionModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if resp.Diagnostics.Append(r.grant(ctx, &plan, utils.OperationUpdate)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
+
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceItemPermission) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "start",
+	})
+
+	var state resourceItemPermissionModel
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, diags := r.powerbiClient()
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	var reqUpdate requestUpdateItemPermission
+
+	if resp.Diagnostics.Append(reqUpdate.set(ctx, state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the permission is revoked by replacing it with no access
+	reqUpdate.DatasetUserAccessRight = new(powerbi.DatasetUserAccessRightNone)
+
+	err := client.UpdateDatasetUser(ctx, state.WorkspaceID.ValueString(), state.ItemID.ValueString(), reqUpdate.DatasetUser)
+	if err != nil && !powerbi.IsNotFoundError(err) {
+		resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationDelete, nil)...)
+
+		return
+	}
+
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "end",
+	})
+}
+
+func (r *resourceItemPermission) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "IMPORT", map[string]any{
+		"action": "start",
+	})
+
+	importID, diags := utils.GetImportID(ctx, req, "workspace_id", "item_id", "id")
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "IMPORT", map[string]any{
+		"id": importID,
+	})
+
+	parts := strings.SplitN(importID, "/", 3) //nolint:mnd
+	if len(parts) != 3 || parts[2] == "" {    //nolint:mnd
+		resp.Diagnostics.AddError(
+			common.ErrorImportIdentifierHeader,
+			fmt.Sprintf(common.ErrorImportIdentifierDetails, "WorkspaceID/ItemID/PrincipalID"),
+		)
+
+		return
+	}
+
+	workspaceID, diags := customtypes.NewUUIDValueMust(parts[0])
+	resp.Diagnostics.Append(diags...)
+
+	itemID, diags := customtypes.NewUUIDValueMust(parts[1])
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var timeout timeouts.Value
+	if resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := resourceItemPermissionModel{
+		ID:          types.StringValue(parts[2]),
+		WorkspaceID: workspaceID,
+		ItemID:      itemID,
+		Principal:   supertypes.NewSingleNestedObjectValueOfNull[principalModel](ctx),
+		Timeouts:    timeout,
+	}
+
+	found, diags := r.get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.Diagnostics.AddError(
+			common.ErrorImportIdentifierHeader,
+			fmt.Sprintf("Principal %s has no permission on Item %s.", parts[2], parts[1]),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
+
+	tflog.Debug(ctx, "IMPORT", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// grant adds the permission of the principal, or replaces it when the principal already has one, e.g. granted from the portal.
+func (r *resourceItemPermission) grant(ctx context.Context, model *resourceItemPermissionModel, operation utils.Operation) diag.Diagnostics {
+	client, diags := r.powerbiClient()
+	if diags.HasError() {
+		return diags
+	}
+
+	var reqUpdate requestUpdateItemPermission
+
+	if diags := reqUpdate.set(ctx, *model); diags.HasError() {
+		return diags
+	}
+
+	workspaceID := model.WorkspaceID.ValueString()
+	itemID := model.ItemID.ValueString()
+
+	users, err := client.ListDatasetUsers(ctx, workspaceID, itemID)
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationList, nil); diags.HasError() {
+		return diags
+	}
+
+	if findDatasetUser(users, *reqUpdate.Identifier) != nil {
+		err = client.UpdateDatasetUser(ctx, workspaceID, itemID, reqUpdate.DatasetUser)
+	} else {
+		err = client.AddDatasetUser(ctx, workspaceID, itemID, reqUpdate.DatasetUser)
+	}
+
+	if diags := utils.GetDiagsFromError(ctx, err, operation, nil); diags.HasError() {
+		return diags
+	}
+
+	found, diags := r.get(ctx, model)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !found {
+		diags.AddError(
+			common.ErrorPowerBIHeader,
+			fmt.Sprintf("The permission of principal %s on Item %s was not found after it was granted.", *reqUpdate.Identifier, itemID),
+		)
+	}
+
+	return diags
+}
+
+// get reads the permission of the principal and reports whether the principal has access to the item.
+func (r *resourceItemPermission) get(ctx context.Context, model *resourceItemPermissionModel) (bool, diag.Diagnostics) {
+	client, diags := r.powerbiClient()
+	if diags.HasError() {
+		return false, diags
+	}
+
+	principalID := model.ID.ValueString()
+
+	if !model.Principal.IsNull() && !model.Principal.IsUnknown() {
+		principal, diags := model.Principal.Get(ctx)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		principalID = principal.ID.ValueString()
+	}
+
+	users, err := client.ListDatasetUsers(ctx, model.WorkspaceID.ValueString(), model.ItemID.ValueString())
+	if powerbi.IsNotFoundError(err) {
+		return false, nil
+	}
+
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationRead, nil); diags.HasError() {
+		return false, diags
+	}
+
+	user := findDatasetUser(users, principalID)
+	if user == nil || utils.ValueOrZero(user.DatasetUserAccessRight) == powerbi.DatasetUserAccessRightNone {
+		return false, nil
+	}
+
+	return true, model.set(ctx, *user)
+}
+
+func (r *resourceItemPermission) powerbiClient() (*powerbi.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	client, err := powerbi.NewClient(r.pConfigData.Credential, r.pConfigData.Cloud, r.pConfigData.Version, &powerbi.ClientOptions{
+		ClientOptions: r.pConfigData.ClientOptions,
+	})
+	if err != nil {
+		diags.AddError(common.ErrorPowerBIHeader, err.Error())
+	}
+
+	return client, diags
+}
@@ -0,0 +1,212 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package itempermission
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema" //revive:disable-line:import-alias-naming
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"   //revive:disable-line:import-alias-naming
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	fabadmin "github.com/microsoft/fabric-sdk-go/fabric/admin"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/powerbi"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func itemsSchema() superschema.Schema {
+	return superschema.Schema{
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: fabricitem.NewDataSourceMarkdownDescription(ItemTypeInfo, true) +
+				"\n\nLists the principals with item-level access to a Fabric item (e.g. Semantic Model, Report, Lakehouse) and their permissions, including the permissions granted from the Fabric portal." +
+				"\n\n-> This data-source requires the Fabric administrator role, or a Service Principal with the `Tenant.Read.All` or `Tenant.ReadWrite.All` scope." +
+				"\n\n-> Use the `fabric_item_permission` resource to grant permissions on Semantic Models.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"workspace_id": superschema.SuperStringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The Workspace ID.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+				},
+			},
+			"item_id": superschema.SuperStringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The Item ID.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+				},
+			},
+			"item_type": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The Item type. Required for `Report`, `Dashboard`, `SemanticModel`, `App` and `Dataflow` items.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(utils.ConvertEnumsToStringSlices(fabadmin.PossibleItemTypeValues(), true)...),
+					},
+				},
+			},
+			"values": superschema.SuperListNestedAttributeOf[itemPermissionModel]{
+				DataSource: &schemaD.ListNestedAttribute{
+					MarkdownDescription: "The list of principals with access to the Item, sorted by principal type and ID.",
+					Computed:            true,
+				},
+				Attributes: superschema.Attributes{
+					"principal": superschema.SuperSingleNestedAttributeOf[common.PrincipalModel]{
+						DataSource: &schemaD.SingleNestedAttribute{
+							MarkdownDescription: "The principal.",
+							Computed:            true,
+						},
+						Attributes: map[string]superschema.Attribute{
+							"id": superschema.SuperStringAttribute{
+								DataSource: &schemaD.StringAttribute{
+									MarkdownDescription: "The principal ID.",
+									CustomType:          customtypes.UUIDType{},
+									Computed:            true,
+								},
+							},
+							"type": superschema.StringAttribute{
+								DataSource: &schemaD.StringAttribute{
+									MarkdownDescription: "The type of the principal.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+					"display_name": superschema.StringAttribute{
+						DataSource: &schemaD.StringAttribute{
+							MarkdownDescription: "The principal display name.",
+							Computed:            true,
+						},
+					},
+					"permissions": superschema.SuperSetAttribute{
+						DataSource: &schemaD.SetAttribute{
+							MarkdownDescription: "The item permissions of the principal. Possible values: " + utils.ConvertStringSlicesToString(fabadmin.PossibleItemPermissionsValues(), true, true) + ".",
+							CustomType: supertypes.SetTypeOf[types.String]{
+								SetType: basetypes.SetType{
+									ElemType: types.StringType,
+								},
+							},
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+					"additional_permissions": superschema.SuperSetAttribute{
+						DataSource: &schemaD.SetAttribute{
+							MarkdownDescription: "The workload permissions of the principal, such as `ReadAll`, `Build` or `ViewOutput`.",
+							CustomType: supertypes.SetTypeOf[types.String]{
+								SetType: basetypes.SetType{
+									ElemType: types.StringType,
+								},
+							},
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"timeouts": superschema.TimeoutAttribute{
+				DataSource: &superschema.DatasourceTimeoutAttribute{
+					Read: true,
+				},
+			},
+		},
+	}
+}
+
+func itemSchema() superschema.Schema {
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: fabricitem.NewResourceMarkdownDescription(ItemTypeInfo, false) +
+				"\n\nGrants a principal item-level permissions on a Semantic Model, without access to the whole workspace. " +
+				"Permissions granted from the Fabric portal are detected on read." +
+				"\n\n~> Permissions are managed through the Power BI REST API, which only supports Semantic Models. " +
+				"The Fabric public API does not support granting item-level permissions on other items, such as Reports or Lakehouses. " +
+				"Only the `Read`, `Reshare` and `Build` permissions can be granted, `ReadAll` and `Execute` are not supported.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The Item Permission ID, the identifier of the principal.",
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"workspace_id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The Workspace ID.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"item_id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The Semantic Model ID.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"principal": superschema.SuperSingleNestedAttributeOf[principalModel]{
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The principal.",
+					Required:            true,
+					PlanModifiers: []planmodifier.Object{
+						objectplanmodifier.RequiresReplace(),
+					},
+				},
+				Attributes: map[string]superschema.Attribute{
+					"id": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The principal identifier: the user principal name (UPN) of a `User`, the object ID of a `Group` or `ServicePrincipal`.",
+							Required:            true,
+						},
+					},
+					"type": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The type of the principal.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(principalTypes()...),
+							},
+						},
+					},
+				},
+			},
+			"permission": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The permission of the principal on the Semantic Model. `Read` is always granted, `Reshare` adds the Reshare permission and `Explore` adds the Build permission.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(utils.ConvertEnumsToStringSlices(powerbi.PossibleDatasetUserAccessRightValues(), true)...),
+					},
+				},
+			},
+			"timeouts": superschema.TimeoutAttribute{
+				Resource: &superschema.ResourceTimeoutAttribute{
+					Create: true,
+					Read:   true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		},
+	}
+}
@@ -4,10 +4,14 @@
 package lakehousetable_test
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	azto "github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	fabfake "github.com/microsoft/fabric-sdk-go/fabric/fake"
 	fablakehouse "github.com/microsoft/fabric-sdk-go/fabric/lakehouse"
 
 	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
@@ -52,3 +56,64 @@ func NewRandomLakehouseTables(lakehouseID string) fablakehouse.Tables {
 		},
 	}
 }
+
+// Returns a fake function that fails to load a table with the provided error.
+func fakeLoadTableErrorFunc(
+	errorCode, message string,
+) func(ctx context.Context, workspaceID, lakehouseID, tableName string, loadTableRequest fablakehouse.LoadTableRequest, options *fablakehouse.TablesClientBeginLoadTableOptions) (resp azfake.PollerResponder[fablakehouse.TablesClientLoadTableResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _, _, _ string, _ fablakehouse.LoadTableRequest, _ *fablakehouse.TablesClientBeginLoadTableOptions) (resp azfake.PollerResponder[fablakehouse.TablesClientLoadTableResponse], errResp azfake.ErrorResponder) {
+		errResp.SetError(fabfake.SetResponseError(http.StatusBadRequest, errorCode, message))
+
+		return resp, errResp
+	}
+}
+
+// Returns a fake function that starts a table maintenance job instance, returning its location, and records the received request.
+func fakeRunOnDemandTableMaintenanceFunc(
+	jobInstanceID string,
+	received *fablakehouse.RunOnDemandTableMaintenanceRequest,
+) func(ctx context.Context, workspaceID, lakehouseID string, runOnDemandTableMaintenanceRequest fablakehouse.RunOnDemandTableMaintenanceRequest, options *fablakehouse.BackgroundJobsClientRunOnDemandTableMaintenanceOptions) (resp azfake.Responder[fablakehouse.BackgroundJobsClientRunOnDemandTableMaintenanceResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, workspaceID, lakehouseID string, runOnDemandTableMaintenanceRequest fablakehouse.RunOnDemandTableMaintenanceRequest, _ *fablakehouse.BackgroundJobsClientRunOnDemandTableMaintenanceOptions) (resp azfake.Responder[fablakehouse.BackgroundJobsClientRunOnDemandTableMaintenanceResponse], errResp azfake.ErrorResponder) {
+		*received = runOnDemandTableMaintenanceRequest
+
+		header := http.Header{}
+		header.Set("Location", "https://api.fabric.microsoft.com/v1/workspaces/"+workspaceID+"/lakehouses/"+lakehouseID+"/jobs/instances/"+jobInstanceID)
+
+		resp = azfake.Responder[fablakehouse.BackgroundJobsClientRunOnDemandTableMaintenanceResponse]{}
+		resp.SetResponse(http.StatusAccepted, fablakehouse.BackgroundJobsClientRunOnDemandTableMaintenanceResponse{}, &azfake.SetResponseOptions{Header: header})
+
+		return resp, errResp
+	}
+}
+
+// Returns a fake function that simulates getting a table maintenance job instance with the provided status.
+func fakeGetTableMaintenanceJobInstanceFunc(
+	status fabcore.ItemJobStatus,
+) func(ctx context.Context, workspaceID, itemID, jobInstanceID string, options *fabcore.JobSchedulerClientGetItemJobInstanceOptions) (resp azfake.Responder[fabcore.JobSchedulerClientGetItemJobInstanceResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _, itemID, jobInstanceID string, _ *fabcore.JobSchedulerClientGetItemJobInstanceOptions) (resp azfake.Responder[fabcore.JobSchedulerClientGetItemJobInstanceResponse], errResp azfake.ErrorResponder) {
+		now := time.Now().UTC()
+
+		entity := fabcore.ItemJobInstance{
+			ID:             new(jobInstanceID),
+			ItemID:         new(itemID),
+			JobType:        new("TableMaintenance"),
+			InvokeType:     azto.Ptr(fabcore.InvokeTypeManual),
+			Status:         azto.Ptr(status),
+			RootActivityID: new(testhelp.RandomUUID()),
+			StartTimeUTC:   new(now.Format(time.RFC3339)),
+			EndTimeUTC:     new(now.Add(time.Minute).Format(time.RFC3339)),
+		}
+
+		if status == fabcore.ItemJobStatusFailed {
+			entity.FailureReason = &fabcore.ErrorResponse{
+				ErrorCode: new("JobInstanceStatusFailed"),
+				Message:   new("The table maintenance failed"),
+			}
+		}
+
+		resp = azfake.Responder[fabcore.JobSchedulerClientGetItemJobInstanceResponse]{}
+		resp.SetResponse(http.StatusOK, fabcore.JobSchedulerClientGetItemJobInstanceResponse{ItemJobInstance: entity}, nil)
+
+		return resp, errResp
+	}
+}
@@ -6,8 +6,9 @@ package lakehousetable
 import (
 	"context"
 
+	azto "github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	timeoutsD "github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts" //revive:disable-line:import-alias-naming
-	//revive:disable-line:import-alias-naming
+	timeoutsR "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"   //revive:disable-line:import-alias-naming
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	fablakehouse "github.com/microsoft/fabric-sdk-go/fabric/lakehouse"
@@ -71,3 +72,117 @@ func (to *dataSourceLakehouseTablesModel) setValues(ctx context.Context, workspa
 
 	return to.Values.Set(ctx, slice)
 }
+
+/*
+RESOURCE
+*/
+
+type resourceLakehouseTableModel struct {
+	baseLakehouseTableModel
+
+	Load        supertypes.SingleNestedObjectValueOf[loadModel]        `tfsdk:"load"`
+	Maintenance supertypes.SingleNestedObjectValueOf[maintenanceModel] `tfsdk:"maintenance"`
+	Triggers    supertypes.MapValueOf[types.String]                    `tfsdk:"triggers"`
+	Timeouts    timeoutsR.Value                                        `tfsdk:"timeouts"`
+}
+
+type loadModel struct {
+	RelativePath  types.String `tfsdk:"relative_path"`
+	PathType      types.String `tfsdk:"path_type"`
+	Format        types.String `tfsdk:"format"`
+	Mode          types.String `tfsdk:"mode"`
+	FileExtension types.String `tfsdk:"file_extension"`
+	Recursive     types.Bool   `tfsdk:"recursive"`
+	Header        types.Bool   `tfsdk:"header"`
+	Delimiter     types.String `tfsdk:"delimiter"`
+}
+
+type maintenanceModel struct {
+	Optimize             supertypes.SingleNestedObjectValueOf[optimizeModel] `tfsdk:"optimize"`
+	Vacuum               supertypes.SingleNestedObjectValueOf[vacuumModel]   `tfsdk:"vacuum"`
+	PurgeDeletionVectors types.Bool                                          `tfsdk:"purge_deletion_vectors"`
+}
+
+type optimizeModel struct {
+	VOrder   types.Bool                           `tfsdk:"v_order"`
+	ZOrderBy supertypes.ListValueOf[types.String] `tfsdk:"z_order_by"`
+}
+
+type vacuumModel struct {
+	RetentionPeriod types.String `tfsdk:"retention_period"`
+}
+
+type requestLoadTable struct {
+	fablakehouse.LoadTableRequest
+}
+
+func (to *requestLoadTable) set(from loadModel) {
+	to.RelativePath = from.RelativePath.ValueStringPointer()
+	to.PathType = azto.Ptr(fablakehouse.PathType(from.PathType.ValueString()))
+	to.Mode = azto.Ptr(fablakehouse.ModeType(from.Mode.ValueString()))
+	to.FileExtension = from.FileExtension.ValueStringPointer()
+	to.Recursive = from.Recursive.ValueBoolPointer()
+
+	format := fablakehouse.FileFormat(from.Format.ValueString())
+
+	switch format {
+	case fablakehouse.FileFormatCSV:
+		to.FormatOptions = &fablakehouse.CSV{
+			Format:    azto.Ptr(format),
+			Header:    from.Header.ValueBoolPointer(),
+			Delimiter: from.Delimiter.ValueStringPointer(),
+		}
+	case fablakehouse.FileFormatParquet:
+		to.FormatOptions = &fablakehouse.Parquet{
+			Format: azto.Ptr(format),
+		}
+	}
+}
+
+type requestRunOnDemandTableMaintenance struct {
+	fablakehouse.RunOnDemandTableMaintenanceRequest
+}
+
+func (to *requestRunOnDemandTableMaintenance) set(ctx context.Context, tableName string, from maintenanceModel) diag.Diagnostics {
+	executionData := &fablakehouse.TableMaintenanceExecutionData{
+		TableName:            &tableName,
+		PurgeDeletionVectors: from.PurgeDeletionVectors.ValueBoolPointer(),
+	}
+
+	if !from.Optimize.IsNull() && !from.Optimize.IsUnknown() {
+		optimize, diags := from.Optimize.Get(ctx)
+		if diags.HasError() {
+			return diags
+		}
+
+		executionData.OptimizeSettings = &fablakehouse.OptimizeSettings{
+			VOrder: optimize.VOrder.ValueBoolPointer(),
+		}
+
+		if !optimize.ZOrderBy.IsNull() && !optimize.ZOrderBy.IsUnknown() {
+			columns, diags := optimize.ZOrderBy.Get(ctx)
+			if diags.HasError() {
+				return diags
+			}
+
+			for _, column := range columns {
+				executionData.OptimizeSettings.ZOrderBy = append(executionData.OptimizeSettings.ZOrderBy, column.ValueString())
+			}
+		}
+	}
+
+	if !from.Vacuum.IsNull() && !from.Vacuum.IsUnknown() {
+		vacuum, diags := from.Vacuum.Get(ctx)
+		if diags.HasError() {
+			return diags
+		}
+
+		executionData.VacuumSettings = &fablakehouse.VacuumSettings{
+			RetentionPeriod: vacuum.RetentionPeriod.ValueStringPointer(),
+		}
+	}
+
+	to.ExecutionData = executionData
+
+	return nil
+}
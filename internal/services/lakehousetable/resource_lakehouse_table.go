@@ -0,0 +1,410 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lakehousetable
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	fablakehouse "github.com/microsoft/fabric-sdk-go/fabric/lakehouse"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.ResourceWithConfigure      = (*resourceLakehouseTable)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceLakehouseTable)(nil)
+)
+
+type resourceLakehouseTable struct {
+	pConfigData        *pconfig.ProviderData
+	client             *fablakehouse.TablesClient
+	backgroundJobs     *fablakehouse.BackgroundJobsClient
+	jobSchedulerClient *fabcore.JobSchedulerClient
+	TypeInfo           tftypeinfo.TFTypeInfo
+}
+
+func NewResourceLakehouseTable() resource.Resource {
+	return &resourceLakehouseTable{
+		TypeInfo: ItemTypeInfo,
+	}
+}
+
+func (r *resourceLakehouseTable) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeInfo.FullTypeName(false)
+}
+
+func (r *resourceLakehouseTable) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = itemSchema(false).GetResource(ctx)
+}
+
+func (r *resourceLakehouseTable) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config resourceLakehouseTableModel
+
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &config)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Load.IsNull() || config.Load.IsUnknown() {
+		return
+	}
+
+	load, diags := config.Load.Get(ctx)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !load.PathType.IsUnknown() && load.PathType.ValueString() != string(fablakehouse.PathTypeFolder) {
+		for name, value := range map[string]bool{
+			"file_extension": !load.FileExtension.IsNull(),
+			"recursive":      !load.Recursive.IsNull(),
+		} {
+			if value {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("load").AtName(name),
+					common.ErrorInvalidConfig,
+					fmt.Sprintf("The '%s' argument can only be set when 'path_type' is '%s'.", name, fablakehouse.PathTypeFolder),
+				)
+			}
+		}
+	}
+
+	if !load.Format.IsUnknown() && load.Format.ValueString() != string(fablakehouse.FileFormatCSV) {
+		for name, value := range map[string]bool{
+			"header":    !load.Header.IsNull(),
+			"delimiter": !load.Delimiter.IsNull(),
+		} {
+			if value {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("load").AtName(name),
+					common.ErrorInvalidConfig,
+					fmt.Sprintf("The '%s' argument can only be set when 'format' is '%s'.", name, fablakehouse.FileFormatCSV),
+				)
+			}
+		}
+	}
+}
+
+func (r *resourceLakehouseTable) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorResourceConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	r.pConfigData = pConfigData
+
+	if resp.Diagnostics.Append(fabricitem.IsPreviewMode(r.TypeInfo.Name, r.TypeInfo.IsPreview, r.pConfigData.Preview)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientFactory := fablakehouse.NewClientFactoryWithClient(*pConfigData.FabricClient)
+
+	r.client = clientFactory.NewTablesClient()
+	r.backgroundJobs = clientFactory.NewBackgroundJobsClient()
+	r.jobSchedulerClient = fabcore.NewClientFactoryWithClient(*pConfigData.FabricClient).NewJobSchedulerClient()
+}
+
+func (r *resourceLakehouseTable) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan resourceLakehouseTableModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, true, true, utils.OperationCreate)...)
+
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceLakehouseTable) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "start",
+	})
+
+	var state resourceLakehouseTableModel
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	found, diags := r.get(ctx, &state.baseLakehouseTableModel)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		tflog.Info(ctx, "Table not found, removing from state", map[string]any{
+			"name": state.Name.ValueString(),
+		})
+
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceLakehouseTable) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan, state resourceLakehouseTableModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	triggered := !plan.Triggers.Equal(state.Triggers)
+	load := triggered || !plan.Load.Equal(state.Load)
+	maintenance := triggered || !plan.Maintenance.Equal(state.Maintenance)
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, load, maintenance, utils.OperationUpdate)...)
+
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceLakehouseTable) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "start",
+	})
+
+	// tables cannot be dropped through the API, the resource is only removed from the state
+
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "end",
+	})
+}
+
+// apply loads the table and runs the table maintenance job, when requested and declared, then refreshes the table.
+func (r *resourceLakehouseTable) apply(ctx context.Context, model *resourceLakehouseTableModel, load, maintenance bool, operation utils.Operation) diag.Diagnostics { //revive:disable-line:flag-parameter
+	if load && !model.Load.IsNull() {
+		if diags := r.load(ctx, *model, operation); diags.HasError() {
+			return diags
+		}
+	}
+
+	if maintenance && !model.Maintenance.IsNull() {
+		if diags := r.runMaintenance(ctx, *model, operation); diags.HasError() {
+			return diags
+		}
+	}
+
+	found, diags := r.get(ctx, &model.baseLakehouseTableModel)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !found {
+		diags.AddError(
+			common.ErrorReadHeader,
+			"Unable to find Table with 'name': "+model.Name.ValueString(),
+		)
+	}
+
+	return diags
+}
+
+func (r *resourceLakehouseTable) load(ctx context.Context, model resourceLakehouseTableModel, operation utils.Operation) diag.Diagnostics {
+	load, diags := model.Load.Get(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	var reqLoad requestLoadTable
+
+	reqLoad.set(*load)
+
+	tflog.Info(ctx, "Loading table", map[string]any{
+		"name":          model.Name.ValueString(),
+		"relative_path": load.RelativePath.ValueString(),
+		"mode":          load.Mode.ValueString(),
+	})
+
+	_, err := r.client.LoadTable(
+		ctx,
+		model.WorkspaceID.ValueString(),
+		model.LakehouseID.ValueString(),
+		model.Name.ValueString(),
+		reqLoad.LoadTableRequest,
+		nil,
+	)
+
+	return utils.GetDiagsFromError(ctx, err, operation, nil)
+}
+
+func (r *resourceLakehouseTable) runMaintenance(ctx context.Context, model resourceLakehouseTableModel, operation utils.Operation) diag.Diagnostics {
+	maintenance, diags := model.Maintenance.Get(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	var reqRun requestRunOnDemandTableMaintenance
+
+	if diags := reqRun.set(ctx, model.Name.ValueString(), *maintenance); diags.HasError() {
+		return diags
+	}
+
+	// the job instance ID is only returned in the Location header of the response
+	var respRaw *http.Response
+
+	_, err := r.backgroundJobs.RunOnDemandTableMaintenance(
+		runtime.WithCaptureResponse(ctx, &respRaw),
+		model.WorkspaceID.ValueString(),
+		model.LakehouseID.ValueString(),
+		reqRun.RunOnDemandTableMaintenanceRequest,
+		nil,
+	)
+	if diags := utils.GetDiagsFromError(ctx, err, operation, nil); diags.HasError() {
+		return diags
+	}
+
+	jobInstanceID := utils.GetItemJobInstanceID(respRaw)
+	if jobInstanceID == "" {
+		diags.AddError(
+			common.ErrorItemJobHeader,
+			"The table maintenance job instance ID was not returned by the service.",
+		)
+
+		return diags
+	}
+
+	jobInstance, err := utils.WaitForItemJobInstance(ctx, r.jobSchedulerClient, model.WorkspaceID.ValueString(), model.LakehouseID.ValueString(), jobInstanceID)
+	if diags := utils.GetDiagsFromError(ctx, err, operation, nil); diags.HasError() {
+		diags.Append(r.cancelMaintenance(ctx, model, jobInstanceID)...)
+
+		return diags
+	}
+
+	if *jobInstance.Status != fabcore.ItemJobStatusCompleted {
+		diags.AddError(
+			common.ErrorItemJobHeader,
+			fmt.Sprintf(common.ErrorItemJobDetails, jobInstanceID, *jobInstance.Status, utils.GetItemJobFailureReason(jobInstance)),
+		)
+	}
+
+	return diags
+}
+
+// cancelMaintenance cancels a table maintenance job instance that did not finish in time, so that it does not keep running untracked.
+// The cancellation is not bound to ctx, which is already done when the wait times out.
+func (r *resourceLakehouseTable) cancelMaintenance(ctx context.Context, model resourceLakehouseTableModel, jobInstanceID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), utils.PollInterval)
+	defer cancel()
+
+	tflog.Info(ctx, "Cancelling table maintenance", map[string]any{
+		"id": jobInstanceID,
+	})
+
+	_, err := r.jobSchedulerClient.CancelItemJobInstance(ctx, model.WorkspaceID.ValueString(), model.LakehouseID.ValueString(), jobInstanceID, nil)
+	if err != nil {
+		diags.AddWarning(
+			common.WarningItemJobNotCancelledHeader,
+			fmt.Sprintf(common.WarningItemJobNotCancelledDetails, jobInstanceID, err.Error()),
+		)
+	}
+
+	return diags
+}
+
+// get refreshes the table from the tables of the Lakehouse and reports whether it exists.
+func (r *resourceLakehouseTable) get(ctx context.Context, model *baseLakehouseTableModel) (bool, diag.Diagnostics) {
+	pager := r.client.NewListTablesPager(model.WorkspaceID.ValueString(), model.LakehouseID.ValueString(), nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if diags := utils.GetDiagsFromError(ctx, err, utils.OperationList, nil); diags.HasError() {
+			return false, diags
+		}
+
+		for _, entity := range page.Data {
+			if *entity.Name == model.Name.ValueString() {
+				model.set(model.WorkspaceID.ValueString(), model.LakehouseID.ValueString(), entity)
+
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
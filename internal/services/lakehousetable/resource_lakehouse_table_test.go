@@ -0,0 +1,387 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lakehousetable_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	fablakehouse "github.com/microsoft/fabric-sdk-go/fabric/lakehouse"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testResourceItemFQN, testResourceItemHeader = testhelp.TFResource(common.ProviderTypeName, itemTypeInfo.Type, "test")
+
+func TestUnit_LakehouseTableResource_Attributes(t *testing.T) {
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no required attributes - workspace_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"lakehouse_id": "00000000-0000-0000-0000-000000000000",
+					"name":         "sales",
+				},
+			),
+			ExpectError: regexp.MustCompile(`The argument "workspace_id" is required, but no definition was found.`),
+		},
+		// error - no required attributes - name
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"lakehouse_id": "11111111-1111-1111-1111-111111111111",
+				},
+			),
+			ExpectError: regexp.MustCompile(`The argument "name" is required, but no definition was found.`),
+		},
+		// error - invalid UUID - lakehouse_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"lakehouse_id": "invalid uuid",
+					"name":         "sales",
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - invalid name
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"lakehouse_id": "11111111-1111-1111-1111-111111111111",
+					"name":         "sales-2026",
+				},
+			),
+			ExpectError: regexp.MustCompile(`must contain only alphanumeric characters and underscores`),
+		},
+		// error - load outside of the Files area
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"lakehouse_id": "11111111-1111-1111-1111-111111111111",
+					"name":         "sales",
+					"load": map[string]any{
+						"relative_path": "Tables/sales",
+						"path_type":     string(fablakehouse.PathTypeFolder),
+						"format":        string(fablakehouse.FileFormatParquet),
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(`must be a path in the Files area of the Lakehouse`),
+		},
+		// error - CSV options with Parquet files
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"lakehouse_id": "11111111-1111-1111-1111-111111111111",
+					"name":         "sales",
+					"load": map[string]any{
+						"relative_path": "Files/sales",
+						"path_type":     string(fablakehouse.PathTypeFolder),
+						"format":        string(fablakehouse.FileFormatParquet),
+						"delimiter":     ";",
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(`The 'delimiter' argument can only be set when 'format' is 'Csv'.`),
+		},
+		// error - folder options with a file
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"lakehouse_id": "11111111-1111-1111-1111-111111111111",
+					"name":         "sales",
+					"load": map[string]any{
+						"relative_path": "Files/sales.csv",
+						"path_type":     string(fablakehouse.PathTypeFile),
+						"format":        string(fablakehouse.FileFormatCSV),
+						"recursive":     true,
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(`The 'recursive' argument can only be set when 'path_type' is 'Folder'.`),
+		},
+		// error - empty maintenance
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"lakehouse_id": "11111111-1111-1111-1111-111111111111",
+					"name":         "sales",
+					"maintenance":  map[string]any{},
+				},
+			),
+			ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+		},
+		// error - invalid retention period
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"lakehouse_id": "11111111-1111-1111-1111-111111111111",
+					"name":         "sales",
+					"maintenance": map[string]any{
+						"vacuum": map[string]any{
+							"retention_period": "7 days",
+						},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(`must follow the .d:hh:mm:ss. format`),
+		},
+	}))
+}
+
+func TestUnit_LakehouseTableResource_CRUD(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	lakehouseID := testhelp.RandomUUID()
+	lakehouseTables := NewRandomLakehouseTables(lakehouseID)
+	entity := lakehouseTables.Data[1]
+
+	var receivedMaintenance fablakehouse.RunOnDemandTableMaintenanceRequest
+
+	fakes.FakeServer.ServerFactory.Lakehouse.TablesServer.NewListTablesPager = fakeLakehouseTablesFunc(lakehouseTables)
+	fakes.FakeServer.ServerFactory.Lakehouse.BackgroundJobsServer.RunOnDemandTableMaintenance = fakeRunOnDemandTableMaintenanceFunc(testhelp.RandomUUID(), &receivedMaintenance)
+	fakes.FakeServer.ServerFactory.Core.JobSchedulerServer.GetItemJobInstance = fakeGetTableMaintenanceJobInstanceFunc(fabcore.ItemJobStatusCompleted)
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - table not found
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+					"lakehouse_id": lakehouseID,
+					"name":         testhelp.RandomName(),
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorReadHeader),
+		},
+		// create - optimize
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+					"lakehouse_id": lakehouseID,
+					"name":         *entity.Name,
+					"maintenance": map[string]any{
+						"optimize": map[string]any{
+							"v_order":    true,
+							"z_order_by": []string{"region", "date"},
+						},
+					},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrPtr(testResourceItemFQN, "name", entity.Name),
+				resource.TestCheckResourceAttrPtr(testResourceItemFQN, "location", entity.Location),
+				resource.TestCheckResourceAttrPtr(testResourceItemFQN, "type", (*string)(entity.Type)),
+				resource.TestCheckResourceAttrPtr(testResourceItemFQN, "format", entity.Format),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "maintenance.optimize.z_order_by.#", "2"),
+				func(_ *terraform.State) error {
+					executionData := receivedMaintenance.ExecutionData
+					if executionData == nil || *executionData.TableName != *entity.Name {
+						return errors.New("expected the table maintenance to run on the table")
+					}
+
+					if !*executionData.OptimizeSettings.VOrder || len(executionData.OptimizeSettings.ZOrderBy) != 2 || executionData.VacuumSettings != nil {
+						return errors.New("expected only the optimize settings to be sent")
+					}
+
+					return nil
+				},
+			),
+		},
+		// update - vacuum
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+					"lakehouse_id": lakehouseID,
+					"name":         *entity.Name,
+					"maintenance": map[string]any{
+						"vacuum": map[string]any{
+							"retention_period": "7:01:00:00",
+						},
+					},
+					"triggers": map[string]any{
+						"run": "1",
+					},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "maintenance.vacuum.retention_period", "7:01:00:00"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "triggers.run", "1"),
+				func(_ *terraform.State) error {
+					executionData := receivedMaintenance.ExecutionData
+					if executionData.OptimizeSettings != nil || *executionData.VacuumSettings.RetentionPeriod != "7:01:00:00" {
+						return errors.New("expected only the vacuum settings to be sent")
+					}
+
+					return nil
+				},
+			),
+		},
+	}))
+}
+
+func TestUnit_LakehouseTableResource_LoadFailed(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	lakehouseID := testhelp.RandomUUID()
+	lakehouseTables := NewRandomLakehouseTables(lakehouseID)
+	entity := lakehouseTables.Data[2]
+
+	fakes.FakeServer.ServerFactory.Lakehouse.TablesServer.NewListTablesPager = fakeLakehouseTablesFunc(lakehouseTables)
+	fakes.FakeServer.ServerFactory.Lakehouse.TablesServer.BeginLoadTable = fakeLoadTableErrorFunc("PathNotFound", "The path Files/sales was not found")
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - load failed
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+					"lakehouse_id": lakehouseID,
+					"name":         *entity.Name,
+					"load": map[string]any{
+						"relative_path":  "Files/sales",
+						"path_type":      string(fablakehouse.PathTypeFolder),
+						"format":         string(fablakehouse.FileFormatCSV),
+						"file_extension": "csv",
+						"header":         true,
+						"delimiter":      ";",
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(`PathNotFound`),
+		},
+	}))
+}
+
+func TestUnit_LakehouseTableResource_MaintenanceFailed(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	lakehouseID := testhelp.RandomUUID()
+	lakehouseTables := NewRandomLakehouseTables(lakehouseID)
+	entity := lakehouseTables.Data[0]
+
+	var receivedMaintenance fablakehouse.RunOnDemandTableMaintenanceRequest
+
+	fakes.FakeServer.ServerFactory.Lakehouse.TablesServer.NewListTablesPager = fakeLakehouseTablesFunc(lakehouseTables)
+	fakes.FakeServer.ServerFactory.Lakehouse.BackgroundJobsServer.RunOnDemandTableMaintenance = fakeRunOnDemandTableMaintenanceFunc(testhelp.RandomUUID(), &receivedMaintenance)
+	fakes.FakeServer.ServerFactory.Core.JobSchedulerServer.GetItemJobInstance = fakeGetTableMaintenanceJobInstanceFunc(fabcore.ItemJobStatusFailed)
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - table maintenance failed
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+					"lakehouse_id": lakehouseID,
+					"name":         *entity.Name,
+					"maintenance": map[string]any{
+						"purge_deletion_vectors": true,
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(`finished with status 'Failed': JobInstanceStatusFailed: The table maintenance failed`),
+		},
+	}))
+}
+
+func TestAcc_LakehouseTableResource_CRUD(t *testing.T) {
+	workspace := testhelp.WellKnown()["WorkspaceDS"].(map[string]any)
+	workspaceID := workspace["id"].(string)
+
+	entity := testhelp.WellKnown()["Lakehouse"].(map[string]any)
+	entityID := entity["id"].(string)
+	entityTableName := entity["tableName"].(string)
+
+	resource.Test(t, testhelp.NewTestAccCase(t, &testResourceItemFQN, nil, []resource.TestStep{
+		// create - table maintenance
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+					"lakehouse_id": entityID,
+					"name":         entityTableName,
+					"maintenance": map[string]any{
+						"optimize": map[string]any{
+							"v_order": true,
+						},
+					},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "name", entityTableName),
+				resource.TestCheckResourceAttrSet(testResourceItemFQN, "location"),
+			),
+		},
+		// update - triggers
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+					"lakehouse_id": entityID,
+					"name":         entityTableName,
+					"maintenance": map[string]any{
+						"optimize": map[string]any{
+							"v_order": true,
+						},
+					},
+					"triggers": map[string]any{
+						"run": testhelp.RandomName(),
+					},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "name", entityTableName),
+				resource.TestCheckResourceAttrSet(testResourceItemFQN, "triggers.run"),
+			),
+		},
+	}))
+}
@@ -4,11 +4,24 @@
 package lakehousetable
 
 import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema" //revive:disable-line:import-alias-naming
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema" //revive:disable-line:import-alias-naming
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	fablakehouse "github.com/microsoft/fabric-sdk-go/fabric/lakehouse"
 	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 
 	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
 	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
@@ -24,58 +37,284 @@ func itemSchema(isList bool) superschema.Schema { //revive:disable-line:flag-par
 		}
 	}
 
+	markdownDescriptionR := fabricitem.NewResourceMarkdownDescription(ItemTypeInfo, false) +
+		"\n\n-> The table is loaded from files in the `Files` area of the Lakehouse when `load` is declared, " +
+		"and the table maintenance job runs when `maintenance` is declared. " +
+		"Both run again when their arguments or `triggers` change. " +
+		"Table maintenance only runs on demand during apply, recurring maintenance schedules are not managed by this resource. " +
+		"Destroying the resource does not drop the table, it is only removed from the state."
+
 	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: markdownDescriptionR,
+		},
 		DataSource: superschema.SchemaDetails{
 			MarkdownDescription: fabricitem.NewDataSourceMarkdownDescription(ItemTypeInfo, isList),
 		},
 		Attributes: map[string]superschema.Attribute{
 			"lakehouse_id": superschema.SuperStringAttribute{
-				DataSource: &schemaD.StringAttribute{
+				Common: &schemaR.StringAttribute{
 					MarkdownDescription: "The Lakehouse ID.",
 					CustomType:          customtypes.UUIDType{},
-					Required:            !isList,
-					Computed:            isList,
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Required: !isList,
+					Computed: isList,
 				},
 			},
 			"workspace_id": superschema.SuperStringAttribute{
-				DataSource: &schemaD.StringAttribute{
+				Common: &schemaR.StringAttribute{
 					MarkdownDescription: "The Workspace ID.",
 					CustomType:          customtypes.UUIDType{},
-					Required:            !isList,
-					Computed:            isList,
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Required: !isList,
+					Computed: isList,
 				},
 			},
 			"name": superschema.StringAttribute{
-				DataSource: &schemaD.StringAttribute{
+				Common: &schemaR.StringAttribute{
 					MarkdownDescription: "The Name of the table.",
-					Required:            !isList,
-					Computed:            isList,
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(
+							regexp.MustCompile(`^[a-zA-Z0-9_]{1,256}$`),
+							"must contain only alphanumeric characters and underscores, with a maximum length of 256 characters",
+						),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Required: !isList,
+					Computed: isList,
 				},
 			},
 			"location": superschema.StringAttribute{
-				DataSource: &schemaD.StringAttribute{
+				Common: &schemaR.StringAttribute{
 					MarkdownDescription: "The Location of the table.",
 					Computed:            true,
 				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{},
 			},
 			"type": superschema.StringAttribute{
-				DataSource: &schemaD.StringAttribute{
+				Common: &schemaR.StringAttribute{
 					MarkdownDescription: "The Type of the table.",
 					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{},
+				DataSource: &schemaD.StringAttribute{
 					Validators: []validator.String{
 						stringvalidator.OneOf(utils.ConvertEnumsToStringSlices(fablakehouse.PossibleTableTypeValues(), true)...),
 					},
 				},
 			},
 			"format": superschema.StringAttribute{
-				DataSource: &schemaD.StringAttribute{
+				Common: &schemaR.StringAttribute{
 					MarkdownDescription: "The Format of the table.",
 					Computed:            true,
 				},
+				Resource:   &schemaR.StringAttribute{},
+				DataSource: &schemaD.StringAttribute{},
+			},
+			"load": superschema.SuperSingleNestedAttributeOf[loadModel]{
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "Loads the table from files in the `Files` area of the Lakehouse. The table is created if it does not exist.",
+					Optional:            true,
+				},
+				Attributes: loadAttributes(),
+			},
+			"maintenance": superschema.SuperSingleNestedAttributeOf[maintenanceModel]{
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "Runs the [table maintenance](https://learn.microsoft.com/fabric/data-engineering/lakehouse-table-maintenance) job on the table, after the load if any.",
+					Optional:            true,
+					Validators: []validator.Object{
+						objectvalidator.AtLeastOneOf(
+							path.MatchRelative().AtName("optimize"),
+							path.MatchRelative().AtName("vacuum"),
+							path.MatchRelative().AtName("purge_deletion_vectors"),
+						),
+					},
+				},
+				Attributes: maintenanceAttributes(),
+			},
+			"triggers": superschema.SuperMapAttribute{
+				Resource: &schemaR.MapAttribute{
+					MarkdownDescription: "A map of arbitrary values that, when changed, run the load and the table maintenance again.",
+					CustomType:          supertypes.MapTypeOf[types.String]{MapType: types.MapType{ElemType: types.StringType}},
+					ElementType:         types.StringType,
+					Optional:            true,
+					Validators: []validator.Map{
+						mapvalidator.NoNullValues(),
+					},
+				},
 			},
 			"timeouts": superschema.TimeoutAttribute{
+				Resource: &superschema.ResourceTimeoutAttribute{
+					Create: true,
+					Read:   true,
+					Update: true,
+					Delete: true,
+				},
 				DataSource: dsTimeout,
 			},
 		},
 	}
 }
+
+func loadAttributes() map[string]superschema.Attribute {
+	return map[string]superschema.Attribute{
+		"relative_path": superschema.StringAttribute{
+			Resource: &schemaR.StringAttribute{
+				MarkdownDescription: "The relative path of the file or folder to load, for example `Files/sales/2026.csv`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^Files/.+$`),
+						"must be a path in the Files area of the Lakehouse, starting with 'Files/'",
+					),
+				},
+			},
+		},
+		"path_type": superschema.StringAttribute{
+			Resource: &schemaR.StringAttribute{
+				MarkdownDescription: "The type of the relative path.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.ConvertEnumsToStringSlices(fablakehouse.PossiblePathTypeValues(), true)...),
+				},
+			},
+		},
+		"format": superschema.StringAttribute{
+			Resource: &schemaR.StringAttribute{
+				MarkdownDescription: "The format of the files.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.ConvertEnumsToStringSlices(fablakehouse.PossibleFileFormatValues(), true)...),
+				},
+			},
+		},
+		"mode": superschema.StringAttribute{
+			Resource: &schemaR.StringAttribute{
+				MarkdownDescription: "The load mode.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(fablakehouse.ModeTypeOverwrite)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.ConvertEnumsToStringSlices(fablakehouse.PossibleModeTypeValues(), true)...),
+				},
+			},
+		},
+		"file_extension": superschema.StringAttribute{
+			Resource: &schemaR.StringAttribute{
+				MarkdownDescription: "The extension of the files to load, for example `csv`. Applies only when `path_type` is `Folder`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		"recursive": superschema.BoolAttribute{
+			Resource: &schemaR.BoolAttribute{
+				MarkdownDescription: "Whether to load the files of the subfolders. Applies only when `path_type` is `Folder`.",
+				Optional:            true,
+			},
+		},
+		"header": superschema.BoolAttribute{
+			Resource: &schemaR.BoolAttribute{
+				MarkdownDescription: "Whether the first row of the files is a header. Applies only when `format` is `Csv`.",
+				Optional:            true,
+			},
+		},
+		"delimiter": superschema.StringAttribute{
+			Resource: &schemaR.StringAttribute{
+				MarkdownDescription: "The column delimiter of the files. Applies only when `format` is `Csv`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func maintenanceAttributes() map[string]superschema.Attribute {
+	return map[string]superschema.Attribute{
+		"optimize": superschema.SuperSingleNestedAttributeOf[optimizeModel]{
+			Resource: &schemaR.SingleNestedAttribute{
+				MarkdownDescription: "Compacts the table files.",
+				Optional:            true,
+			},
+			Attributes: map[string]superschema.Attribute{
+				"v_order": superschema.BoolAttribute{
+					Resource: &schemaR.BoolAttribute{
+						MarkdownDescription: "Whether to apply [V-Order](https://learn.microsoft.com/fabric/data-engineering/delta-optimization-and-v-order) to the table files.",
+						Optional:            true,
+					},
+				},
+				"z_order_by": superschema.SuperListAttribute{
+					Resource: &schemaR.ListAttribute{
+						MarkdownDescription: "The columns to Z-Order the table files by.",
+						CustomType: supertypes.ListTypeOf[types.String]{
+							ListType: basetypes.ListType{
+								ElemType: types.StringType,
+							},
+						},
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+						},
+					},
+				},
+			},
+		},
+		"vacuum": superschema.SuperSingleNestedAttributeOf[vacuumModel]{
+			Resource: &schemaR.SingleNestedAttribute{
+				MarkdownDescription: "Removes the files no longer referenced by the table.",
+				Optional:            true,
+			},
+			Attributes: map[string]superschema.Attribute{
+				"retention_period": superschema.StringAttribute{
+					Resource: &schemaR.StringAttribute{
+						MarkdownDescription: "The retention period of the unreferenced files. The service default is used when not set.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(
+								regexp.MustCompile(`^\d+:([01]\d|2[0-3]):[0-5]\d:[0-5]\d$`),
+								"must follow the `d:hh:mm:ss` format",
+							),
+						},
+					},
+				},
+			},
+		},
+		"purge_deletion_vectors": superschema.BoolAttribute{
+			Resource: &schemaR.BoolAttribute{
+				MarkdownDescription: "Whether to rewrite the files with deletion vectors, physically removing the deleted rows.",
+				Optional:            true,
+			},
+		},
+	}
+}
@@ -230,7 +230,7 @@ func TestUnit_MirroredCatalogResource_CRUD(t *testing.T) {
 						"format":       "Default",
 					},
 				)),
-			ExpectError: regexp.MustCompile(`The argument "definition" is required, but no definition was found.`),
+			ExpectError: regexp.MustCompile(common.ErrorAttComboInvalid),
 		},
 		// Create and Read with definition
 		{
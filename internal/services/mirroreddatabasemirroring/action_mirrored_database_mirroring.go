@@ -0,0 +1,197 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mirroreddatabasemirroring
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabmirroreddatabase "github.com/microsoft/fabric-sdk-go/fabric/mirroreddatabase"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+var _ action.ActionWithConfigure = (*actionMirroredDatabaseMirroring)(nil)
+
+type actionMirroredDatabaseMirroring struct {
+	pConfigData *pconfig.ProviderData
+	client      *fabmirroreddatabase.MirroringClient
+	TypeInfo    tftypeinfo.TFTypeInfo
+}
+
+func NewActionMirroredDatabaseMirroring() action.Action {
+	return &actionMirroredDatabaseMirroring{
+		TypeInfo: ItemTypeInfo,
+	}
+}
+
+func (a *actionMirroredDatabaseMirroring) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = a.TypeInfo.FullTypeName(false)
+}
+
+func (a *actionMirroredDatabaseMirroring) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = actionSchema()
+}
+
+func (a *actionMirroredDatabaseMirroring) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorActionConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	a.pConfigData = pConfigData
+	a.client = fabmirroreddatabase.NewClientFactoryWithClient(*pConfigData.FabricClient).NewMirroringClient()
+
+	if resp.Diagnostics.Append(fabricitem.IsPreviewMode(a.TypeInfo.Name, a.TypeInfo.IsPreview, a.pConfigData.Preview)...); resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (a *actionMirroredDatabaseMirroring) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Debug(ctx, "INVOKE", map[string]any{
+		"action": "start",
+	})
+
+	var config actionMirroredDatabaseMirroringModel
+
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &config)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, a.pConfigData.Timeout)
+	defer cancel()
+
+	workspaceID := config.WorkspaceID.ValueString()
+	mirroredDatabaseID := config.MirroredDatabaseID.ValueString()
+	desired := fabmirroreddatabase.MirroringStatus(config.State.ValueString())
+
+	respStatus, err := a.client.GetMirroringStatus(ctx, workspaceID, mirroredDatabaseID, nil)
+	if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationRead, nil)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current fabmirroreddatabase.MirroringStatus
+	if respStatus.Status != nil {
+		current = *respStatus.Status
+	}
+
+	tflog.Debug(ctx, "INVOKE", map[string]any{
+		"mirrored_database_id": mirroredDatabaseID,
+		"current_state":        current,
+		"desired_state":        desired,
+	})
+
+	if current == desired {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Mirroring of Mirrored Database %s is already %s", mirroredDatabaseID, desired),
+		})
+
+		return
+	}
+
+	switch desired {
+	case fabmirroreddatabase.MirroringStatusStopped:
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Stopping mirroring of Mirrored Database %s (current state: %s)", mirroredDatabaseID, current),
+		})
+
+		_, err = a.client.StopMirroring(ctx, workspaceID, mirroredDatabaseID, nil)
+	default:
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Starting mirroring of Mirrored Database %s (current state: %s)", mirroredDatabaseID, current),
+		})
+
+		_, err = a.client.StartMirroring(ctx, workspaceID, mirroredDatabaseID, nil)
+	}
+
+	if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationUpdate, nil)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(a.waitForState(ctx, resp, workspaceID, mirroredDatabaseID, desired)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Mirroring of Mirrored Database %s is %s", mirroredDatabaseID, desired),
+	})
+
+	tflog.Debug(ctx, "INVOKE", map[string]any{
+		"action": "end",
+	})
+}
+
+// waitForState polls the mirroring status until it reaches the desired state, the mirroring reports an error or ctx is done.
+func (a *actionMirroredDatabaseMirroring) waitForState(
+	ctx context.Context,
+	resp *action.InvokeResponse,
+	workspaceID, mirroredDatabaseID string,
+	desired fabmirroreddatabase.MirroringStatus,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for {
+		respStatus, err := a.client.GetMirroringStatus(ctx, workspaceID, mirroredDatabaseID, nil)
+		if diags.Append(utils.GetDiagsFromError(ctx, err, utils.OperationRead, nil)...); diags.HasError() {
+			return diags
+		}
+
+		if respStatus.Status != nil && *respStatus.Status == desired {
+			return diags
+		}
+
+		if respStatus.Error != nil {
+			var errorCode, message string
+
+			if respStatus.Error.ErrorCode != nil {
+				errorCode = *respStatus.Error.ErrorCode
+			}
+
+			if respStatus.Error.Message != nil {
+				message = *respStatus.Error.Message
+			}
+
+			diags.AddError(
+				common.ErrorUpdateHeader,
+				fmt.Sprintf("Mirroring of Mirrored Database %s did not reach the %s state: %s - %s", mirroredDatabaseID, desired, errorCode, message),
+			)
+
+			return diags
+		}
+
+		var current fabmirroreddatabase.MirroringStatus
+		if respStatus.Status != nil {
+			current = *respStatus.Status
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Waiting for mirroring of Mirrored Database %s to be %s (current state: %s)", mirroredDatabaseID, desired, current),
+		})
+
+		if err := utils.WaitForNextPoll(ctx); err != nil {
+			diags.AddError(
+				common.ErrorUpdateHeader,
+				fmt.Sprintf("Mirroring of Mirrored Database %s did not reach the %s state before the timeout (current state: %s): %s", mirroredDatabaseID, desired, current, err),
+			)
+
+			return diags
+		}
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mirroreddatabasemirroring_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	fabmirroreddatabase "github.com/microsoft/fabric-sdk-go/fabric/mirroreddatabase"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testActionItemFQN, testActionItemHeader = testhelp.TFAction(common.ProviderTypeName, itemTypeInfo.Type, "test")
+
+func TestUnit_MirroredDatabaseMirroringAction_Attributes(t *testing.T) {
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testActionItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no attributes
+		{
+			Config: testhelp.TFActionConfig(
+				testActionItemHeader,
+				map[string]any{},
+			),
+			ExpectError: regexp.MustCompile(`The argument "workspace_id" is required, but no definition was found.`),
+		},
+		// error - invalid UUID
+		{
+			Config: testhelp.TFActionConfig(
+				testActionItemHeader,
+				map[string]any{
+					"workspace_id":         testhelp.RandomUUID(),
+					"mirrored_database_id": "invalid uuid",
+					"state":                "Running",
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - invalid state
+		{
+			Config: testhelp.TFActionConfig(
+				testActionItemHeader,
+				map[string]any{
+					"workspace_id":         testhelp.RandomUUID(),
+					"mirrored_database_id": testhelp.RandomUUID(),
+					"state":                "Paused",
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorAttValueMatch),
+		},
+	}))
+}
+
+func TestUnit_MirroredDatabaseMirroringAction_Invoke(t *testing.T) {
+	testCases := []struct {
+		name    string
+		current fabmirroreddatabase.MirroringStatus
+		state   fabmirroreddatabase.MirroringStatus
+		starts  int
+		stops   int
+	}{
+		{
+			name:    "start",
+			current: fabmirroreddatabase.MirroringStatusStopped,
+			state:   fabmirroreddatabase.MirroringStatusRunning,
+			starts:  1,
+		},
+		{
+			name:    "stop",
+			current: fabmirroreddatabase.MirroringStatusRunning,
+			state:   fabmirroreddatabase.MirroringStatusStopped,
+			stops:   1,
+		},
+		{
+			name:    "already in state",
+			current: fabmirroreddatabase.MirroringStatusRunning,
+			state:   fabmirroreddatabase.MirroringStatusRunning,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mirroredDatabaseID := testhelp.RandomUUID()
+
+			var starts, stops int
+
+			fakeTestUpsertMirroring(mirroredDatabaseID, fabmirroreddatabase.MirroringStatusResponse{Status: to.Ptr(testCase.current)}, nil)
+
+			configureFakes()
+			fakes.FakeServer.ServerFactory.MirroredDatabase.MirroringServer.StartMirroring = fakeStartMirroring(
+				fabmirroreddatabase.MirroringStatusResponse{Status: to.Ptr(fabmirroreddatabase.MirroringStatusRunning)},
+				&starts,
+			)
+			fakes.FakeServer.ServerFactory.MirroredDatabase.MirroringServer.StopMirroring = fakeStopMirroring(
+				fabmirroreddatabase.MirroringStatusResponse{Status: to.Ptr(fabmirroreddatabase.MirroringStatusStopped)},
+				&stops,
+			)
+
+			resource.Test(t, testhelp.NewTestUnitCase(t, &testActionItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+				{
+					Config: at.JoinConfigs(
+						testhelp.TFActionConfig(
+							testActionItemHeader,
+							map[string]any{
+								"workspace_id":         testhelp.RandomUUID(),
+								"mirrored_database_id": mirroredDatabaseID,
+								"state":                string(testCase.state),
+							},
+						),
+						testhelp.TFActionTrigger(testActionItemFQN),
+					),
+					Check: func(_ *terraform.State) error {
+						if starts != testCase.starts || stops != testCase.stops {
+							return fmt.Errorf("expected %d start and %d stop calls, got %d and %d", testCase.starts, testCase.stops, starts, stops)
+						}
+
+						if status := fakeTestMirroringStatus(mirroredDatabaseID); status != testCase.state {
+							return fmt.Errorf("expected the mirroring to be %s, got %s", testCase.state, status)
+						}
+
+						return nil
+					},
+				},
+			}))
+		})
+	}
+}
+
+func TestUnit_MirroredDatabaseMirroringAction_Invoke_Error(t *testing.T) {
+	mirroredDatabaseID := testhelp.RandomUUID()
+
+	var starts, stops int
+
+	fakeTestUpsertMirroring(mirroredDatabaseID, fabmirroreddatabase.MirroringStatusResponse{Status: to.Ptr(fabmirroreddatabase.MirroringStatusStopped)}, nil)
+
+	configureFakes()
+	fakes.FakeServer.ServerFactory.MirroredDatabase.MirroringServer.StartMirroring = fakeStartMirroring(
+		fabmirroreddatabase.MirroringStatusResponse{
+			Status: to.Ptr(fabmirroreddatabase.MirroringStatusInitializing),
+			Error: &fabmirroreddatabase.ErrorResponse{
+				ErrorCode: to.Ptr("SourceUnreachable"),
+				Message:   to.Ptr("The source database cannot be reached."),
+			},
+		},
+		&starts,
+	)
+	fakes.FakeServer.ServerFactory.MirroredDatabase.MirroringServer.StopMirroring = fakeStopMirroring(fabmirroreddatabase.MirroringStatusResponse{}, &stops)
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testActionItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - mirrored database not found
+		{
+			Config: at.JoinConfigs(
+				testhelp.TFActionConfig(
+					testActionItemHeader,
+					map[string]any{
+						"workspace_id":         testhelp.RandomUUID(),
+						"mirrored_database_id": testhelp.RandomUUID(),
+						"state":                string(fabmirroreddatabase.MirroringStatusRunning),
+					},
+				),
+				testhelp.TFActionTrigger(testActionItemFQN),
+			),
+			ExpectError: regexp.MustCompile(common.ErrorReadHeader),
+		},
+		// error - mirroring reports an error
+		{
+			Config: at.JoinConfigs(
+				testhelp.TFActionConfig(
+					testActionItemHeader,
+					map[string]any{
+						"workspace_id":         testhelp.RandomUUID(),
+						"mirrored_database_id": mirroredDatabaseID,
+						"state":                string(fabmirroreddatabase.MirroringStatusRunning),
+					},
+				),
+				testhelp.TFActionTrigger(testActionItemFQN),
+			),
+			ExpectError: regexp.MustCompile(`SourceUnreachable`),
+		},
+	}))
+}
+
+func TestUnit_MirroredDatabaseMirroringAction_Invoke_Timeout(t *testing.T) {
+	mirroredDatabaseID := testhelp.RandomUUID()
+
+	var starts, stops int
+
+	fakeTestUpsertMirroring(mirroredDatabaseID, fabmirroreddatabase.MirroringStatusResponse{Status: to.Ptr(fabmirroreddatabase.MirroringStatusStopped)}, nil)
+
+	configureFakes()
+	fakes.FakeServer.ServerFactory.MirroredDatabase.MirroringServer.StartMirroring = fakeStartMirroring(
+		fabmirroreddatabase.MirroringStatusResponse{Status: to.Ptr(fabmirroreddatabase.MirroringStatusInitializing)},
+		&starts,
+	)
+	fakes.FakeServer.ServerFactory.MirroredDatabase.MirroringServer.StopMirroring = fakeStopMirroring(fabmirroreddatabase.MirroringStatusResponse{}, &stops)
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testActionItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		{
+			Config: at.JoinConfigs(
+				`provider "fabric" {
+					timeout = "2s"
+				}`,
+				testhelp.TFActionConfig(
+					testActionItemHeader,
+					map[string]any{
+						"workspace_id":         testhelp.RandomUUID(),
+						"mirrored_database_id": mirroredDatabaseID,
+						"state":                string(fabmirroreddatabase.MirroringStatusRunning),
+					},
+				),
+				testhelp.TFActionTrigger(testActionItemFQN),
+			),
+			ExpectError: regexp.MustCompile(`before the timeout`),
+		},
+	}))
+}
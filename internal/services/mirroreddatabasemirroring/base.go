@@ -0,0 +1,23 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mirroreddatabasemirroring
+
+import (
+	fabmirroreddatabase "github.com/microsoft/fabric-sdk-go/fabric/mirroreddatabase"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+)
+
+var ItemTypeInfo = tftypeinfo.TFTypeInfo{ //nolint:gochecknoglobals
+	Name:           "Mirrored Database Mirroring",
+	Type:           "mirrored_database_mirroring",
+	DocsURL:        "https://learn.microsoft.com/fabric/database/mirrored-database/monitor",
+	IsPreview:      true,
+	IsSPNSupported: true,
+}
+
+var possibleStateValues = []fabmirroreddatabase.MirroringStatus{ //nolint:gochecknoglobals
+	fabmirroreddatabase.MirroringStatusRunning,
+	fabmirroreddatabase.MirroringStatusStopped,
+}
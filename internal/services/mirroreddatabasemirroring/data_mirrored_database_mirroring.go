@@ -0,0 +1,117 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mirroreddatabasemirroring
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabmirroreddatabase "github.com/microsoft/fabric-sdk-go/fabric/mirroreddatabase"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+var _ datasource.DataSourceWithConfigure = (*dataSourceMirroredDatabaseMirroring)(nil)
+
+type dataSourceMirroredDatabaseMirroring struct {
+	pConfigData *pconfig.ProviderData
+	client      *fabmirroreddatabase.MirroringClient
+	TypeInfo    tftypeinfo.TFTypeInfo
+}
+
+func NewDataSourceMirroredDatabaseMirroring() datasource.DataSource {
+	return &dataSourceMirroredDatabaseMirroring{
+		TypeInfo: ItemTypeInfo,
+	}
+}
+
+func (d *dataSourceMirroredDatabaseMirroring) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeInfo.FullTypeName(false)
+}
+
+func (d *dataSourceMirroredDatabaseMirroring) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dataSourceSchema().GetDataSource(ctx)
+}
+
+func (d *dataSourceMirroredDatabaseMirroring) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorDataSourceConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	d.pConfigData = pConfigData
+	d.client = fabmirroreddatabase.NewClientFactoryWithClient(*pConfigData.FabricClient).NewMirroringClient()
+
+	if resp.Diagnostics.Append(fabricitem.IsPreviewMode(d.TypeInfo.Name, d.TypeInfo.IsPreview, d.pConfigData.Preview)...); resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *dataSourceMirroredDatabaseMirroring) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "start",
+	})
+
+	var data dataSourceMirroredDatabaseMirroringModel
+
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Read(ctx, d.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if resp.Diagnostics.Append(d.get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *dataSourceMirroredDatabaseMirroring) get(ctx context.Context, model *dataSourceMirroredDatabaseMirroringModel) diag.Diagnostics {
+	respStatus, err := d.client.GetMirroringStatus(ctx, model.WorkspaceID.ValueString(), model.MirroredDatabaseID.ValueString(), nil)
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationRead, nil); diags.HasError() {
+		return diags
+	}
+
+	if diags := model.setStatus(ctx, respStatus.MirroringStatusResponse); diags.HasError() {
+		return diags
+	}
+
+	respTables, err := d.client.GetTablesMirroringStatus(ctx, model.WorkspaceID.ValueString(), model.MirroredDatabaseID.ValueString(), nil)
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationList, nil); diags.HasError() {
+		return diags
+	}
+
+	return model.setTables(ctx, respTables)
+}
@@ -0,0 +1,172 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mirroreddatabasemirroring_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	fabmirroreddatabase "github.com/microsoft/fabric-sdk-go/fabric/mirroreddatabase"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testDataSourceItemFQN, testDataSourceItemHeader = testhelp.TFDataSource(common.ProviderTypeName, itemTypeInfo.Type, "test")
+
+func TestUnit_MirroredDatabaseMirroringDataSource(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	mirroredDatabaseID := testhelp.RandomUUID()
+
+	fakeTestUpsertMirroring(
+		mirroredDatabaseID,
+		fabmirroreddatabase.MirroringStatusResponse{
+			Status: to.Ptr(fabmirroreddatabase.MirroringStatusRunning),
+		},
+		[]fabmirroreddatabase.TableMirroringStatusResponse{
+			{
+				SourceSchemaName: to.Ptr("dbo"),
+				SourceTableName:  to.Ptr("orders"),
+				SourceObjectType: to.Ptr(fabmirroreddatabase.TableSourceObjectTypeTable),
+				Status:           to.Ptr(fabmirroreddatabase.TableMirroringStatusReplicating),
+				Metrics: &fabmirroreddatabase.TableMirroringMetrics{
+					ProcessedRows:    to.Ptr(int64(1200)),
+					ProcessedBytes:   to.Ptr(int64(65536)),
+					LastSyncDateTime: to.Ptr(time.Date(2026, time.January, 2, 3, 4, 5, 0, time.UTC)),
+				},
+			},
+			{
+				SourceSchemaName: to.Ptr("dbo"),
+				SourceTableName:  to.Ptr("customers"),
+				SourceObjectType: to.Ptr(fabmirroreddatabase.TableSourceObjectTypeTable),
+				Status:           to.Ptr(fabmirroreddatabase.TableMirroringStatusFailed),
+				Error: &fabmirroreddatabase.ErrorResponse{
+					ErrorCode: to.Ptr("PrimaryKeyMissing"),
+					Message:   to.Ptr("The source table has no primary key."),
+				},
+			},
+		},
+	)
+
+	configureFakes()
+
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, nil, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no attributes
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{},
+			),
+			ExpectError: regexp.MustCompile(`The argument "workspace_id" is required, but no definition was found`),
+		},
+		// error - mirrored_database_id - invalid UUID
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"workspace_id":         workspaceID,
+					"mirrored_database_id": "invalid uuid",
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - unexpected attribute
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"workspace_id":         workspaceID,
+					"mirrored_database_id": mirroredDatabaseID,
+					"unexpected_attr":      "test",
+				},
+			),
+			ExpectError: regexp.MustCompile(`An argument named "unexpected_attr" is not expected here`),
+		},
+		// read - not found
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"workspace_id":         workspaceID,
+					"mirrored_database_id": testhelp.RandomUUID(),
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorReadHeader),
+		},
+		// read
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"workspace_id":         workspaceID,
+					"mirrored_database_id": mirroredDatabaseID,
+				},
+			),
+			ConfigStateChecks: []statecheck.StateCheck{
+				statecheck.ExpectKnownValue(testDataSourceItemFQN, tfjsonpath.New("status"), knownvalue.StringExact(string(fabmirroreddatabase.MirroringStatusRunning))),
+				statecheck.ExpectKnownValue(testDataSourceItemFQN, tfjsonpath.New("error"), knownvalue.Null()),
+				statecheck.ExpectKnownValue(testDataSourceItemFQN, tfjsonpath.New("tables"), knownvalue.ListSizeExact(2)),
+				statecheck.ExpectKnownValue(
+					testDataSourceItemFQN,
+					tfjsonpath.New("tables").AtSliceIndex(0),
+					knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"source_schema_name":           knownvalue.StringExact("dbo"),
+						"source_table_name":            knownvalue.StringExact("orders"),
+						"source_object_type":           knownvalue.StringExact(string(fabmirroreddatabase.TableSourceObjectTypeTable)),
+						"status":                       knownvalue.StringExact(string(fabmirroreddatabase.TableMirroringStatusReplicating)),
+						"processed_rows":               knownvalue.Int64Exact(1200),
+						"processed_bytes":              knownvalue.Int64Exact(65536),
+						"last_sync_date_time":          knownvalue.StringExact("2026-01-02T03:04:05Z"),
+						"last_sync_latency_in_seconds": knownvalue.Null(),
+						"error":                        knownvalue.Null(),
+					}),
+				),
+				statecheck.ExpectKnownValue(testDataSourceItemFQN, tfjsonpath.New("tables").AtSliceIndex(1).AtMapKey("status"), knownvalue.StringExact(string(fabmirroreddatabase.TableMirroringStatusFailed))),
+				statecheck.ExpectKnownValue(testDataSourceItemFQN, tfjsonpath.New("tables").AtSliceIndex(1).AtMapKey("processed_rows"), knownvalue.Null()),
+				statecheck.ExpectKnownValue(
+					testDataSourceItemFQN,
+					tfjsonpath.New("tables").AtSliceIndex(1).AtMapKey("error"),
+					knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"error_code": knownvalue.StringExact("PrimaryKeyMissing"),
+						"message":    knownvalue.StringExact("The source table has no primary key."),
+					}),
+				),
+			},
+		},
+	}))
+}
+
+func TestAcc_MirroredDatabaseMirroringDataSource(t *testing.T) {
+	workspace := testhelp.WellKnown()["WorkspaceDS"].(map[string]any)
+	workspaceID := workspace["id"].(string)
+
+	entity := testhelp.WellKnown()["MirroredDatabase"].(map[string]any)
+	entityID := entity["id"].(string)
+
+	resource.ParallelTest(t, testhelp.NewTestAccCase(t, nil, nil, []resource.TestStep{
+		// read
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"workspace_id":         workspaceID,
+					"mirrored_database_id": entityID,
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "mirrored_database_id", entityID),
+				resource.TestCheckResourceAttrSet(testDataSourceItemFQN, "status"),
+			),
+		},
+	}))
+}
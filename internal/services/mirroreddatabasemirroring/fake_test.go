@@ -0,0 +1,137 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mirroreddatabasemirroring_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	fabfake "github.com/microsoft/fabric-sdk-go/fabric/fake"
+	fabmirroreddatabase "github.com/microsoft/fabric-sdk-go/fabric/mirroreddatabase"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+type fakeMirroring struct {
+	status fabmirroreddatabase.MirroringStatusResponse
+	tables []fabmirroreddatabase.TableMirroringStatusResponse
+}
+
+// fakeMirroringStore keeps the mirroring status of the tests, keyed by Mirrored Database ID.
+type fakeMirroringStore struct {
+	mu        sync.Mutex
+	mirroring map[string]fakeMirroring
+}
+
+var fakeStore = &fakeMirroringStore{ //nolint:gochecknoglobals
+	mirroring: map[string]fakeMirroring{},
+}
+
+func fakeTestUpsertMirroring(mirroredDatabaseID string, status fabmirroreddatabase.MirroringStatusResponse, tables []fabmirroreddatabase.TableMirroringStatusResponse) {
+	fakeStore.mu.Lock()
+	defer fakeStore.mu.Unlock()
+
+	fakeStore.mirroring[mirroredDatabaseID] = fakeMirroring{
+		status: status,
+		tables: tables,
+	}
+}
+
+func configureFakes() {
+	fakes.FakeServer.ServerFactory.MirroredDatabase.MirroringServer.GetMirroringStatus = fakeGetMirroringStatus()
+	fakes.FakeServer.ServerFactory.MirroredDatabase.MirroringServer.NewGetTablesMirroringStatusPager = fakeGetTablesMirroringStatusPager()
+}
+
+func fakeGetMirroringStatus() func(ctx context.Context, workspaceID, mirroredDatabaseID string, options *fabmirroreddatabase.MirroringClientGetMirroringStatusOptions) (resp azfake.Responder[fabmirroreddatabase.MirroringClientGetMirroringStatusResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _, mirroredDatabaseID string, _ *fabmirroreddatabase.MirroringClientGetMirroringStatusOptions) (resp azfake.Responder[fabmirroreddatabase.MirroringClientGetMirroringStatusResponse], errResp azfake.ErrorResponder) {
+		fakeStore.mu.Lock()
+		defer fakeStore.mu.Unlock()
+
+		mirroring, ok := fakeStore.mirroring[mirroredDatabaseID]
+		if !ok {
+			errResp.SetError(fabfake.SetResponseError(http.StatusNotFound, fabcore.ErrCommon.EntityNotFound.Error(), fabcore.ErrCommon.EntityNotFound.Error()))
+			resp.SetResponse(http.StatusNotFound, fabmirroreddatabase.MirroringClientGetMirroringStatusResponse{}, nil)
+
+			return resp, errResp
+		}
+
+		resp.SetResponse(http.StatusOK, fabmirroreddatabase.MirroringClientGetMirroringStatusResponse{MirroringStatusResponse: mirroring.status}, nil)
+
+		return resp, errResp
+	}
+}
+
+func fakeGetTablesMirroringStatusPager() func(workspaceID, mirroredDatabaseID string, options *fabmirroreddatabase.MirroringClientGetTablesMirroringStatusOptions) (resp azfake.PagerResponder[fabmirroreddatabase.MirroringClientGetTablesMirroringStatusResponse]) {
+	return func(_, mirroredDatabaseID string, _ *fabmirroreddatabase.MirroringClientGetTablesMirroringStatusOptions) (resp azfake.PagerResponder[fabmirroreddatabase.MirroringClientGetTablesMirroringStatusResponse]) {
+		fakeStore.mu.Lock()
+		defer fakeStore.mu.Unlock()
+
+		resp = azfake.PagerResponder[fabmirroreddatabase.MirroringClientGetTablesMirroringStatusResponse]{}
+		resp.AddPage(
+			http.StatusOK,
+			fabmirroreddatabase.MirroringClientGetTablesMirroringStatusResponse{
+				TablesMirroringStatusResponse: fabmirroreddatabase.TablesMirroringStatusResponse{
+					Data: fakeStore.mirroring[mirroredDatabaseID].tables,
+				},
+			},
+			nil,
+		)
+
+		return resp
+	}
+}
+
+// Returns a fake function that starts the mirroring, by setting the mirroring status to the provided status, and counts the calls.
+func fakeStartMirroring(
+	started fabmirroreddatabase.MirroringStatusResponse,
+	calls *int,
+) func(ctx context.Context, workspaceID, mirroredDatabaseID string, options *fabmirroreddatabase.MirroringClientStartMirroringOptions) (resp azfake.Responder[fabmirroreddatabase.MirroringClientStartMirroringResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _, mirroredDatabaseID string, _ *fabmirroreddatabase.MirroringClientStartMirroringOptions) (resp azfake.Responder[fabmirroreddatabase.MirroringClientStartMirroringResponse], errResp azfake.ErrorResponder) {
+		fakeSetMirroringStatus(mirroredDatabaseID, started)
+		*calls++
+
+		resp.SetResponse(http.StatusOK, fabmirroreddatabase.MirroringClientStartMirroringResponse{}, nil)
+
+		return resp, errResp
+	}
+}
+
+// Returns a fake function that stops the mirroring, by setting the mirroring status to the provided status, and counts the calls.
+func fakeStopMirroring(
+	stopped fabmirroreddatabase.MirroringStatusResponse,
+	calls *int,
+) func(ctx context.Context, workspaceID, mirroredDatabaseID string, options *fabmirroreddatabase.MirroringClientStopMirroringOptions) (resp azfake.Responder[fabmirroreddatabase.MirroringClientStopMirroringResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _, mirroredDatabaseID string, _ *fabmirroreddatabase.MirroringClientStopMirroringOptions) (resp azfake.Responder[fabmirroreddatabase.MirroringClientStopMirroringResponse], errResp azfake.ErrorResponder) {
+		fakeSetMirroringStatus(mirroredDatabaseID, stopped)
+		*calls++
+
+		resp.SetResponse(http.StatusOK, fabmirroreddatabase.MirroringClientStopMirroringResponse{}, nil)
+
+		return resp, errResp
+	}
+}
+
+func fakeSetMirroringStatus(mirroredDatabaseID string, status fabmirroreddatabase.MirroringStatusResponse) {
+	fakeStore.mu.Lock()
+	defer fakeStore.mu.Unlock()
+
+	mirroring := fakeStore.mirroring[mirroredDatabaseID]
+	mirroring.status = status
+	fakeStore.mirroring[mirroredDatabaseID] = mirroring
+}
+
+func fakeTestMirroringStatus(mirroredDatabaseID string) fabmirroreddatabase.MirroringStatus {
+	fakeStore.mu.Lock()
+	defer fakeStore.mu.Unlock()
+
+	status := fakeStore.mirroring[mirroredDatabaseID].status.Status
+	if status == nil {
+		return ""
+	}
+
+	return *status
+}
@@ -0,0 +1,138 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mirroreddatabasemirroring
+
+import (
+	"context"
+
+	timeoutsD "github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts" //revive:disable-line:import-alias-naming
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fabmirroreddatabase "github.com/microsoft/fabric-sdk-go/fabric/mirroreddatabase"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+)
+
+/*
+DATA-SOURCE
+*/
+
+type dataSourceMirroredDatabaseMirroringModel struct {
+	WorkspaceID        customtypes.UUID                                              `tfsdk:"workspace_id"`
+	MirroredDatabaseID customtypes.UUID                                              `tfsdk:"mirrored_database_id"`
+	Status             types.String                                                  `tfsdk:"status"`
+	Error              supertypes.SingleNestedObjectValueOf[mirroringErrorModel]     `tfsdk:"error"`
+	Tables             supertypes.ListNestedObjectValueOf[tableMirroringStatusModel] `tfsdk:"tables"`
+
+	Timeouts timeoutsD.Value `tfsdk:"timeouts"`
+}
+
+func (to *dataSourceMirroredDatabaseMirroringModel) setStatus(ctx context.Context, from fabmirroreddatabase.MirroringStatusResponse) diag.Diagnostics {
+	to.Status = types.StringPointerValue((*string)(from.Status))
+
+	mirroringError, diags := newMirroringErrorValue(ctx, from.Error)
+	if diags.HasError() {
+		return diags
+	}
+
+	to.Error = mirroringError
+
+	return nil
+}
+
+func (to *dataSourceMirroredDatabaseMirroringModel) setTables(ctx context.Context, from []fabmirroreddatabase.TableMirroringStatusResponse) diag.Diagnostics {
+	slice := make([]*tableMirroringStatusModel, 0, len(from))
+
+	for _, entity := range from {
+		var entityModel tableMirroringStatusModel
+
+		if diags := entityModel.set(ctx, entity); diags.HasError() {
+			return diags
+		}
+
+		slice = append(slice, &entityModel)
+	}
+
+	return to.Tables.Set(ctx, slice)
+}
+
+/*
+ACTION
+*/
+
+type actionMirroredDatabaseMirroringModel struct {
+	WorkspaceID        customtypes.UUID `tfsdk:"workspace_id"`
+	MirroredDatabaseID customtypes.UUID `tfsdk:"mirrored_database_id"`
+	State              types.String     `tfsdk:"state"`
+}
+
+/*
+HELPER MODELS
+*/
+
+type tableMirroringStatusModel struct {
+	SourceSchemaName         types.String                                              `tfsdk:"source_schema_name"`
+	SourceTableName          types.String                                              `tfsdk:"source_table_name"`
+	SourceObjectType         types.String                                              `tfsdk:"source_object_type"`
+	Status                   types.String                                              `tfsdk:"status"`
+	ProcessedRows            types.Int64                                               `tfsdk:"processed_rows"`
+	ProcessedBytes           types.Int64                                               `tfsdk:"processed_bytes"`
+	LastSyncDateTime         timetypes.RFC3339                                         `tfsdk:"last_sync_date_time"`
+	LastSyncLatencyInSeconds types.Int32                                               `tfsdk:"last_sync_latency_in_seconds"`
+	Error                    supertypes.SingleNestedObjectValueOf[mirroringErrorModel] `tfsdk:"error"`
+}
+
+func (to *tableMirroringStatusModel) set(ctx context.Context, from fabmirroreddatabase.TableMirroringStatusResponse) diag.Diagnostics {
+	to.SourceSchemaName = types.StringPointerValue(from.SourceSchemaName)
+	to.SourceTableName = types.StringPointerValue(from.SourceTableName)
+	to.SourceObjectType = types.StringPointerValue((*string)(from.SourceObjectType))
+	to.Status = types.StringPointerValue((*string)(from.Status))
+	to.ProcessedRows = types.Int64Null()
+	to.ProcessedBytes = types.Int64Null()
+	to.LastSyncDateTime = timetypes.NewRFC3339Null()
+	to.LastSyncLatencyInSeconds = types.Int32Null()
+
+	if from.Metrics != nil {
+		to.ProcessedRows = types.Int64PointerValue(from.Metrics.ProcessedRows)
+		to.ProcessedBytes = types.Int64PointerValue(from.Metrics.ProcessedBytes)
+		to.LastSyncDateTime = timetypes.NewRFC3339TimePointerValue(from.Metrics.LastSyncDateTime)
+		to.LastSyncLatencyInSeconds = types.Int32PointerValue(from.Metrics.LastSyncLatencyInSeconds)
+	}
+
+	mirroringError, diags := newMirroringErrorValue(ctx, from.Error)
+	if diags.HasError() {
+		return diags
+	}
+
+	to.Error = mirroringError
+
+	return nil
+}
+
+type mirroringErrorModel struct {
+	ErrorCode types.String `tfsdk:"error_code"`
+	Message   types.String `tfsdk:"message"`
+}
+
+func (to *mirroringErrorModel) set(from fabmirroreddatabase.ErrorResponse) {
+	to.ErrorCode = types.StringPointerValue(from.ErrorCode)
+	to.Message = types.StringPointerValue(from.Message)
+}
+
+func newMirroringErrorValue(ctx context.Context, from *fabmirroreddatabase.ErrorResponse) (supertypes.SingleNestedObjectValueOf[mirroringErrorModel], diag.Diagnostics) {
+	result := supertypes.NewSingleNestedObjectValueOfNull[mirroringErrorModel](ctx)
+
+	if from == nil {
+		return result, nil
+	}
+
+	errorModel := &mirroringErrorModel{}
+	errorModel.set(*from)
+
+	diags := result.Set(ctx, errorModel)
+
+	return result, diags
+}
@@ -0,0 +1,42 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mirroreddatabasemirroring
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func actionSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "The " + ItemTypeInfo.Name + " action allows you to start or stop the replication of a Fabric [Mirrored Database](" + ItemTypeInfo.DocsURL + ")." +
+			"\n\nThe action waits until the mirroring status reaches the target state, and fails when the mirroring reports an error. " +
+			"It does nothing when the mirroring is already in the target state." +
+			"\n\n-> This action supports Service Principal authentication." +
+			"\n\n~> This action is in **preview**. To use it, you must explicitly enable the `preview` mode in the provider level configuration.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "The Workspace ID.",
+				CustomType:          customtypes.UUIDType{},
+				Required:            true,
+			},
+			"mirrored_database_id": schema.StringAttribute{
+				MarkdownDescription: "The Mirrored Database ID.",
+				CustomType:          customtypes.UUIDType{},
+				Required:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The target mirroring state. Value must be one of : " + utils.ConvertStringSlicesToString(possibleStateValues, true, true) + ".",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.ConvertEnumsToStringSlices(possibleStateValues, false)...),
+				},
+			},
+		},
+	}
+}
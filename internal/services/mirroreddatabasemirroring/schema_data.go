@@ -0,0 +1,141 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mirroreddatabasemirroring
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema" //revive:disable-line:import-alias-naming
+	fabmirroreddatabase "github.com/microsoft/fabric-sdk-go/fabric/mirroreddatabase"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func dataSourceSchema() superschema.Schema {
+	return superschema.Schema{
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: fabricitem.NewDataSourceMarkdownDescription(ItemTypeInfo, false) +
+				"\n\nReturns the mirroring status of a Mirrored Database and the replication status of each of its tables. " +
+				"Use it in a [`check` block](https://developer.hashicorp.com/terraform/language/checks) to confirm that a mirror is healthy after apply.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"workspace_id": superschema.SuperStringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The Workspace ID.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+				},
+			},
+			"mirrored_database_id": superschema.SuperStringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The Mirrored Database ID.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+				},
+			},
+			"status": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The mirroring status. Possible values: " +
+						utils.ConvertStringSlicesToString(fabmirroreddatabase.PossibleMirroringStatusValues(), true, true) + ".",
+					Computed: true,
+				},
+			},
+			"error":  errorSchema("The mirroring error, set when the mirroring fails."),
+			"tables": tablesSchema(),
+			"timeouts": superschema.TimeoutAttribute{
+				DataSource: &superschema.DatasourceTimeoutAttribute{
+					Read: true,
+				},
+			},
+		},
+	}
+}
+
+func tablesSchema() superschema.SuperListNestedAttributeOf[tableMirroringStatusModel] {
+	return superschema.SuperListNestedAttributeOf[tableMirroringStatusModel]{
+		DataSource: &schemaD.ListNestedAttribute{
+			MarkdownDescription: "The replication status of the mirrored tables.",
+			Computed:            true,
+		},
+		Attributes: map[string]superschema.Attribute{
+			"source_schema_name": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The source schema name.",
+					Computed:            true,
+				},
+			},
+			"source_table_name": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The source table name.",
+					Computed:            true,
+				},
+			},
+			"source_object_type": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The source object type. Possible values: " +
+						utils.ConvertStringSlicesToString(fabmirroreddatabase.PossibleTableSourceObjectTypeValues(), true, true) + ".",
+					Computed: true,
+				},
+			},
+			"status": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The table mirroring status. Possible values: " +
+						utils.ConvertStringSlicesToString(fabmirroreddatabase.PossibleTableMirroringStatusValues(), true, true) + ".",
+					Computed: true,
+				},
+			},
+			"processed_rows": superschema.Int64Attribute{
+				DataSource: &schemaD.Int64Attribute{
+					MarkdownDescription: "The number of rows replicated for the table.",
+					Computed:            true,
+				},
+			},
+			"processed_bytes": superschema.Int64Attribute{
+				DataSource: &schemaD.Int64Attribute{
+					MarkdownDescription: "The number of bytes replicated for the table.",
+					Computed:            true,
+				},
+			},
+			"last_sync_date_time": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The last sync time of the table in UTC.",
+					CustomType:          timetypes.RFC3339Type{},
+					Computed:            true,
+				},
+			},
+			"last_sync_latency_in_seconds": superschema.Int32Attribute{
+				DataSource: &schemaD.Int32Attribute{
+					MarkdownDescription: "The latency in seconds between the source commit and the target commit of the last processed change. Not set for sources without a commit time.",
+					Computed:            true,
+				},
+			},
+			"error": errorSchema("The table error, set when the replication of the table fails."),
+		},
+	}
+}
+
+func errorSchema(markdownDescription string) superschema.SuperSingleNestedAttributeOf[mirroringErrorModel] {
+	return superschema.SuperSingleNestedAttributeOf[mirroringErrorModel]{
+		DataSource: &schemaD.SingleNestedAttribute{
+			MarkdownDescription: markdownDescription,
+			Computed:            true,
+		},
+		Attributes: map[string]superschema.Attribute{
+			"error_code": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The error code.",
+					Computed:            true,
+				},
+			},
+			"message": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The error message.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
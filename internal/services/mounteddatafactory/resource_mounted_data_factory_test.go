@@ -133,7 +133,7 @@ func TestUnit_MountedDataFactoryResource_Attributes(t *testing.T) {
 						"display_name": "test",
 					},
 				)),
-			ExpectError: regexp.MustCompile(`The argument "definition" is required, but no definition was found.`),
+			ExpectError: regexp.MustCompile(common.ErrorAttComboInvalid),
 		},
 	}))
 }
@@ -334,7 +334,7 @@ func TestAcc_MountedDataFactoryResource_CRUD(t *testing.T) {
 						"format":       "Default",
 					},
 				)),
-			ExpectError: regexp.MustCompile(`The argument "definition" is required, but no definition was found.`),
+			ExpectError: regexp.MustCompile(common.ErrorAttComboInvalid),
 		},
 		// Update and Read
 		{
@@ -4,6 +4,8 @@
 package notebook_test
 
 import (
+	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
 
@@ -11,10 +13,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
 
 	"github.com/microsoft/terraform-provider-fabric/internal/common"
 	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
 	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
 	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
 )
@@ -28,6 +33,18 @@ func TestUnit_NotebookDataSource(t *testing.T) {
 	fakes.FakeServer.Upsert(fakes.NewRandomItemWithWorkspace(fabricItemType, workspaceID))
 	fakes.FakeServer.Upsert(entity)
 	fakes.FakeServer.Upsert(fakes.NewRandomItemWithWorkspace(fabricItemType, workspaceID))
+	fakes.FakeServer.UpsertDefinition(workspaceID, *entity.ID, fabcore.ItemDefinition{
+		Format: new("ipynb"),
+		Parts: []fabcore.ItemDefinitionPart{
+			{
+				Path:        new("notebook-content.ipynb"),
+				Payload:     new("eyJjZWxscyI6W10sIm1ldGFkYXRhIjp7fSwibmJmb3JtYXQiOjQsIm5iZm9ybWF0X21pbm9yIjo1fQ=="), // {"cells":[],"metadata":{},"nbformat":4,"nbformat_minor":5}
+				PayloadType: new(fabcore.PayloadTypeInlineBase64),
+			},
+		},
+	})
+
+	outputDirectory := t.TempDir()
 
 	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, nil, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
 		// error - no attributes
@@ -148,6 +165,34 @@ func TestUnit_NotebookDataSource(t *testing.T) {
 			),
 			ExpectError: regexp.MustCompile(common.ErrorReadHeader),
 		},
+		// read by id with definition written to the output directory
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"workspace_id":     workspaceID,
+					"id":               *entity.ID,
+					"format":           "ipynb",
+					"output_directory": filepath.ToSlash(outputDirectory),
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrPtr(testDataSourceItemFQN, "id", entity.ID),
+				resource.TestCheckNoResourceAttr(testDataSourceItemFQN, "definition"),
+				func(_ *terraform.State) error {
+					_, err := os.Stat(filepath.Join(outputDirectory, "notebook-content.ipynb"))
+
+					return err
+				},
+			),
+			ConfigStateChecks: []statecheck.StateCheck{
+				statecheck.ExpectKnownValue(
+					testDataSourceItemFQN,
+					tfjsonpath.New("output_files").AtMapKey("notebook-content.ipynb"),
+					knownvalue.StringExact(utils.Sha256(`{"cells":[],"metadata":{},"nbformat":4,"nbformat_minor":5}`)),
+				),
+			},
+		},
 	}))
 }
 
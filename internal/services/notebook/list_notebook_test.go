@@ -0,0 +1,109 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package notebook_test
+
+import (
+	"regexp"
+	"testing"
+
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testListItemFQN, testListItemHeader = testhelp.TFList(common.ProviderTypeName, itemTypeInfo.Type, "test")
+
+func TestUnit_NotebookListResource(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	entity := fakes.NewRandomItemWithWorkspace(fabricItemType, workspaceID)
+
+	fakes.FakeServer.Upsert(fakes.NewRandomItemWithWorkspace(fabricItemType, workspaceID))
+	fakes.FakeServer.Upsert(entity)
+	fakes.FakeServer.Upsert(fakes.NewRandomItemWithWorkspace(fabricItemType, workspaceID))
+
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, nil, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// init working directory
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemsHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+				},
+			),
+		},
+		// error - workspace_id - invalid UUID
+		{
+			Query: true,
+			Config: testhelp.TFListConfig(
+				common.ProviderTypeName,
+				testListItemHeader,
+				map[string]any{
+					"workspace_id": "invalid uuid",
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// list
+		{
+			Query: true,
+			Config: testhelp.TFListConfig(
+				common.ProviderTypeName,
+				testListItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+				},
+			),
+			QueryResultChecks: []querycheck.QueryResultCheck{
+				querycheck.ExpectLength(testListItemFQN, 3),
+				querycheck.ExpectIdentity(testListItemFQN, map[string]knownvalue.Check{
+					"workspace_id": knownvalue.StringExact(workspaceID),
+					"id":           knownvalue.StringExact(*entity.ID),
+				}),
+			},
+		},
+	}))
+}
+
+func TestAcc_NotebookListResource(t *testing.T) {
+	workspace := testhelp.WellKnown()["WorkspaceDS"].(map[string]any)
+	workspaceID := workspace["id"].(string)
+
+	entity := testhelp.WellKnown()["Notebook"].(map[string]any)
+	entityID := entity["id"].(string)
+
+	resource.ParallelTest(t, testhelp.NewTestAccCase(t, nil, nil, []resource.TestStep{
+		// init working directory
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemsHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+				},
+			),
+		},
+		// list
+		{
+			Query: true,
+			Config: testhelp.TFListConfig(
+				common.ProviderTypeName,
+				testListItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+				},
+			),
+			QueryResultChecks: []querycheck.QueryResultCheck{
+				querycheck.ExpectIdentity(testListItemFQN, map[string]knownvalue.Check{
+					"workspace_id": knownvalue.StringExact(workspaceID),
+					"id":           knownvalue.StringExact(entityID),
+				}),
+			},
+		},
+	}))
+}
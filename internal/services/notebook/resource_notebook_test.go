@@ -12,6 +12,7 @@ import (
 	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
 
 	"github.com/microsoft/terraform-provider-fabric/internal/common"
 	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
@@ -293,6 +294,69 @@ func TestUnit_NotebookResource_CRUD(t *testing.T) {
 	}))
 }
 
+func TestUnit_NotebookResource_DefinitionDrift(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	entity := fakes.NewRandomItemWithWorkspace(fabricItemType, workspaceID)
+
+	var entityID string
+
+	testCase := at.JoinConfigs(
+		testHelperLocals,
+		at.CompileConfig(
+			testResourceItemHeader,
+			map[string]any{
+				"workspace_id":                       workspaceID,
+				"display_name":                       *entity.DisplayName,
+				"format":                             "Default",
+				"definition":                         testHelperDefinitionIPYNB,
+				"definition_drift_detection_enabled": true,
+			},
+		))
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config:       testCase,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "definition_drift_detection_enabled", "true"),
+				resource.TestCheckResourceAttrSet(testResourceItemFQN, `definition.notebook-content.ipynb.source_content_sha256`),
+				func(s *terraform.State) error {
+					entityID = s.RootModule().Resources[testResourceItemFQN].Primary.ID
+
+					return nil
+				},
+			),
+		},
+		// Out-of-band definition change
+		{
+			ResourceName: testResourceItemFQN,
+			PreConfig: func() {
+				fakes.FakeServer.UpsertDefinition(workspaceID, entityID, fabcore.ItemDefinition{
+					Parts: []fabcore.ItemDefinitionPart{
+						{
+							Path:        new("notebook-content.ipynb"),
+							Payload:     new("eyJjZWxscyI6W119"),
+							PayloadType: new(fabcore.PayloadTypeInlineBase64),
+						},
+					},
+				})
+			},
+			Config:             testCase,
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+		// Restore configured definition
+		{
+			ResourceName: testResourceItemFQN,
+			Config:       testCase,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrSet(testResourceItemFQN, `definition.notebook-content.ipynb.source_content_sha256`),
+			),
+		},
+	}))
+}
+
 func TestAcc_NotebookResource_CRUD(t *testing.T) {
 	workspace := testhelp.WellKnown()["WorkspaceRS"].(map[string]any)
 	workspaceID := workspace["id"].(string)
@@ -402,6 +466,43 @@ func TestAcc_NotebookDefinitionIPYNBResource_CRUD(t *testing.T) {
 	))
 }
 
+func TestAcc_NotebookResource_DefinitionDrift(t *testing.T) {
+	workspace := testhelp.WellKnown()["WorkspaceRS"].(map[string]any)
+	workspaceID := workspace["id"].(string)
+
+	testCase := at.JoinConfigs(
+		testHelperLocals,
+		at.CompileConfig(
+			testResourceItemHeader,
+			map[string]any{
+				"workspace_id":                       workspaceID,
+				"display_name":                       testhelp.RandomName(),
+				"format":                             "ipynb",
+				"definition":                         testHelperDefinitionIPYNB,
+				"definition_drift_detection_enabled": true,
+			},
+		))
+
+	resource.Test(t, testhelp.NewTestAccCase(t, &testResourceItemFQN, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config:       testCase,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "definition_drift_detection_enabled", "true"),
+			),
+		},
+		// Fabric normalizes the notebook content, which must not be reported as drift
+		{
+			ResourceName:       testResourceItemFQN,
+			Config:             testCase,
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: false,
+		},
+	},
+	))
+}
+
 func TestAcc_NotebookDefinitionPYResource_CRUD(t *testing.T) {
 	workspace := testhelp.WellKnown()["WorkspaceRS"].(map[string]any)
 	workspaceID := workspace["id"].(string)
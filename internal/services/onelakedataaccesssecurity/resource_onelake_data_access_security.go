@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
@@ -29,6 +30,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = (*resourceOneLakeDataAccessSecurity)(nil)
 	_ resource.ResourceWithImportState = (*resourceOneLakeDataAccessSecurity)(nil)
+	_ resource.ResourceWithIdentity    = (*resourceOneLakeDataAccessSecurity)(nil)
 )
 
 type resourceOneLakeDataAccessSecurity struct {
@@ -51,6 +53,27 @@ func (r *resourceOneLakeDataAccessSecurity) Schema(ctx context.Context, _ resour
 	resp.Schema = itemSchema(false).GetResource(ctx)
 }
 
+func (r *resourceOneLakeDataAccessSecurity) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_id": identityschema.StringAttribute{
+				Description:       "The Workspace ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+			"item_id": identityschema.StringAttribute{
+				Description:       "The Item ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+			"role_name": identityschema.StringAttribute{
+				Description:       "The Data Access Role name.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *resourceOneLakeDataAccessSecurity) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -110,6 +133,7 @@ func (r *resourceOneLakeDataAccessSecurity) Create(ctx context.Context, req reso
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "CREATE", map[string]any{
 		"action": "end",
@@ -148,6 +172,7 @@ func (r *resourceOneLakeDataAccessSecurity) Read(ctx context.Context, req resour
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "READ", map[string]any{
 		"action": "end",
@@ -197,6 +222,7 @@ func (r *resourceOneLakeDataAccessSecurity) Update(ctx context.Context, req reso
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "UPDATE", map[string]any{
 		"action": "end",
@@ -240,7 +266,12 @@ func (r *resourceOneLakeDataAccessSecurity) ImportState(ctx context.Context, req
 		"id": req.ID,
 	})
 
-	parts := strings.Split(req.ID, "/")
+	importID, diags := utils.GetImportID(ctx, req, "workspace_id", "item_id", "role_name")
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts := strings.Split(importID, "/")
 	if len(parts) != 3 {
 		resp.Diagnostics.AddError(
 			common.ErrorImportIdentifierHeader,
@@ -281,6 +312,7 @@ func (r *resourceOneLakeDataAccessSecurity) ImportState(ctx context.Context, req
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "IMPORT", map[string]any{
 		"action": "end",
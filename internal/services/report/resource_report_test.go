@@ -133,7 +133,7 @@ func TestUnit_ReportResource_Attributes(t *testing.T) {
 						"display_name": "test",
 					},
 				)),
-			ExpectError: regexp.MustCompile(`The argument "definition" is required, but no definition was found.`),
+			ExpectError: regexp.MustCompile(common.ErrorAttComboInvalid),
 		},
 	}))
 }
@@ -289,6 +289,84 @@ func TestUnit_ReportResource_CRUD(t *testing.T) {
 	}))
 }
 
+func TestUnit_ReportResource_DefinitionDirectory(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	semanticModel := fakes.NewRandomItemWithWorkspace(fabcore.ItemTypeSemanticModel, workspaceID)
+	fakes.FakeServer.Upsert(semanticModel)
+
+	entity := fakes.NewRandomItemWithWorkspace(fabricItemType, workspaceID)
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - definition and definition_directory
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.JoinConfigs(
+				testHelperLocals,
+				at.CompileConfig(
+					testResourceItemHeader,
+					map[string]any{
+						"workspace_id": workspaceID,
+						"display_name": *entity.DisplayName,
+						"format":       "PBIR-Legacy",
+						"definition":   testHelperDefinition,
+						"definition_directory": map[string]any{
+							"path": "${local.path}",
+						},
+					},
+				)),
+			ExpectError: regexp.MustCompile(common.ErrorAttComboInvalid),
+		},
+		// error - part paths not accepted by the format
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.JoinConfigs(
+				testHelperLocals,
+				at.CompileConfig(
+					testResourceItemHeader,
+					map[string]any{
+						"workspace_id": workspaceID,
+						"display_name": *entity.DisplayName,
+						"format":       "PBIR",
+						"definition_directory": map[string]any{
+							"path": "${local.path}",
+							"tokens": map[string]any{
+								"SemanticModelID": *semanticModel.ID,
+							},
+						},
+					},
+				)),
+			ExpectError: regexp.MustCompile(common.ErrorDefinitionDirectoryHeader),
+		},
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.JoinConfigs(
+				testHelperLocals,
+				at.CompileConfig(
+					testResourceItemHeader,
+					map[string]any{
+						"workspace_id": workspaceID,
+						"display_name": *entity.DisplayName,
+						"format":       "PBIR-Legacy",
+						"definition_directory": map[string]any{
+							"path": "${local.path}",
+							"tokens": map[string]any{
+								"SemanticModelID": *semanticModel.ID,
+							},
+						},
+					},
+				)),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrPtr(testResourceItemFQN, "display_name", entity.DisplayName),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "definition.%", "4"),
+				resource.TestCheckResourceAttrSet(testResourceItemFQN, "definition.definition.pbir.source_content_sha256"),
+				resource.TestCheckResourceAttrSet(testResourceItemFQN, "definition.report.json.source_content_sha256"),
+			),
+		},
+		// Delete testing automatically occurs in TestCase
+	}))
+}
+
 func TestAcc_ReportResource_CRUD(t *testing.T) {
 	workspace := testhelp.WellKnown()["WorkspaceRS"].(map[string]any)
 	workspaceID := workspace["id"].(string)
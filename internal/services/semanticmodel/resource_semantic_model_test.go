@@ -126,7 +126,7 @@ func TestUnit_SemanticModelResource_Attributes(t *testing.T) {
 						"display_name": "test",
 					},
 				)),
-			ExpectError: regexp.MustCompile(`The argument "definition" is required, but no definition was found.`),
+			ExpectError: regexp.MustCompile(common.ErrorAttComboInvalid),
 		},
 	}))
 }
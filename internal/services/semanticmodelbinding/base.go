@@ -0,0 +1,29 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package semanticmodelbinding
+
+import (
+	fabsemanticmodel "github.com/microsoft/fabric-sdk-go/fabric/semanticmodel"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+)
+
+var ItemTypeInfo = tftypeinfo.TFTypeInfo{ //nolint:gochecknoglobals
+	Name:           "Semantic Model Connection Binding",
+	Type:           "semantic_model_connection_binding",
+	DocsURL:        "https://learn.microsoft.com/rest/api/fabric/semanticmodel/items/bind-semantic-model-connection",
+	IsPreview:      true,
+	IsSPNSupported: true,
+}
+
+// possibleConnectivityTypeValues are the connectivity types a data source reference can be bound with, `None` is used to unbind it.
+var possibleConnectivityTypeValues = []fabsemanticmodel.ConnectivityType{ //nolint:gochecknoglobals
+	fabsemanticmodel.ConnectivityTypeAutomatic,
+	fabsemanticmodel.ConnectivityTypeOnPremisesGateway,
+	fabsemanticmodel.ConnectivityTypeOnPremisesGatewayPersonal,
+	fabsemanticmodel.ConnectivityTypePersonalCloud,
+	fabsemanticmodel.ConnectivityTypeShareableCloud,
+	fabsemanticmodel.ConnectivityTypeStreamingVirtualNetworkGateway,
+	fabsemanticmodel.ConnectivityTypeVirtualNetworkGateway,
+}
@@ -0,0 +1,121 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package semanticmodelbinding_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	fabfake "github.com/microsoft/fabric-sdk-go/fabric/fake"
+	fabsemanticmodel "github.com/microsoft/fabric-sdk-go/fabric/semanticmodel"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+// itemConnectionsState stores the data source references of the fake Semantic Models and the Connections bound to them.
+type itemConnectionsState struct {
+	mutex       sync.Mutex
+	connections map[string][]fabcore.ItemConnection
+}
+
+func newItemConnectionsState() *itemConnectionsState {
+	return &itemConnectionsState{
+		connections: make(map[string][]fabcore.ItemConnection),
+	}
+}
+
+// addDataSource adds an unbound data source reference to the Semantic Model.
+func (s *itemConnectionsState) addDataSource(semanticModelID, connectionType, connectionPath string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.connections[semanticModelID] = append(s.connections[semanticModelID], fabcore.ItemConnection{
+		ConnectionDetails: &fabcore.ListConnectionDetails{
+			Type: new(connectionType),
+			Path: new(connectionPath),
+		},
+		ConnectivityType: new(fabcore.ConnectivityTypeNone),
+	})
+}
+
+func (s *itemConnectionsState) bind(semanticModelID string, binding fabsemanticmodel.ConnectionBinding) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for i, entity := range s.connections[semanticModelID] {
+		if *entity.ConnectionDetails.Type != *binding.ConnectionDetails.Type || *entity.ConnectionDetails.Path != *binding.ConnectionDetails.Path {
+			continue
+		}
+
+		entity.ConnectivityType = (*fabcore.ConnectivityType)(binding.ConnectivityType)
+		entity.ID = binding.ID
+		entity.DisplayName = nil
+		entity.GatewayID = nil
+
+		switch *binding.ConnectivityType {
+		case fabsemanticmodel.ConnectivityTypeNone:
+		case fabsemanticmodel.ConnectivityTypeAutomatic:
+			entity.ID = new(testhelp.RandomUUID())
+		default:
+			entity.DisplayName = new(testhelp.RandomName())
+		}
+
+		s.connections[semanticModelID][i] = entity
+
+		return true
+	}
+
+	return false
+}
+
+func (s *itemConnectionsState) list(semanticModelID string) ([]fabcore.ItemConnection, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	entities, ok := s.connections[semanticModelID]
+
+	return entities, ok
+}
+
+func configureFakes(state *itemConnectionsState) {
+	fakes.FakeServer.ServerFactory.SemanticModel.ItemsServer.BindSemanticModelConnection = fakeBindSemanticModelConnection(state)
+	fakes.FakeServer.ServerFactory.Core.ItemsServer.NewListItemConnectionsPager = fakeListItemConnections(state)
+}
+
+func fakeBindSemanticModelConnection(
+	state *itemConnectionsState,
+) func(ctx context.Context, workspaceID, semanticModelID string, bindSemanticModelConnectionRequest fabsemanticmodel.BindSemanticModelConnectionRequest, options *fabsemanticmodel.ItemsClientBindSemanticModelConnectionOptions) (resp azfake.Responder[fabsemanticmodel.ItemsClientBindSemanticModelConnectionResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _, semanticModelID string, bindSemanticModelConnectionRequest fabsemanticmodel.BindSemanticModelConnectionRequest, _ *fabsemanticmodel.ItemsClientBindSemanticModelConnectionOptions) (resp azfake.Responder[fabsemanticmodel.ItemsClientBindSemanticModelConnectionResponse], errResp azfake.ErrorResponder) {
+		if !state.bind(semanticModelID, *bindSemanticModelConnectionRequest.ConnectionBinding) {
+			errResp.SetError(fabfake.SetResponseError(http.StatusNotFound, fabcore.ErrCommon.EntityNotFound.Error(), fabcore.ErrCommon.EntityNotFound.Error()))
+
+			return resp, errResp
+		}
+
+		resp.SetResponse(http.StatusOK, fabsemanticmodel.ItemsClientBindSemanticModelConnectionResponse{}, nil)
+
+		return resp, errResp
+	}
+}
+
+func fakeListItemConnections(
+	state *itemConnectionsState,
+) func(workspaceID, itemID string, options *fabcore.ItemsClientListItemConnectionsOptions) (resp azfake.PagerResponder[fabcore.ItemsClientListItemConnectionsResponse]) {
+	return func(_, itemID string, _ *fabcore.ItemsClientListItemConnectionsOptions) (resp azfake.PagerResponder[fabcore.ItemsClientListItemConnectionsResponse]) {
+		entities, ok := state.list(itemID)
+		if !ok {
+			resp.AddError(fabfake.SetResponseError(http.StatusNotFound, fabcore.ErrCommon.EntityNotFound.Error(), fabcore.ErrCommon.EntityNotFound.Error()))
+
+			return resp
+		}
+
+		resp.AddPage(http.StatusOK, fabcore.ItemsClientListItemConnectionsResponse{ItemConnections: fabcore.ItemConnections{Value: entities}}, nil)
+
+		return resp
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package semanticmodelbinding
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	fabsemanticmodel "github.com/microsoft/fabric-sdk-go/fabric/semanticmodel"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+)
+
+/*
+RESOURCE
+*/
+
+type resourceSemanticModelConnectionBindingModel struct {
+	WorkspaceID           customtypes.UUID                                             `tfsdk:"workspace_id"`
+	SemanticModelID       customtypes.UUID                                             `tfsdk:"semantic_model_id"`
+	ConnectionDetails     supertypes.SingleNestedObjectValueOf[connectionDetailsModel] `tfsdk:"connection_details"`
+	ConnectivityType      types.String                                                 `tfsdk:"connectivity_type"`
+	ConnectionID          customtypes.UUID                                             `tfsdk:"connection_id"`
+	ConnectionDisplayName types.String                                                 `tfsdk:"connection_display_name"`
+	GatewayID             customtypes.UUID                                             `tfsdk:"gateway_id"`
+	Timeouts              timeouts.Value                                               `tfsdk:"timeouts"`
+}
+
+func (to *resourceSemanticModelConnectionBindingModel) set(from fabcore.ItemConnection) {
+	to.ConnectivityType = types.StringPointerValue((*string)(from.ConnectivityType))
+	to.ConnectionID = customtypes.NewUUIDNull()
+
+	// the implicit connection of an automatic binding is not managed
+	if from.ConnectivityType == nil || *from.ConnectivityType != fabcore.ConnectivityTypeAutomatic {
+		to.ConnectionID = customtypes.NewUUIDPointerValue(from.ID)
+	}
+
+	to.ConnectionDisplayName = types.StringPointerValue(from.DisplayName)
+	to.GatewayID = customtypes.NewUUIDPointerValue(from.GatewayID)
+}
+
+type requestBindSemanticModelConnection struct {
+	fabsemanticmodel.BindSemanticModelConnectionRequest
+}
+
+func (to *requestBindSemanticModelConnection) set(ctx context.Context, from resourceSemanticModelConnectionBindingModel) diag.Diagnostics {
+	connectionDetails, diags := from.ConnectionDetails.Get(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	to.ConnectionBinding = &fabsemanticmodel.ConnectionBinding{
+		ConnectionDetails: &fabsemanticmodel.ListConnectionDetails{
+			Type: connectionDetails.Type.ValueStringPointer(),
+			Path: connectionDetails.Path.ValueStringPointer(),
+		},
+		ConnectivityType: (*fabsemanticmodel.ConnectivityType)(from.ConnectivityType.ValueStringPointer()),
+		ID:               from.ConnectionID.ValueStringPointer(),
+	}
+
+	return nil
+}
+
+// unbind sets the request to unbind the data source reference from its Connection.
+func (to *requestBindSemanticModelConnection) unbind() {
+	to.ConnectionBinding.ConnectivityType = new(fabsemanticmodel.ConnectivityTypeNone)
+	to.ConnectionBinding.ID = nil
+}
+
+/*
+HELPER MODELS
+*/
+
+type connectionDetailsModel struct {
+	Type types.String `tfsdk:"type"`
+	Path types.String `tfsdk:"path"`
+}
+
+// matches returns true when the connection is the data source reference described by the model.
+func (m connectionDetailsModel) matches(from fabcore.ItemConnection) bool {
+	if from.ConnectionDetails == nil || from.ConnectionDetails.Type == nil || from.ConnectionDetails.Path == nil {
+		return false
+	}
+
+	return *from.ConnectionDetails.Type == m.Type.ValueString() && *from.ConnectionDetails.Path == m.Path.ValueString()
+}
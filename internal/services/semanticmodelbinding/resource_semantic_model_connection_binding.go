@@ -0,0 +1,299 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package semanticmodelbinding
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	fabsemanticmodel "github.com/microsoft/fabric-sdk-go/fabric/semanticmodel"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithConfigure = (*resourceSemanticModelConnectionBinding)(nil)
+
+type resourceSemanticModelConnectionBinding struct {
+	pConfigData *pconfig.ProviderData
+	client      *fabsemanticmodel.ItemsClient
+	clientItems *fabcore.ItemsClient
+	TypeInfo    tftypeinfo.TFTypeInfo
+}
+
+func NewResourceSemanticModelConnectionBinding() resource.Resource {
+	return &resourceSemanticModelConnectionBinding{
+		TypeInfo: ItemTypeInfo,
+	}
+}
+
+func (r *resourceSemanticModelConnectionBinding) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeInfo.FullTypeName(false)
+}
+
+func (r *resourceSemanticModelConnectionBinding) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = itemSchema().GetResource(ctx)
+}
+
+func (r *resourceSemanticModelConnectionBinding) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorResourceConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	r.pConfigData = pConfigData
+
+	if resp.Diagnostics.Append(fabricitem.IsPreviewMode(r.TypeInfo.Name, r.TypeInfo.IsPreview, r.pConfigData.Preview)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client = fabsemanticmodel.NewClientFactoryWithClient(*pConfigData.FabricClient).NewItemsClient()
+	r.clientItems = fabcore.NewClientFactoryWithClient(*pConfigData.FabricClient).NewItemsClient()
+}
+
+func (r *resourceSemanticModelConnectionBinding) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan resourceSemanticModelConnectionBindingModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if resp.Diagnostics.Append(r.bind(ctx, &plan, utils.OperationCreate)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceSemanticModelConnectionBinding) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "start",
+	})
+
+	var state resourceSemanticModelConnectionBindingModel
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	found, diags := r.get(ctx, &state)
+	if utils.IsErrNotFound(state.SemanticModelID.ValueString(), &diags, fabcore.ErrCommon.EntityNotFound) {
+		resp.State.RemoveResource(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		return
+	}
+
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.Diagnostics.AddWarning(
+			"Resource not found",
+			fmt.Sprintf("%s. It may have been unbound outside of Terraform. Removing object from state.", notBoundDetails(ctx, state)),
+		)
+
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceSemanticModelConnectionBinding) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan resourceSemanticModelConnectionBindingModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if resp.Diagnostics.Append(r.bind(ctx, &plan, utils.OperationUpdate)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceSemanticModelConnectionBinding) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "start",
+	})
+
+	var state resourceSemanticModelConnectionBindingModel
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var reqBind requestBindSemanticModelConnection
+
+	if resp.Diagnostics.Append(reqBind.set(ctx, state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBind.unbind()
+
+	_, err := r.client.BindSemanticModelConnection(ctx, state.WorkspaceID.ValueString(), state.SemanticModelID.ValueString(), reqBind.BindSemanticModelConnectionRequest, nil)
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationDelete, fabcore.ErrCommon.EntityNotFound); diags.HasError() {
+		if utils.IsErr(diags, fabcore.ErrCommon.EntityNotFound) {
+			return
+		}
+
+		resp.Diagnostics.Append(diags...)
+
+		return
+	}
+
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "end",
+	})
+}
+
+func (r *resourceSemanticModelConnectionBinding) bind(ctx context.Context, model *resourceSemanticModelConnectionBindingModel, operation utils.Operation) diag.Diagnostics {
+	var reqBind requestBindSemanticModelConnection
+
+	if diags := reqBind.set(ctx, *model); diags.HasError() {
+		return diags
+	}
+
+	_, err := r.client.BindSemanticModelConnection(ctx, model.WorkspaceID.ValueString(), model.SemanticModelID.ValueString(), reqBind.BindSemanticModelConnectionRequest, nil)
+	if diags := utils.GetDiagsFromError(ctx, err, operation, nil); diags.HasError() {
+		return diags
+	}
+
+	found, diags := r.get(ctx, model)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !found {
+		diags.AddError(common.ErrorReadHeader, notBoundDetails(ctx, *model))
+	}
+
+	return diags
+}
+
+// get reads the Connection bound to the data source reference of the model, it returns false when the data source reference is not bound.
+func (r *resourceSemanticModelConnectionBinding) get(ctx context.Context, model *resourceSemanticModelConnectionBindingModel) (bool, diag.Diagnostics) {
+	connectionDetails, diags := model.ConnectionDetails.Get(ctx)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	pager := r.clientItems.NewListItemConnectionsPager(model.WorkspaceID.ValueString(), model.SemanticModelID.ValueString(), nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if diags := utils.GetDiagsFromError(ctx, err, utils.OperationRead, fabcore.ErrCommon.EntityNotFound); diags.HasError() {
+			return false, diags
+		}
+
+		for _, entity := range page.Value {
+			if !connectionDetails.matches(entity) {
+				continue
+			}
+
+			// an unbound data source reference is reported with the None connectivity type
+			if entity.ConnectivityType == nil || *entity.ConnectivityType == fabcore.ConnectivityTypeNone {
+				return false, nil
+			}
+
+			model.set(entity)
+
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func notBoundDetails(ctx context.Context, model resourceSemanticModelConnectionBindingModel) string {
+	connectionDetails, _ := model.ConnectionDetails.Get(ctx)
+
+	return fmt.Sprintf("The data source reference %s '%s' of the Semantic Model %s is not bound to a Connection",
+		connectionDetails.Type.ValueString(), connectionDetails.Path.ValueString(), model.SemanticModelID.ValueString())
+}
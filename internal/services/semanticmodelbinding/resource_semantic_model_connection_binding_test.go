@@ -0,0 +1,219 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package semanticmodelbinding_test
+
+import (
+	"regexp"
+	"testing"
+
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	fabsemanticmodel "github.com/microsoft/fabric-sdk-go/fabric/semanticmodel"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testResourceItemFQN, testResourceItemHeader = testhelp.TFResource(common.ProviderTypeName, itemTypeInfo.Type, "test")
+
+func TestUnit_SemanticModelConnectionBindingResource_Attributes(t *testing.T) {
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no attributes
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{},
+			),
+			ExpectError: regexp.MustCompile(`Missing required argument`),
+		},
+		// error - unexpected attribute
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":      testhelp.RandomUUID(),
+					"semantic_model_id": testhelp.RandomUUID(),
+					"connection_details": map[string]any{
+						"type": "SQL",
+						"path": "server;database",
+					},
+					"connectivity_type": string(fabsemanticmodel.ConnectivityTypeShareableCloud),
+					"connection_id":     testhelp.RandomUUID(),
+					"unexpected_attr":   "test",
+				},
+			),
+			ExpectError: regexp.MustCompile(`An argument named "unexpected_attr" is not expected here`),
+		},
+		// error - invalid UUID - semantic_model_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":      testhelp.RandomUUID(),
+					"semantic_model_id": "invalid uuid",
+					"connection_details": map[string]any{
+						"type": "SQL",
+						"path": "server;database",
+					},
+					"connectivity_type": string(fabsemanticmodel.ConnectivityTypeShareableCloud),
+					"connection_id":     testhelp.RandomUUID(),
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - invalid connectivity_type
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":      testhelp.RandomUUID(),
+					"semantic_model_id": testhelp.RandomUUID(),
+					"connection_details": map[string]any{
+						"type": "SQL",
+						"path": "server;database",
+					},
+					"connectivity_type": string(fabsemanticmodel.ConnectivityTypeNone),
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorAttValueMatch),
+		},
+		// error - missing connection_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":      testhelp.RandomUUID(),
+					"semantic_model_id": testhelp.RandomUUID(),
+					"connection_details": map[string]any{
+						"type": "SQL",
+						"path": "server;database",
+					},
+					"connectivity_type": string(fabsemanticmodel.ConnectivityTypeShareableCloud),
+				},
+			),
+			ExpectError: regexp.MustCompile(`Invalid configuration for attribute connection_id`),
+		},
+		// error - connection_id with Automatic connectivity_type
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":      testhelp.RandomUUID(),
+					"semantic_model_id": testhelp.RandomUUID(),
+					"connection_details": map[string]any{
+						"type": "SQL",
+						"path": "server;database",
+					},
+					"connectivity_type": string(fabsemanticmodel.ConnectivityTypeAutomatic),
+					"connection_id":     testhelp.RandomUUID(),
+				},
+			),
+			ExpectError: regexp.MustCompile(`Invalid configuration for attribute connection_id`),
+		},
+	}))
+}
+
+func TestUnit_SemanticModelConnectionBindingResource_CRUD(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	semanticModelID := testhelp.RandomUUID()
+	connectionID := testhelp.RandomUUID()
+	updatedConnectionID := testhelp.RandomUUID()
+
+	state := newItemConnectionsState()
+	state.addDataSource(semanticModelID, "SQL", "server;database")
+
+	configureFakes(state)
+
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - unknown data source reference
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":      workspaceID,
+					"semantic_model_id": semanticModelID,
+					"connection_details": map[string]any{
+						"type": "SQL",
+						"path": "server;unknown",
+					},
+					"connectivity_type": string(fabsemanticmodel.ConnectivityTypeShareableCloud),
+					"connection_id":     connectionID,
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorCreateHeader),
+		},
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":      workspaceID,
+					"semantic_model_id": semanticModelID,
+					"connection_details": map[string]any{
+						"type": "SQL",
+						"path": "server;database",
+					},
+					"connectivity_type": string(fabsemanticmodel.ConnectivityTypeShareableCloud),
+					"connection_id":     connectionID,
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "connectivity_type", string(fabsemanticmodel.ConnectivityTypeShareableCloud)),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "connection_id", connectionID),
+				resource.TestCheckResourceAttrSet(testResourceItemFQN, "connection_display_name"),
+				resource.TestCheckNoResourceAttr(testResourceItemFQN, "gateway_id"),
+			),
+		},
+		// Update and Read - connection
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":      workspaceID,
+					"semantic_model_id": semanticModelID,
+					"connection_details": map[string]any{
+						"type": "SQL",
+						"path": "server;database",
+					},
+					"connectivity_type": string(fabsemanticmodel.ConnectivityTypeShareableCloud),
+					"connection_id":     updatedConnectionID,
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "connection_id", updatedConnectionID),
+			),
+		},
+		// Update and Read - automatic
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":      workspaceID,
+					"semantic_model_id": semanticModelID,
+					"connection_details": map[string]any{
+						"type": "SQL",
+						"path": "server;database",
+					},
+					"connectivity_type": string(fabsemanticmodel.ConnectivityTypeAutomatic),
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "connectivity_type", string(fabsemanticmodel.ConnectivityTypeAutomatic)),
+				resource.TestCheckNoResourceAttr(testResourceItemFQN, "connection_id"),
+			),
+		},
+	}))
+}
@@ -0,0 +1,143 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package semanticmodelbinding
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema" //revive:disable-line:import-alias-naming
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fabsemanticmodel "github.com/microsoft/fabric-sdk-go/fabric/semanticmodel"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+	superstringvalidator "github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func itemSchema() superschema.Schema {
+	markdownDescriptionR := fabricitem.NewResourceMarkdownDescription(ItemTypeInfo, false) +
+		"\n\n-> Each resource binds one data source reference of the Semantic Model, identified by its `connection_details`, to a Connection. " +
+		"Destroying the resource unbinds the data source reference."
+
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: markdownDescriptionR,
+		},
+		Attributes: map[string]superschema.Attribute{
+			"workspace_id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The Workspace ID.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"semantic_model_id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The Semantic Model ID.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"connection_details": superschema.SuperSingleNestedAttributeOf[connectionDetailsModel]{
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The details of the data source reference of the Semantic Model to bind, as defined in the Semantic Model definition.",
+					Required:            true,
+					PlanModifiers: []planmodifier.Object{
+						objectplanmodifier.RequiresReplace(),
+					},
+				},
+				Attributes: superschema.Attributes{
+					"type": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The type of the data source reference. For example `SQL` or `Web`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+					"path": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The path of the data source reference. For example `server.database.windows.net;database`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"connectivity_type": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The connectivity type of the Connection.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(utils.ConvertEnumsToStringSlices(possibleConnectivityTypeValues, true)...),
+					},
+				},
+			},
+			"connection_id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the Connection to bind the data source reference to.",
+					CustomType:          customtypes.UUIDType{},
+					Optional:            true,
+					Validators: []validator.String{
+						superstringvalidator.RequireIfAttributeIsOneOf(path.MatchRoot("connectivity_type"), connectionRequiredValues()),
+						superstringvalidator.NullIfAttributeIsOneOf(path.MatchRoot("connectivity_type"),
+							[]attr.Value{
+								types.StringValue(string(fabsemanticmodel.ConnectivityTypeAutomatic)),
+							}),
+					},
+				},
+			},
+			"connection_display_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The display name of the bound Connection.",
+					Computed:            true,
+				},
+			},
+			"gateway_id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the Gateway of the bound Connection, when it connects through a Gateway.",
+					CustomType:          customtypes.UUIDType{},
+					Computed:            true,
+				},
+			},
+			"timeouts": superschema.TimeoutAttribute{
+				Resource: &superschema.ResourceTimeoutAttribute{
+					Create: true,
+					Read:   true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		},
+	}
+}
+
+// connectionRequiredValues returns the connectivity types that require an explicit Connection.
+func connectionRequiredValues() []attr.Value {
+	values := make([]attr.Value, 0, len(possibleConnectivityTypeValues))
+
+	for _, v := range possibleConnectivityTypeValues {
+		if v != fabsemanticmodel.ConnectivityTypeAutomatic {
+			values = append(values, types.StringValue(string(v)))
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,166 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package semanticmodelrefresh
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/powerbi"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+var _ action.ActionWithConfigure = (*actionSemanticModelRefresh)(nil)
+
+type actionSemanticModelRefresh struct {
+	pConfigData *pconfig.ProviderData
+	TypeInfo    tftypeinfo.TFTypeInfo
+}
+
+func NewActionSemanticModelRefresh() action.Action {
+	return &actionSemanticModelRefresh{
+		TypeInfo: ItemTypeInfo,
+	}
+}
+
+func (a *actionSemanticModelRefresh) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = a.TypeInfo.FullTypeName(false)
+}
+
+func (a *actionSemanticModelRefresh) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = itemSchema()
+}
+
+func (a *actionSemanticModelRefresh) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorActionConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	a.pConfigData = pConfigData
+
+	if resp.Diagnostics.Append(fabricitem.IsPreviewMode(a.TypeInfo.Name, a.TypeInfo.IsPreview, a.pConfigData.Preview)...); resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (a *actionSemanticModelRefresh) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Debug(ctx, "INVOKE", map[string]any{
+		"action": "start",
+	})
+
+	var config actionSemanticModelRefreshModel
+
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &config)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, a.pConfigData.Timeout)
+	defer cancel()
+
+	client, diags := a.powerbiClient()
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := config.WorkspaceID.ValueString()
+	semanticModelID := config.SemanticModelID.ValueString()
+
+	var reqRefresh requestRefreshSemanticModel
+
+	reqRefresh.set(config)
+
+	refreshID, err := client.Refresh(ctx, workspaceID, semanticModelID, reqRefresh.RefreshRequest)
+	if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationCreate, nil)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for the %s refresh %s of Semantic Model %s to complete", *reqRefresh.Type, refreshID, semanticModelID),
+	})
+
+	respRefresh, err := client.WaitForRefresh(ctx, workspaceID, semanticModelID, refreshID)
+	if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationRead, nil)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if respRefresh.Status == nil || *respRefresh.Status != powerbi.RefreshStatusCompleted {
+		resp.Diagnostics.AddError(
+			common.ErrorSemanticModelRefreshHeader,
+			fmt.Sprintf(
+				common.ErrorSemanticModelRefreshDetails,
+				refreshID,
+				semanticModelID,
+				utils.ValueOrZero(respRefresh.Status),
+				getRefreshFailures(respRefresh.Messages),
+			),
+		)
+
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Semantic Model %s refreshed (refresh %s)", semanticModelID, refreshID),
+	})
+
+	tflog.Debug(ctx, "INVOKE", map[string]any{
+		"action": "end",
+	})
+}
+
+func (a *actionSemanticModelRefresh) powerbiClient() (*powerbi.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	client, err := powerbi.NewClient(a.pConfigData.Credential, a.pConfigData.Cloud, a.pConfigData.Version, &powerbi.ClientOptions{
+		ClientOptions: a.pConfigData.ClientOptions,
+	})
+	if err != nil {
+		diags.AddError(common.ErrorPowerBIHeader, err.Error())
+	}
+
+	return client, diags
+}
+
+// getRefreshFailures returns a description of the messages reported by a refresh that did not complete.
+func getRefreshFailures(messages []powerbi.RefreshMessage) string {
+	failures := make([]string, 0, len(messages))
+
+	for _, message := range messages {
+		if message.Message == nil {
+			continue
+		}
+
+		if message.Code != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", *message.Code, *message.Message))
+
+			continue
+		}
+
+		failures = append(failures, *message.Message)
+	}
+
+	if len(failures) == 0 {
+		return "no error was reported"
+	}
+
+	return strings.Join(failures, "; ")
+}
@@ -0,0 +1,89 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package semanticmodelrefresh_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testActionItemFQN, testActionItemHeader = testhelp.TFAction(common.ProviderTypeName, itemTypeInfo.Type, "test")
+
+func TestUnit_SemanticModelRefreshAction_Attributes(t *testing.T) {
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testActionItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no attributes
+		{
+			Config: testhelp.TFActionConfig(
+				testActionItemHeader,
+				map[string]any{},
+			),
+			ExpectError: regexp.MustCompile(`The argument "workspace_id" is required, but no definition was found.`),
+		},
+		// error - unexpected attribute
+		{
+			Config: testhelp.TFActionConfig(
+				testActionItemHeader,
+				map[string]any{
+					"workspace_id":      testhelp.RandomUUID(),
+					"semantic_model_id": testhelp.RandomUUID(),
+					"unexpected_attr":   "test",
+				},
+			),
+			ExpectError: regexp.MustCompile(`An argument named "unexpected_attr" is not expected here`),
+		},
+		// error - invalid UUID - workspace_id
+		{
+			Config: testhelp.TFActionConfig(
+				testActionItemHeader,
+				map[string]any{
+					"workspace_id":      "invalid uuid",
+					"semantic_model_id": testhelp.RandomUUID(),
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - invalid UUID - semantic_model_id
+		{
+			Config: testhelp.TFActionConfig(
+				testActionItemHeader,
+				map[string]any{
+					"workspace_id":      testhelp.RandomUUID(),
+					"semantic_model_id": "invalid uuid",
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - invalid type
+		{
+			Config: testhelp.TFActionConfig(
+				testActionItemHeader,
+				map[string]any{
+					"workspace_id":      testhelp.RandomUUID(),
+					"semantic_model_id": testhelp.RandomUUID(),
+					"type":              "Partial",
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorAttValueMatch),
+		},
+		// error - invalid retry_count
+		{
+			Config: testhelp.TFActionConfig(
+				testActionItemHeader,
+				map[string]any{
+					"workspace_id":      testhelp.RandomUUID(),
+					"semantic_model_id": testhelp.RandomUUID(),
+					"retry_count":       -1,
+				},
+			),
+			ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+		},
+	}))
+}
@@ -0,0 +1,16 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package semanticmodelrefresh
+
+import (
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+)
+
+var ItemTypeInfo = tftypeinfo.TFTypeInfo{ //nolint:gochecknoglobals
+	Name:           "Semantic Model Refresh",
+	Type:           "semantic_model_refresh",
+	DocsURL:        "https://learn.microsoft.com/power-bi/connect-data/asynchronous-refresh",
+	IsPreview:      true,
+	IsSPNSupported: true,
+}
@@ -0,0 +1,36 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package semanticmodelrefresh
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/powerbi"
+)
+
+/*
+ACTION
+*/
+
+type actionSemanticModelRefreshModel struct {
+	WorkspaceID     customtypes.UUID `tfsdk:"workspace_id"`
+	SemanticModelID customtypes.UUID `tfsdk:"semantic_model_id"`
+	Type            types.String     `tfsdk:"type"`
+	RetryCount      types.Int32      `tfsdk:"retry_count"`
+}
+
+type requestRefreshSemanticModel struct {
+	powerbi.RefreshRequest
+}
+
+func (to *requestRefreshSemanticModel) set(from actionSemanticModelRefreshModel) {
+	to.Type = new(powerbi.RefreshTypeFull)
+
+	if !from.Type.IsNull() && !from.Type.IsUnknown() {
+		to.Type = (*powerbi.RefreshType)(from.Type.ValueStringPointer())
+	}
+
+	to.RetryCount = from.RetryCount.ValueInt32Pointer()
+}
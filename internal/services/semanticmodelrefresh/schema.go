@@ -0,0 +1,52 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package semanticmodelrefresh
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/powerbi"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func itemSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "The " + ItemTypeInfo.Name + " action allows you to refresh a Fabric Semantic Model, with an [enhanced refresh](" + ItemTypeInfo.DocsURL + ") of the Power BI REST API." +
+			"\n\nThe action waits for the refresh to complete, and fails with the refresh messages when the refresh does not complete. " +
+			"Trigger it after a new definition is deployed, with the `action_trigger` block of the Semantic Model lifecycle." +
+			"\n\n-> The refresh is started with the Power BI REST API, the provider credential must be allowed to use it. This action supports Service Principal authentication." +
+			"\n\n~> This action is in **preview**. To use it, you must explicitly enable the `preview` mode in the provider level configuration.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "The Workspace ID.",
+				CustomType:          customtypes.UUIDType{},
+				Required:            true,
+			},
+			"semantic_model_id": schema.StringAttribute{
+				MarkdownDescription: "The Semantic Model ID.",
+				CustomType:          customtypes.UUIDType{},
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of processing to perform. Value must be one of : " +
+					utils.ConvertStringSlicesToString(powerbi.PossibleRefreshTypeValues(), true, true) + ". Defaults to `" + string(powerbi.RefreshTypeFull) + "`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.ConvertEnumsToStringSlices(powerbi.PossibleRefreshTypeValues(), true)...),
+				},
+			},
+			"retry_count": schema.Int32Attribute{
+				MarkdownDescription: "The number of times the service retries the refresh before it fails.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(0, 10),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package semanticmodelrefreshschedule
+
+import (
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+)
+
+var ItemTypeInfo = tftypeinfo.TFTypeInfo{ //nolint:gochecknoglobals
+	Name:           "Semantic Model Refresh Schedule",
+	Type:           "semantic_model_refresh_schedule",
+	DocsURL:        "https://learn.microsoft.com/rest/api/power-bi/datasets/update-refresh-schedule-in-group",
+	IsPreview:      true,
+	IsSPNSupported: true,
+}
@@ -0,0 +1,92 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package semanticmodelrefreshschedule
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/powerbi"
+)
+
+/*
+RESOURCE
+*/
+
+type resourceSemanticModelRefreshScheduleModel struct {
+	WorkspaceID     customtypes.UUID                    `tfsdk:"workspace_id"`
+	SemanticModelID customtypes.UUID                    `tfsdk:"semantic_model_id"`
+	Enabled         types.Bool                          `tfsdk:"enabled"`
+	Days            supertypes.SetValueOf[types.String] `tfsdk:"days"`
+	Times           supertypes.SetValueOf[types.String] `tfsdk:"times"`
+	LocalTimeZoneID types.String                        `tfsdk:"local_time_zone_id"`
+	NotifyOption    types.String                        `tfsdk:"notify_option"`
+	Timeouts        timeouts.Value                      `tfsdk:"timeouts"`
+}
+
+func (to *resourceSemanticModelRefreshScheduleModel) set(ctx context.Context, from powerbi.RefreshSchedule) diag.Diagnostics {
+	to.Enabled = types.BoolPointerValue(from.Enabled)
+	to.LocalTimeZoneID = types.StringPointerValue(from.LocalTimeZoneID)
+	to.NotifyOption = types.StringPointerValue((*string)(from.NotifyOption))
+
+	if diags := to.Days.Set(ctx, stringValues(from.Days)); diags.HasError() {
+		return diags
+	}
+
+	return to.Times.Set(ctx, stringValues(from.Times))
+}
+
+type requestUpdateRefreshSchedule struct {
+	powerbi.RefreshSchedule
+}
+
+func (to *requestUpdateRefreshSchedule) set(ctx context.Context, from resourceSemanticModelRefreshScheduleModel) diag.Diagnostics {
+	days, diags := from.Days.Get(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	times, diags := from.Times.Get(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	to.Days = valueStrings(days)
+	to.Times = valueStrings(times)
+	to.Enabled = from.Enabled.ValueBoolPointer()
+	to.LocalTimeZoneID = from.LocalTimeZoneID.ValueStringPointer()
+	to.NotifyOption = (*powerbi.NotifyOption)(from.NotifyOption.ValueStringPointer())
+
+	return nil
+}
+
+// disable sets the request to disable the refresh schedule, the days and times are kept by the service.
+func (to *requestUpdateRefreshSchedule) disable() {
+	to.RefreshSchedule = powerbi.RefreshSchedule{
+		Enabled: new(false),
+	}
+}
+
+func stringValues(from []string) []types.String {
+	result := make([]types.String, 0, len(from))
+	for _, v := range from {
+		result = append(result, types.StringValue(v))
+	}
+
+	return result
+}
+
+func valueStrings(from []types.String) []string {
+	result := make([]string, 0, len(from))
+	for _, v := range from {
+		result = append(result, v.ValueString())
+	}
+
+	return result
+}
@@ -0,0 +1,266 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package semanticmodelrefreshschedule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/powerbi"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithConfigure = (*resourceSemanticModelRefreshSchedule)(nil)
+
+type resourceSemanticModelRefreshSchedule struct {
+	pConfigData *pconfig.ProviderData
+	TypeInfo    tftypeinfo.TFTypeInfo
+}
+
+func NewResourceSemanticModelRefreshSchedule() resource.Resource {
+	return &resourceSemanticModelRefreshSchedule{
+		TypeInfo: ItemTypeInfo,
+	}
+}
+
+func (r *resourceSemanticModelRefreshSchedule) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeInfo.FullTypeName(false)
+}
+
+func (r *resourceSemanticModelRefreshSchedule) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = itemSchema().GetResource(ctx)
+}
+
+func (r *resourceSemanticModelRefreshSchedule) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorResourceConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	r.pConfigData = pConfigData
+
+	if resp.Diagnostics.Append(fabricitem.IsPreviewMode(r.TypeInfo.Name, r.TypeInfo.IsPreview, r.pConfigData.Preview)...); resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceSemanticModelRefreshSchedule) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan resourceSemanticModelRefreshScheduleModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if resp.Diagnostics.Append(r.update(ctx, &plan, utils.OperationCreate)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceSemanticModelRefreshSchedule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "start",
+	})
+
+	var state resourceSemanticModelRefreshScheduleModel
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, diags := r.powerbiClient()
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	respGet, err := client.GetRefreshSchedule(ctx, state.WorkspaceID.ValueString(), state.SemanticModelID.ValueString())
+	if powerbi.IsNotFoundError(err) {
+		resp.Diagnostics.AddWarning(
+			"Resource not found",
+			fmt.Sprintf("Semantic Model with ID %s not found. It may have been deleted outside of Terraform. Removing object from state.", state.SemanticModelID.ValueString()),
+		)
+
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationRead, nil)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(state.set(ctx, *respGet)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceSemanticModelRefreshSchedule) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan resourceSemanticModelRefreshScheduleModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if resp.Diagnostics.Append(r.update(ctx, &plan, utils.OperationUpdate)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceSemanticModelRefreshSchedule) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "start",
+	})
+
+	var state resourceSemanticModelRefreshScheduleModel
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, diags := r.powerbiClient()
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// a Semantic Model always has a refresh schedule, it is disabled instead
+	var reqUpdate requestUpdateRefreshSchedule
+
+	reqUpdate.disable()
+
+	err := client.UpdateRefreshSchedule(ctx, state.WorkspaceID.ValueString(), state.SemanticModelID.ValueString(), reqUpdate.RefreshSchedule)
+	if err != nil && !powerbi.IsNotFoundError(err) {
+		resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationDelete, nil)...)
+
+		return
+	}
+
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "end",
+	})
+}
+
+func (r *resourceSemanticModelRefreshSchedule) update(ctx context.Context, model *resourceSemanticModelRefreshScheduleModel, operation utils.Operation) diag.Diagnostics {
+	client, diags := r.powerbiClient()
+	if diags.HasError() {
+		return diags
+	}
+
+	var reqUpdate requestUpdateRefreshSchedule
+
+	if diags := reqUpdate.set(ctx, *model); diags.HasError() {
+		return diags
+	}
+
+	err := client.UpdateRefreshSchedule(ctx, model.WorkspaceID.ValueString(), model.SemanticModelID.ValueString(), reqUpdate.RefreshSchedule)
+	if diags := utils.GetDiagsFromError(ctx, err, operation, nil); diags.HasError() {
+		return diags
+	}
+
+	respGet, err := client.GetRefreshSchedule(ctx, model.WorkspaceID.ValueString(), model.SemanticModelID.ValueString())
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationRead, nil); diags.HasError() {
+		return diags
+	}
+
+	return model.set(ctx, *respGet)
+}
+
+func (r *resourceSemanticModelRefreshSchedule) powerbiClient() (*powerbi.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	client, err := powerbi.NewClient(r.pConfigData.Credential, r.pConfigData.Cloud, r.pConfigData.Version, &powerbi.ClientOptions{
+		ClientOptions: r.pConfigData.ClientOptions,
+	})
+	if err != nil {
+		diags.AddError(common.ErrorPowerBIHeader, err.Error())
+	}
+
+	return client, diags
+}
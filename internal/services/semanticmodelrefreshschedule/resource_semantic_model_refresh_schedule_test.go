@@ -0,0 +1,171 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package semanticmodelrefreshschedule_test
+
+import (
+	"regexp"
+	"testing"
+
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testResourceItemFQN, testResourceItemHeader = testhelp.TFResource(common.ProviderTypeName, itemTypeInfo.Type, "test")
+
+func TestUnit_SemanticModelRefreshScheduleResource_Attributes(t *testing.T) {
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no attributes
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{},
+			),
+			ExpectError: regexp.MustCompile(`Missing required argument`),
+		},
+		// error - unexpected attribute
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":      testhelp.RandomUUID(),
+					"semantic_model_id": testhelp.RandomUUID(),
+					"days":              []string{"Monday"},
+					"times":             []string{"07:00"},
+					"unexpected_attr":   "test",
+				},
+			),
+			ExpectError: regexp.MustCompile(`An argument named "unexpected_attr" is not expected here`),
+		},
+		// error - invalid UUID - workspace_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":      "invalid uuid",
+					"semantic_model_id": testhelp.RandomUUID(),
+					"days":              []string{"Monday"},
+					"times":             []string{"07:00"},
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - invalid UUID - semantic_model_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":      testhelp.RandomUUID(),
+					"semantic_model_id": "invalid uuid",
+					"days":              []string{"Monday"},
+					"times":             []string{"07:00"},
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - invalid day
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":      testhelp.RandomUUID(),
+					"semantic_model_id": testhelp.RandomUUID(),
+					"days":              []string{"Someday"},
+					"times":             []string{"07:00"},
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorAttValueMatch),
+		},
+		// error - invalid time
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":      testhelp.RandomUUID(),
+					"semantic_model_id": testhelp.RandomUUID(),
+					"days":              []string{"Monday"},
+					"times":             []string{"07:15"},
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorAttValueMatch),
+		},
+		// error - invalid notify_option
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":      testhelp.RandomUUID(),
+					"semantic_model_id": testhelp.RandomUUID(),
+					"days":              []string{"Monday"},
+					"times":             []string{"07:00"},
+					"notify_option":     "Always",
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorAttValueMatch),
+		},
+	}))
+}
+
+func TestAcc_SemanticModelRefreshScheduleResource_CRUD(t *testing.T) {
+	workspace := testhelp.WellKnown()["WorkspaceDS"].(map[string]any)
+	workspaceID := workspace["id"].(string)
+
+	entity := testhelp.WellKnown()["SemanticModel"].(map[string]any)
+	entityID := entity["id"].(string)
+
+	resource.Test(t, testhelp.NewTestAccCase(t, &testResourceItemFQN, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":      workspaceID,
+					"semantic_model_id": entityID,
+					"days":              []string{"Monday", "Thursday"},
+					"times":             []string{"07:00"},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "enabled", "true"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "days.#", "2"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "times.#", "1"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "local_time_zone_id", "UTC"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "notify_option", "NoNotification"),
+			),
+		},
+		// Update and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":       workspaceID,
+					"semantic_model_id":  entityID,
+					"enabled":            false,
+					"days":               []string{"Sunday"},
+					"times":              []string{"06:30", "18:00"},
+					"local_time_zone_id": "Pacific Standard Time",
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "enabled", "false"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "days.#", "1"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "times.#", "2"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "local_time_zone_id", "Pacific Standard Time"),
+			),
+		},
+	}))
+}
@@ -0,0 +1,133 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package semanticmodelrefreshschedule
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema" //revive:disable-line:import-alias-naming
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/powerbi"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func itemSchema() superschema.Schema {
+	markdownDescriptionR := fabricitem.NewResourceMarkdownDescription(ItemTypeInfo, false) +
+		"\n\n-> The refresh schedule is managed with the Power BI REST API, the provider credential must be allowed to use it. " +
+		"Destroying the resource disables the refresh schedule."
+
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: markdownDescriptionR,
+		},
+		Attributes: map[string]superschema.Attribute{
+			"workspace_id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The Workspace ID.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"semantic_model_id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The Semantic Model ID.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"enabled": superschema.BoolAttribute{
+				Resource: &schemaR.BoolAttribute{
+					MarkdownDescription: "Whether the refresh schedule is enabled.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(true),
+				},
+			},
+			"days": superschema.SuperSetAttribute{
+				Resource: &schemaR.SetAttribute{
+					MarkdownDescription: "The days to run the refresh on.",
+					Required:            true,
+					CustomType: supertypes.SetTypeOf[types.String]{
+						SetType: basetypes.SetType{
+							ElemType: types.StringType,
+						},
+					},
+					ElementType: types.StringType,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+						setvalidator.ValueStringsAre(stringvalidator.OneOf(powerbi.Weekdays...)),
+					},
+				},
+			},
+			"times": superschema.SuperSetAttribute{
+				Resource: &schemaR.SetAttribute{
+					MarkdownDescription: "The times of the day to run the refresh at, in the `hh:mm` format with `00` or `30` minutes. For example `07:00` or `18:30`.",
+					Required:            true,
+					CustomType: supertypes.SetTypeOf[types.String]{
+						SetType: basetypes.SetType{
+							ElemType: types.StringType,
+						},
+					},
+					ElementType: types.StringType,
+					Validators: []validator.Set{
+						setvalidator.SizeBetween(1, 48),
+						setvalidator.ValueStringsAre(
+							stringvalidator.RegexMatches(regexp.MustCompile(`^([01]\d|2[0-3]):(00|30)$`), "Value must be a time in the hh:mm format, with 00 or 30 minutes."),
+						),
+					},
+				},
+			},
+			"local_time_zone_id": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the time zone of the `times`, as a Windows time zone ID. For example `UTC` or `Pacific Standard Time`.",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString("UTC"),
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+			},
+			"notify_option": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The notification option when a scheduled refresh fails. Service Principals cannot use `" +
+						string(powerbi.NotifyOptionMailOnFailure) + "`.",
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString(string(powerbi.NotifyOptionNoNotification)),
+					Validators: []validator.String{
+						stringvalidator.OneOf(utils.ConvertEnumsToStringSlices(powerbi.PossibleNotifyOptionValues(), true)...),
+					},
+				},
+			},
+			"timeouts": superschema.TimeoutAttribute{
+				Resource: &superschema.ResourceTimeoutAttribute{
+					Create: true,
+					Read:   true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		},
+	}
+}
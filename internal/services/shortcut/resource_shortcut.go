@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
@@ -28,6 +29,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure        = (*resourceShortcut)(nil)
 	_ resource.ResourceWithImportState      = (*resourceShortcut)(nil)
+	_ resource.ResourceWithIdentity         = (*resourceShortcut)(nil)
 	_ resource.ResourceWithConfigValidators = (*resourceShortcut)(nil)
 )
 
@@ -51,6 +53,31 @@ func (r *resourceShortcut) Schema(ctx context.Context, _ resource.SchemaRequest,
 	resp.Schema = itemSchema(false).GetResource(ctx)
 }
 
+func (r *resourceShortcut) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_id": identityschema.StringAttribute{
+				Description:       "The Workspace ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+			"item_id": identityschema.StringAttribute{
+				Description:       "The Item ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+			"path": identityschema.StringAttribute{
+				Description:       "The Shortcut path.",
+				RequiredForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "The Shortcut name.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *resourceShortcut) ConfigValidators(_ context.Context) []resource.ConfigValidator {
 	return []resource.ConfigValidator{
 		resourcevalidator.ExactlyOneOf(
@@ -138,6 +165,7 @@ func (r *resourceShortcut) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "CREATE", map[string]any{
 		"action": "end",
@@ -187,6 +215,7 @@ func (r *resourceShortcut) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "UPDATE", map[string]any{"action": "end"})
 }
@@ -224,6 +253,7 @@ func (r *resourceShortcut) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "READ", map[string]any{
 		"action": "end",
@@ -273,7 +303,12 @@ func (r *resourceShortcut) ImportState(ctx context.Context, req resource.ImportS
 		"id": req.ID,
 	})
 
-	parts := strings.Split(req.ID, "/")
+	importID, diags := utils.GetImportID(ctx, req, "workspace_id", "item_id", "path", "name")
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts := strings.Split(importID, "/")
 	if len(parts) < 4 {
 		resp.Diagnostics.AddError(
 			common.ErrorImportIdentifierHeader,
@@ -318,6 +353,7 @@ func (r *resourceShortcut) ImportState(ctx context.Context, req resource.ImportS
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "IMPORT", map[string]any{
 		"action": "end",
@@ -449,6 +449,28 @@ func TestUnit_ShortcutResource_CRUD(t *testing.T) {
 				resource.TestCheckResourceAttr(testResourceItemFQN, "path", *entityBefore.Path),
 			),
 		},
+		// Import with the resource identity
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+					"item_id":      itemID,
+					"name":         *entityBefore.Name,
+					"path":         *entityBefore.Path,
+					"target": map[string]any{
+						"onelake": map[string]any{
+							"workspace_id": *entityBefore.Target.OneLake.WorkspaceID,
+							"item_id":      *entityAfter.Target.OneLake.ItemID,
+							"path":         *entityBefore.Target.OneLake.Path,
+						},
+					},
+				},
+			),
+			ImportState:     true,
+			ImportStateKind: resource.ImportBlockWithResourceIdentity,
+		},
 		// Delete testing automatically occurs in TestCase
 	}))
 }
@@ -0,0 +1,19 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sparkenvlibraries
+
+import (
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+)
+
+// CustomLibraryExtensions are the file extensions of the custom libraries that can be uploaded.
+var CustomLibraryExtensions = []string{".whl", ".jar", ".tar.gz"} //nolint:gochecknoglobals
+
+var ItemTypeInfo = tftypeinfo.TFTypeInfo{ //nolint:gochecknoglobals
+	Name:           "Spark Environment Libraries",
+	Type:           "spark_environment_libraries",
+	DocsURL:        "https://learn.microsoft.com/fabric/data-engineering/environment-manage-library",
+	IsPreview:      false,
+	IsSPNSupported: true,
+}
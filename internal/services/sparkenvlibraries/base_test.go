@@ -0,0 +1,31 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sparkenvlibraries_test
+
+import (
+	"testing"
+
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/services/sparkenvlibraries"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+)
+
+var itemTypeInfo = sparkenvlibraries.ItemTypeInfo
+
+func environmentResource(t *testing.T, workspaceID string) (resourceHCL, resourceFQN string) {
+	t.Helper()
+
+	resourceHCL = at.CompileConfig(
+		at.ResourceHeader(testhelp.TypeName("fabric", "environment"), "test"),
+		map[string]any{
+			"display_name": testhelp.RandomName(),
+			"workspace_id": workspaceID,
+		},
+	)
+
+	resourceFQN = testhelp.ResourceFQN("fabric", "environment", "test")
+
+	return resourceHCL, resourceFQN
+}
@@ -0,0 +1,174 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sparkenvlibraries_test
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sync"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	fabenvironment "github.com/microsoft/fabric-sdk-go/fabric/environment"
+)
+
+// fakeStagedLibraries are the libraries in the staging area of a fake Environment.
+type fakeStagedLibraries struct {
+	custom   []string
+	external []fabenvironment.ExternalLibrary
+}
+
+var (
+	fakeStagingLibrariesMu    sync.Mutex
+	fakeStagingLibrariesStore = map[string]*fakeStagedLibraries{}
+	fakePublishStateStore     = map[string]fabenvironment.PublishState{}
+)
+
+// fakeImportedExternalLibraries are the public libraries staged by any imported environment.yml.
+var fakeImportedExternalLibraries = []fabenvironment.ExternalLibrary{ //nolint:gochecknoglobals
+	{
+		LibraryType: to.Ptr(fabenvironment.LibraryTypeExternal),
+		Name:        new("emoji"),
+		Version:     new("2.14.1"),
+	},
+}
+
+func fakeTestUpsertStagingLibraries(environmentID string, custom ...string) {
+	fakeStagingLibrariesMu.Lock()
+	defer fakeStagingLibrariesMu.Unlock()
+
+	fakeStagingLibrariesStore[environmentID] = &fakeStagedLibraries{custom: custom}
+}
+
+func fakeTestSetPublishState(environmentID string, state fabenvironment.PublishState) {
+	fakeStagingLibrariesMu.Lock()
+	defer fakeStagingLibrariesMu.Unlock()
+
+	fakePublishStateStore[environmentID] = state
+}
+
+func fakeTestGetStagingLibraries(environmentID string) fakeStagedLibraries {
+	fakeStagingLibrariesMu.Lock()
+	defer fakeStagingLibrariesMu.Unlock()
+
+	staged := fakeStagingLibrariesStore[environmentID]
+
+	return fakeStagedLibraries{
+		custom:   slices.Clone(staged.custom),
+		external: slices.Clone(staged.external),
+	}
+}
+
+func fakeListStagingLibrariesFunc() func(_, environmentID string, _ bool, _ *fabenvironment.StagingClientListLibrariesOptions) (resp azfake.PagerResponder[fabenvironment.StagingClientListLibrariesResponse]) {
+	return func(_, environmentID string, _ bool, _ *fabenvironment.StagingClientListLibrariesOptions) (resp azfake.PagerResponder[fabenvironment.StagingClientListLibrariesResponse]) {
+		staged := fakeTestGetStagingLibraries(environmentID)
+
+		libraries := make([]fabenvironment.LibraryClassification, 0, len(staged.custom)+len(staged.external))
+
+		for _, name := range staged.custom {
+			libraries = append(libraries, &fabenvironment.CustomLibrary{
+				LibraryType: to.Ptr(fabenvironment.LibraryTypeCustom),
+				Name:        new(name),
+			})
+		}
+
+		for _, library := range staged.external {
+			libraries = append(libraries, &library)
+		}
+
+		resp = azfake.PagerResponder[fabenvironment.StagingClientListLibrariesResponse]{}
+		resp.AddPage(http.StatusOK, fabenvironment.StagingClientListLibrariesResponse{Libraries: fabenvironment.Libraries{Libraries: libraries}}, nil)
+
+		return resp
+	}
+}
+
+func fakeUploadCustomLibraryFunc() func(ctx context.Context, _, environmentID, libraryName string, _ *fabenvironment.StagingClientUploadCustomLibraryOptions) (resp azfake.Responder[fabenvironment.StagingClientUploadCustomLibraryResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _, environmentID, libraryName string, _ *fabenvironment.StagingClientUploadCustomLibraryOptions) (resp azfake.Responder[fabenvironment.StagingClientUploadCustomLibraryResponse], errResp azfake.ErrorResponder) {
+		fakeStagingLibrariesMu.Lock()
+		defer fakeStagingLibrariesMu.Unlock()
+
+		staged := fakeStagingLibrariesStore[environmentID]
+		if !slices.Contains(staged.custom, libraryName) {
+			staged.custom = append(staged.custom, libraryName)
+		}
+
+		resp = azfake.Responder[fabenvironment.StagingClientUploadCustomLibraryResponse]{}
+		resp.SetResponse(http.StatusOK, fabenvironment.StagingClientUploadCustomLibraryResponse{}, nil)
+
+		return resp, errResp
+	}
+}
+
+func fakeDeleteCustomLibraryFunc() func(ctx context.Context, _, environmentID, libraryName string, _ *fabenvironment.StagingClientDeleteCustomLibraryOptions) (resp azfake.Responder[fabenvironment.StagingClientDeleteCustomLibraryResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _, environmentID, libraryName string, _ *fabenvironment.StagingClientDeleteCustomLibraryOptions) (resp azfake.Responder[fabenvironment.StagingClientDeleteCustomLibraryResponse], errResp azfake.ErrorResponder) {
+		fakeStagingLibrariesMu.Lock()
+		defer fakeStagingLibrariesMu.Unlock()
+
+		staged := fakeStagingLibrariesStore[environmentID]
+		staged.custom = slices.DeleteFunc(staged.custom, func(name string) bool {
+			return name == libraryName
+		})
+
+		resp = azfake.Responder[fabenvironment.StagingClientDeleteCustomLibraryResponse]{}
+		resp.SetResponse(http.StatusOK, fabenvironment.StagingClientDeleteCustomLibraryResponse{}, nil)
+
+		return resp, errResp
+	}
+}
+
+func fakeImportExternalLibrariesFunc() func(ctx context.Context, _, environmentID string, _ *fabenvironment.StagingClientImportExternalLibrariesOptions) (resp azfake.Responder[fabenvironment.StagingClientImportExternalLibrariesResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _, environmentID string, _ *fabenvironment.StagingClientImportExternalLibrariesOptions) (resp azfake.Responder[fabenvironment.StagingClientImportExternalLibrariesResponse], errResp azfake.ErrorResponder) {
+		fakeStagingLibrariesMu.Lock()
+		defer fakeStagingLibrariesMu.Unlock()
+
+		fakeStagingLibrariesStore[environmentID].external = slices.Clone(fakeImportedExternalLibraries)
+
+		resp = azfake.Responder[fabenvironment.StagingClientImportExternalLibrariesResponse]{}
+		resp.SetResponse(http.StatusOK, fabenvironment.StagingClientImportExternalLibrariesResponse{}, nil)
+
+		return resp, errResp
+	}
+}
+
+func fakeRemoveExternalLibraryFunc() func(ctx context.Context, _, environmentID string, req fabenvironment.RemoveExternalLibrariesRequest, _ *fabenvironment.StagingClientRemoveExternalLibraryOptions) (resp azfake.Responder[fabenvironment.StagingClientRemoveExternalLibraryResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _, environmentID string, req fabenvironment.RemoveExternalLibrariesRequest, _ *fabenvironment.StagingClientRemoveExternalLibraryOptions) (resp azfake.Responder[fabenvironment.StagingClientRemoveExternalLibraryResponse], errResp azfake.ErrorResponder) {
+		fakeStagingLibrariesMu.Lock()
+		defer fakeStagingLibrariesMu.Unlock()
+
+		staged := fakeStagingLibrariesStore[environmentID]
+		staged.external = slices.DeleteFunc(staged.external, func(library fabenvironment.ExternalLibrary) bool {
+			return *library.Name == *req.Name && *library.Version == *req.Version
+		})
+
+		resp = azfake.Responder[fabenvironment.StagingClientRemoveExternalLibraryResponse]{}
+		resp.SetResponse(http.StatusOK, fabenvironment.StagingClientRemoveExternalLibraryResponse{}, nil)
+
+		return resp, errResp
+	}
+}
+
+func fakeBeginPublishEnvironmentFunc() func(ctx context.Context, _, environmentID string, _ bool, _ *fabenvironment.ItemsClientBeginPublishEnvironmentOptions) (resp azfake.PollerResponder[fabenvironment.ItemsClientPublishEnvironmentResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _, environmentID string, _ bool, _ *fabenvironment.ItemsClientBeginPublishEnvironmentOptions) (resp azfake.PollerResponder[fabenvironment.ItemsClientPublishEnvironmentResponse], errResp azfake.ErrorResponder) {
+		fakeStagingLibrariesMu.Lock()
+		defer fakeStagingLibrariesMu.Unlock()
+
+		state, ok := fakePublishStateStore[environmentID]
+		if !ok {
+			state = fabenvironment.PublishStateSuccess
+		}
+
+		resp = azfake.PollerResponder[fabenvironment.ItemsClientPublishEnvironmentResponse]{}
+		resp.SetTerminalResponse(http.StatusOK, fabenvironment.ItemsClientPublishEnvironmentResponse{
+			Properties: fabenvironment.Properties{
+				PublishDetails: &fabenvironment.PublishDetails{
+					State: to.Ptr(state),
+				},
+			},
+		}, nil)
+
+		return resp, errResp
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sparkenvlibraries
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fabenvironment "github.com/microsoft/fabric-sdk-go/fabric/environment"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+)
+
+/*
+RESOURCE
+*/
+
+type resourceSparkEnvironmentLibrariesModel struct {
+	ID              customtypes.UUID                                       `tfsdk:"id"`
+	WorkspaceID     customtypes.UUID                                       `tfsdk:"workspace_id"`
+	EnvironmentID   customtypes.UUID                                       `tfsdk:"environment_id"`
+	CustomLibraries supertypes.MapNestedObjectValueOf[libraryFileModel]    `tfsdk:"custom_libraries"`
+	EnvironmentYml  supertypes.SingleNestedObjectValueOf[libraryFileModel] `tfsdk:"environment_yml"`
+	PublishState    types.String                                           `tfsdk:"publish_state"`
+	Timeouts        timeouts.Value                                         `tfsdk:"timeouts"`
+}
+
+// setStaged aligns the libraries in the state with the libraries in the staging area of the Environment.
+// Declared custom libraries missing from the staging area are dropped, so they are uploaded again.
+// Undeclared custom and public libraries are added without a source, so they are removed on the next apply.
+func (to *resourceSparkEnvironmentLibrariesModel) setStaged(ctx context.Context, from stagedLibraries) diag.Diagnostics {
+	customLibraries := make(map[string]*libraryFileModel)
+
+	if !to.CustomLibraries.IsNull() && !to.CustomLibraries.IsUnknown() {
+		current, diags := to.CustomLibraries.Get(ctx)
+		if diags.HasError() {
+			return diags
+		}
+
+		customLibraries = current
+	}
+
+	for name := range customLibraries {
+		if !slices.Contains(from.custom, name) {
+			delete(customLibraries, name)
+		}
+	}
+
+	for _, name := range from.custom {
+		if _, ok := customLibraries[name]; !ok {
+			customLibraries[name] = &libraryFileModel{
+				Source:              types.StringNull(),
+				SourceContentSha256: types.StringNull(),
+			}
+		}
+	}
+
+	if len(customLibraries) > 0 {
+		if diags := to.CustomLibraries.Set(ctx, customLibraries); diags.HasError() {
+			return diags
+		}
+	} else {
+		to.CustomLibraries = supertypes.NewMapNestedObjectValueOfNull[libraryFileModel](ctx)
+	}
+
+	if to.EnvironmentYml.IsNull() && len(from.external) > 0 {
+		return to.EnvironmentYml.Set(ctx, &libraryFileModel{
+			Source:              types.StringNull(),
+			SourceContentSha256: types.StringNull(),
+		})
+	}
+
+	return nil
+}
+
+func (to *resourceSparkEnvironmentLibrariesModel) setPublishState(from *fabenvironment.Properties) {
+	to.PublishState = types.StringNull()
+
+	if from != nil && from.PublishDetails != nil {
+		to.PublishState = types.StringPointerValue((*string)(from.PublishDetails.State))
+	}
+}
+
+/*
+HELPER MODELS
+*/
+
+type libraryFileModel struct {
+	Source              types.String `tfsdk:"source"`
+	SourceContentSha256 types.String `tfsdk:"source_content_sha256"`
+}
+
+// stagedLibraries are the libraries in the staging area of an Environment.
+type stagedLibraries struct {
+	custom   []string
+	external []fabenvironment.ExternalLibrary
+}
+
+func (to *stagedLibraries) set(from []fabenvironment.LibraryClassification) {
+	to.custom = make([]string, 0)
+	to.external = make([]fabenvironment.ExternalLibrary, 0)
+
+	for _, library := range from {
+		if external, ok := library.(*fabenvironment.ExternalLibrary); ok {
+			to.external = append(to.external, *external)
+
+			continue
+		}
+
+		if name := library.GetLibrary().Name; name != nil {
+			to.custom = append(to.custom, *name)
+		}
+	}
+}
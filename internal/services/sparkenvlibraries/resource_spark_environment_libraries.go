@@ -0,0 +1,579 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sparkenvlibraries
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/streaming"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	fabenvironment "github.com/microsoft/fabric-sdk-go/fabric/environment"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ resource.ResourceWithConfigure = (*resourceSparkEnvironmentLibraries)(nil)
+
+// finalPublishStates are the states of an Environment publish that is no longer running.
+var finalPublishStates = []fabenvironment.PublishState{ //nolint:gochecknoglobals
+	fabenvironment.PublishStateSuccess,
+	fabenvironment.PublishStateFailed,
+	fabenvironment.PublishStateCancelled,
+}
+
+type resourceSparkEnvironmentLibraries struct {
+	pConfigData   *pconfig.ProviderData
+	stagingClient *fabenvironment.StagingClient
+	itemsClient   *fabenvironment.ItemsClient
+	TypeInfo      tftypeinfo.TFTypeInfo
+}
+
+func NewResourceSparkEnvironmentLibraries() resource.Resource {
+	return &resourceSparkEnvironmentLibraries{
+		TypeInfo: ItemTypeInfo,
+	}
+}
+
+func (r *resourceSparkEnvironmentLibraries) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeInfo.FullTypeName(false)
+}
+
+func (r *resourceSparkEnvironmentLibraries) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = itemSchema().GetResource(ctx)
+}
+
+func (r *resourceSparkEnvironmentLibraries) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorResourceConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	r.pConfigData = pConfigData
+
+	if resp.Diagnostics.Append(fabricitem.IsPreviewMode(r.TypeInfo.Name, r.TypeInfo.IsPreview, r.pConfigData.Preview)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.stagingClient = fabenvironment.NewClientFactoryWithClient(*pConfigData.FabricClient).NewStagingClient()
+	r.itemsClient = fabenvironment.NewClientFactoryWithClient(*pConfigData.FabricClient).NewItemsClient()
+}
+
+func (r *resourceSparkEnvironmentLibraries) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan resourceSparkEnvironmentLibrariesModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	plan.ID = plan.EnvironmentID
+
+	if resp.Diagnostics.Append(r.apply(ctx, &plan, resourceSparkEnvironmentLibrariesModel{}, utils.OperationCreate)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceSparkEnvironmentLibraries) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "start",
+	})
+
+	var state resourceSparkEnvironmentLibrariesModel
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	diags = r.get(ctx, &state)
+	if utils.IsErrNotFound(state.EnvironmentID.ValueString(), &diags, fabcore.ErrCommon.EntityNotFound) {
+		resp.State.RemoveResource(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		return
+	}
+
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceSparkEnvironmentLibraries) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan, state resourceSparkEnvironmentLibrariesModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	plan.ID = plan.EnvironmentID
+
+	if resp.Diagnostics.Append(r.apply(ctx, &plan, state, utils.OperationUpdate)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceSparkEnvironmentLibraries) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "start",
+	})
+
+	var state resourceSparkEnvironmentLibrariesModel
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	staged, err := r.listStaged(ctx, state)
+
+	// the libraries are removed together with the Environment
+	diags = utils.GetDiagsFromError(ctx, err, utils.OperationDelete, fabcore.ErrCommon.EntityNotFound)
+	if diags.HasError() {
+		if !utils.IsErr(diags, fabcore.ErrCommon.EntityNotFound) {
+			resp.Diagnostics.Append(diags...)
+		}
+
+		return
+	}
+
+	stateCustomLibraries, diags := getLibraryFiles(ctx, state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	changed := false
+
+	for _, name := range staged.custom {
+		if _, ok := stateCustomLibraries[name]; !ok {
+			continue
+		}
+
+		if resp.Diagnostics.Append(r.deleteCustomLibrary(ctx, state, name, utils.OperationDelete)...); resp.Diagnostics.HasError() {
+			return
+		}
+
+		changed = true
+	}
+
+	if !state.EnvironmentYml.IsNull() && len(staged.external) > 0 {
+		if resp.Diagnostics.Append(r.removeExternalLibraries(ctx, state, staged.external, utils.OperationDelete)...); resp.Diagnostics.HasError() {
+			return
+		}
+
+		changed = true
+	}
+
+	if changed {
+		if resp.Diagnostics.Append(r.publish(ctx, &state, utils.OperationDelete)...); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "end",
+	})
+}
+
+// apply brings the staging libraries of the Environment in line with the plan and publishes the Environment when anything changed.
+func (r *resourceSparkEnvironmentLibraries) apply( //nolint:gocognit
+	ctx context.Context,
+	plan *resourceSparkEnvironmentLibrariesModel,
+	state resourceSparkEnvironmentLibrariesModel,
+	operation utils.Operation,
+) diag.Diagnostics {
+	staged, err := r.listStaged(ctx, *plan)
+	if diags := utils.GetDiagsFromError(ctx, err, operation, nil); diags.HasError() {
+		return diags
+	}
+
+	planCustomLibraries, diags := getLibraryFiles(ctx, *plan)
+	if diags.HasError() {
+		return diags
+	}
+
+	stateCustomLibraries, diags := getLibraryFiles(ctx, state)
+	if diags.HasError() {
+		return diags
+	}
+
+	changed := false
+
+	// remove the custom libraries that are no longer declared
+	for _, name := range staged.custom {
+		if _, ok := planCustomLibraries[name]; ok {
+			continue
+		}
+
+		if diags := r.deleteCustomLibrary(ctx, *plan, name, operation); diags.HasError() {
+			return diags
+		}
+
+		changed = true
+	}
+
+	// upload the custom libraries that are new or whose content changed
+	for _, name := range slices.Sorted(maps.Keys(planCustomLibraries)) {
+		library := planCustomLibraries[name]
+
+		if previous, ok := stateCustomLibraries[name]; ok && slices.Contains(staged.custom, name) && previous.SourceContentSha256.Equal(library.SourceContentSha256) {
+			continue
+		}
+
+		content, diags := readLibraryFile(library, path.Root("custom_libraries").AtMapKey(name).AtName("source"))
+		if diags.HasError() {
+			return diags
+		}
+
+		tflog.Info(ctx, "Uploading custom library", map[string]any{
+			"name": name,
+		})
+
+		err := r.uploadFile(ctx, "environment.StagingClient.UploadCustomLibrary", r.stagingLibrariesPath(*plan, name), content)
+		if diags := utils.GetDiagsFromError(ctx, err, operation, nil); diags.HasError() {
+			return diags
+		}
+
+		changed = true
+	}
+
+	if len(planCustomLibraries) > 0 {
+		if diags := plan.CustomLibraries.Set(ctx, planCustomLibraries); diags.HasError() {
+			return diags
+		}
+	}
+
+	planEnvironmentYml, diags := plan.EnvironmentYml.Get(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	stateEnvironmentYml, diags := state.EnvironmentYml.Get(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	switch {
+	case planEnvironmentYml != nil:
+		if stateEnvironmentYml != nil && !stateEnvironmentYml.Source.IsNull() && stateEnvironmentYml.SourceContentSha256.Equal(planEnvironmentYml.SourceContentSha256) {
+			break
+		}
+
+		content, diags := readLibraryFile(planEnvironmentYml, path.Root("environment_yml").AtName("source"))
+		if diags.HasError() {
+			return diags
+		}
+
+		tflog.Info(ctx, "Importing public libraries from environment.yml")
+
+		err := r.uploadFile(ctx, "environment.StagingClient.ImportExternalLibraries", r.stagingLibrariesPath(*plan, "importExternalLibraries"), content)
+		if diags := utils.GetDiagsFromError(ctx, err, operation, nil); diags.HasError() {
+			return diags
+		}
+
+		if diags := plan.EnvironmentYml.Set(ctx, planEnvironmentYml); diags.HasError() {
+			return diags
+		}
+
+		changed = true
+	case len(staged.external) > 0:
+		if diags := r.removeExternalLibraries(ctx, *plan, staged.external, operation); diags.HasError() {
+			return diags
+		}
+
+		changed = true
+	}
+
+	if !changed {
+		tflog.Info(ctx, "Environment libraries are up to date, nothing to publish")
+
+		return r.getPublishState(ctx, plan, operation)
+	}
+
+	return r.publish(ctx, plan, operation)
+}
+
+func (r *resourceSparkEnvironmentLibraries) get(ctx context.Context, model *resourceSparkEnvironmentLibrariesModel) diag.Diagnostics {
+	tflog.Trace(ctx, fmt.Sprintf("getting %s for Environment ID: %s", r.TypeInfo.Name, model.EnvironmentID.ValueString()))
+
+	staged, err := r.listStaged(ctx, *model)
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationRead, fabcore.ErrCommon.EntityNotFound); diags.HasError() {
+		return diags
+	}
+
+	if diags := model.setStaged(ctx, staged); diags.HasError() {
+		return diags
+	}
+
+	return r.getPublishState(ctx, model, utils.OperationRead)
+}
+
+func (r *resourceSparkEnvironmentLibraries) listStaged(ctx context.Context, model resourceSparkEnvironmentLibrariesModel) (stagedLibraries, error) {
+	var staged stagedLibraries
+
+	respList, err := r.stagingClient.ListLibraries(ctx, model.WorkspaceID.ValueString(), model.EnvironmentID.ValueString(), false, nil)
+	if err != nil {
+		return staged, err
+	}
+
+	staged.set(respList)
+
+	return staged, nil
+}
+
+func (r *resourceSparkEnvironmentLibraries) deleteCustomLibrary(
+	ctx context.Context,
+	model resourceSparkEnvironmentLibrariesModel,
+	name string,
+	operation utils.Operation,
+) diag.Diagnostics {
+	tflog.Info(ctx, "Removing custom library", map[string]any{
+		"name": name,
+	})
+
+	_, err := r.stagingClient.DeleteCustomLibrary(ctx, model.WorkspaceID.ValueString(), model.EnvironmentID.ValueString(), name, nil)
+
+	return utils.GetDiagsFromError(ctx, err, operation, nil)
+}
+
+func (r *resourceSparkEnvironmentLibraries) removeExternalLibraries(
+	ctx context.Context,
+	model resourceSparkEnvironmentLibrariesModel,
+	libraries []fabenvironment.ExternalLibrary,
+	operation utils.Operation,
+) diag.Diagnostics {
+	for _, library := range libraries {
+		tflog.Info(ctx, "Removing public library", map[string]any{
+			"name":    library.Name,
+			"version": library.Version,
+		})
+
+		_, err := r.stagingClient.RemoveExternalLibrary(
+			ctx,
+			model.WorkspaceID.ValueString(),
+			model.EnvironmentID.ValueString(),
+			fabenvironment.RemoveExternalLibrariesRequest{
+				Name:    library.Name,
+				Version: library.Version,
+			},
+			nil,
+		)
+		if diags := utils.GetDiagsFromError(ctx, err, operation, nil); diags.HasError() {
+			return diags
+		}
+	}
+
+	return nil
+}
+
+// publish publishes the staging libraries and waits for the publish to reach a final state.
+func (r *resourceSparkEnvironmentLibraries) publish(ctx context.Context, model *resourceSparkEnvironmentLibrariesModel, operation utils.Operation) diag.Diagnostics {
+	respPublish, err := r.itemsClient.PublishEnvironment(ctx, model.WorkspaceID.ValueString(), model.EnvironmentID.ValueString(), false, nil)
+	if diags := utils.GetDiagsFromError(ctx, err, operation, nil); diags.HasError() {
+		return diags
+	}
+
+	model.setPublishState(&respPublish.Properties)
+
+	for !slices.Contains(finalPublishStates, fabenvironment.PublishState(model.PublishState.ValueString())) {
+		tflog.Info(ctx, "Environment publish in progress, waiting before retrying", map[string]any{
+			"id":    model.EnvironmentID.ValueString(),
+			"state": model.PublishState.ValueString(),
+		})
+
+		if diags := utils.GetDiagsFromError(ctx, utils.WaitForNextPoll(ctx), operation, nil); diags.HasError() {
+			return diags
+		}
+
+		if diags := r.getPublishState(ctx, model, operation); diags.HasError() {
+			return diags
+		}
+	}
+
+	if model.PublishState.ValueString() != string(fabenvironment.PublishStateSuccess) {
+		var diags diag.Diagnostics
+
+		diags.AddError(
+			common.ErrorEnvironmentPublishHeader,
+			fmt.Sprintf(common.ErrorEnvironmentPublishDetails, model.EnvironmentID.ValueString(), model.PublishState.ValueString()),
+		)
+
+		return diags
+	}
+
+	return nil
+}
+
+func (r *resourceSparkEnvironmentLibraries) getPublishState(ctx context.Context, model *resourceSparkEnvironmentLibrariesModel, operation utils.Operation) diag.Diagnostics {
+	respGet, err := r.itemsClient.GetEnvironment(ctx, model.WorkspaceID.ValueString(), model.EnvironmentID.ValueString(), nil)
+	if diags := utils.GetDiagsFromError(ctx, err, operation, fabcore.ErrCommon.EntityNotFound); diags.HasError() {
+		return diags
+	}
+
+	model.setPublishState(respGet.Properties)
+
+	return nil
+}
+
+func (r *resourceSparkEnvironmentLibraries) stagingLibrariesPath(model resourceSparkEnvironmentLibrariesModel, name string) string {
+	return fmt.Sprintf(
+		"/v1/workspaces/%s/environments/%s/staging/libraries/%s",
+		url.PathEscape(model.WorkspaceID.ValueString()),
+		url.PathEscape(model.EnvironmentID.ValueString()),
+		url.PathEscape(name),
+	)
+}
+
+// uploadFile sends the file content to the staging libraries API.
+// The SDK client does not send a request body for the upload operations, so the request is made through the client pipeline.
+// The API name is set on the context the same way the SDK client does it.
+func (r *resourceSparkEnvironmentLibraries) uploadFile(ctx context.Context, apiName, urlPath string, content []byte) error {
+	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, apiName)
+
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(r.pConfigData.FabricClient.Endpoint, urlPath))
+	if err != nil {
+		return err
+	}
+
+	req.Raw().Header["Accept"] = []string{"application/json"}
+
+	if err := req.SetBody(streaming.NopCloser(bytes.NewReader(content)), "application/octet-stream"); err != nil {
+		return err
+	}
+
+	httpResp, err := r.pConfigData.FabricClient.Internal.Pipeline().Do(req)
+	if err != nil {
+		return err
+	}
+
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		return fabcore.NewResponseError(httpResp)
+	}
+
+	return nil
+}
+
+func getLibraryFiles(ctx context.Context, model resourceSparkEnvironmentLibrariesModel) (map[string]*libraryFileModel, diag.Diagnostics) {
+	if model.CustomLibraries.IsNull() || model.CustomLibraries.IsUnknown() {
+		return map[string]*libraryFileModel{}, nil
+	}
+
+	return model.CustomLibraries.Get(ctx)
+}
+
+// readLibraryFile reads the content of the library file and sets its SHA256 when it was not known at plan time.
+func readLibraryFile(library *libraryFileModel, sourcePath path.Path) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	content, err := os.ReadFile(library.Source.ValueString())
+	if err != nil {
+		diags.AddAttributeError(sourcePath, common.ErrorFileReadHeader, err.Error())
+
+		return nil, diags
+	}
+
+	if library.SourceContentSha256.IsUnknown() {
+		library.SourceContentSha256 = types.StringValue(utils.Sha256(content))
+	}
+
+	return content, diags
+}
@@ -0,0 +1,387 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sparkenvlibraries_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"testing"
+
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	fabenvironment "github.com/microsoft/fabric-sdk-go/fabric/environment"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testResourceItemFQN, testResourceItemHeader = testhelp.TFResource(common.ProviderTypeName, itemTypeInfo.Type, "test")
+
+func configureFakeLibraries() {
+	fakes.FakeServer.ServerFactory.Environment.StagingServer.NewListLibrariesPager = fakeListStagingLibrariesFunc()
+	fakes.FakeServer.ServerFactory.Environment.StagingServer.UploadCustomLibrary = fakeUploadCustomLibraryFunc()
+	fakes.FakeServer.ServerFactory.Environment.StagingServer.DeleteCustomLibrary = fakeDeleteCustomLibraryFunc()
+	fakes.FakeServer.ServerFactory.Environment.StagingServer.ImportExternalLibraries = fakeImportExternalLibrariesFunc()
+	fakes.FakeServer.ServerFactory.Environment.StagingServer.RemoveExternalLibrary = fakeRemoveExternalLibraryFunc()
+	fakes.FakeServer.ServerFactory.Environment.ItemsServer.BeginPublishEnvironment = fakeBeginPublishEnvironmentFunc()
+}
+
+func writeSourceFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+
+	sourcePath := filepath.Join(dir, name)
+
+	if err := os.WriteFile(sourcePath, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return filepath.ToSlash(sourcePath)
+}
+
+func checkStagingLibraries(environmentID string, expCustom []string, expExternal int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		staged := fakeTestGetStagingLibraries(environmentID)
+
+		slices.Sort(staged.custom)
+		slices.Sort(expCustom)
+
+		if !slices.Equal(staged.custom, expCustom) {
+			return fmt.Errorf("expected staged custom libraries %v, got %v", expCustom, staged.custom)
+		}
+
+		if len(staged.external) != expExternal {
+			return fmt.Errorf("expected %d staged public libraries, got %d", expExternal, len(staged.external))
+		}
+
+		return nil
+	}
+}
+
+func TestUnit_SparkEnvironmentLibrariesResource_Attributes(t *testing.T) {
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no attributes
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{},
+			),
+			ExpectError: regexp.MustCompile(`Missing required argument`),
+		},
+		// error - workspace_id - invalid UUID
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":   "invalid uuid",
+					"environment_id": testhelp.RandomUUID(),
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - environment_id - invalid UUID
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":   testhelp.RandomUUID(),
+					"environment_id": "invalid uuid",
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - unexpected attribute
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":    testhelp.RandomUUID(),
+					"environment_id":  testhelp.RandomUUID(),
+					"unexpected_attr": "test",
+				},
+			),
+			ExpectError: regexp.MustCompile(`An argument named "unexpected_attr" is not expected here`),
+		},
+		// error - custom_libraries - unsupported file extension
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":   testhelp.RandomUUID(),
+					"environment_id": testhelp.RandomUUID(),
+					"custom_libraries": map[string]any{
+						`"library.zip"`: map[string]any{
+							"source": "library.zip",
+						},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(`must be a file name with one of the accepted extensions`),
+		},
+		// error - custom_libraries - missing source
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":   testhelp.RandomUUID(),
+					"environment_id": testhelp.RandomUUID(),
+					"custom_libraries": map[string]any{
+						`"library.whl"`: map[string]any{},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(`Inappropriate value for attribute "custom_libraries"`),
+		},
+		// error - environment_yml - source file not found
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":   testhelp.RandomUUID(),
+					"environment_id": testhelp.RandomUUID(),
+					"environment_yml": map[string]any{
+						"source": filepath.ToSlash(filepath.Join(t.TempDir(), "environment.yml")),
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorFileReadHeader),
+		},
+	}))
+}
+
+func TestUnit_SparkEnvironmentLibrariesResource_CRUD(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	entity := fakes.NewRandomEnvironmentWithWorkspace(workspaceID)
+	environmentID := *entity.ID
+
+	fakes.FakeServer.Upsert(entity)
+	fakeTestUpsertStagingLibraries(environmentID, "legacy.jar")
+	configureFakeLibraries()
+
+	sourceDir := t.TempDir()
+	wheelPath := writeSourceFile(t, sourceDir, "sample-0.1.0-py3-none-any.whl", "wheel v1")
+	jarPath := writeSourceFile(t, sourceDir, "sample.jar", "jar v1")
+	environmentYmlPath := writeSourceFile(t, sourceDir, "environment.yml", "dependencies:\n  - pip:\n      - emoji==2.14.1\n")
+
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":   workspaceID,
+					"environment_id": environmentID,
+					"custom_libraries": map[string]any{
+						`"sample-0.1.0-py3-none-any.whl"`: map[string]any{
+							"source": wheelPath,
+						},
+					},
+					"environment_yml": map[string]any{
+						"source": environmentYmlPath,
+					},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "id", environmentID),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "publish_state", string(fabenvironment.PublishStateSuccess)),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "custom_libraries.%", "1"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "custom_libraries.sample-0.1.0-py3-none-any.whl.source_content_sha256", utils.Sha256("wheel v1")),
+				resource.TestCheckResourceAttrSet(testResourceItemFQN, "environment_yml.source_content_sha256"),
+				checkStagingLibraries(environmentID, []string{"sample-0.1.0-py3-none-any.whl"}, 1),
+			),
+		},
+		// Update and Read - changed content, new library and removed environment.yml
+		{
+			ResourceName: testResourceItemFQN,
+			PreConfig: func() {
+				writeSourceFile(t, sourceDir, "sample-0.1.0-py3-none-any.whl", "wheel v2")
+			},
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":   workspaceID,
+					"environment_id": environmentID,
+					"custom_libraries": map[string]any{
+						`"sample-0.1.0-py3-none-any.whl"`: map[string]any{
+							"source": wheelPath,
+						},
+						`"sample.jar"`: map[string]any{
+							"source": jarPath,
+						},
+					},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "custom_libraries.%", "2"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "custom_libraries.sample-0.1.0-py3-none-any.whl.source_content_sha256", utils.Sha256("wheel v2")),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "custom_libraries.sample.jar.source_content_sha256", utils.Sha256("jar v1")),
+				resource.TestCheckNoResourceAttr(testResourceItemFQN, "environment_yml"),
+				checkStagingLibraries(environmentID, []string{"sample-0.1.0-py3-none-any.whl", "sample.jar"}, 0),
+			),
+		},
+		// Update and Read - library removed outside of Terraform is uploaded again
+		{
+			ResourceName: testResourceItemFQN,
+			PreConfig: func() {
+				fakeTestUpsertStagingLibraries(environmentID, "sample.jar", "other.whl")
+			},
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":   workspaceID,
+					"environment_id": environmentID,
+					"custom_libraries": map[string]any{
+						`"sample-0.1.0-py3-none-any.whl"`: map[string]any{
+							"source": wheelPath,
+						},
+						`"sample.jar"`: map[string]any{
+							"source": jarPath,
+						},
+					},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "custom_libraries.%", "2"),
+				checkStagingLibraries(environmentID, []string{"sample-0.1.0-py3-none-any.whl", "sample.jar"}, 0),
+			),
+		},
+		// Delete - declared libraries are removed from the Environment
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":   workspaceID,
+					"environment_id": environmentID,
+					"custom_libraries": map[string]any{
+						`"sample.jar"`: map[string]any{
+							"source": jarPath,
+						},
+					},
+				},
+			),
+			Destroy: true,
+			Check: func(_ *terraform.State) error {
+				if staged := fakeTestGetStagingLibraries(environmentID); len(staged.custom) > 0 {
+					return errors.New("expected no staged custom libraries after destroy")
+				}
+
+				return nil
+			},
+		},
+	}))
+}
+
+func TestUnit_SparkEnvironmentLibrariesResource_PublishFailed(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	entity := fakes.NewRandomEnvironmentWithWorkspace(workspaceID)
+	environmentID := *entity.ID
+
+	fakes.FakeServer.Upsert(entity)
+	fakeTestUpsertStagingLibraries(environmentID)
+	fakeTestSetPublishState(environmentID, fabenvironment.PublishStateFailed)
+	configureFakeLibraries()
+
+	jarPath := writeSourceFile(t, t.TempDir(), "sample.jar", "jar v1")
+
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - publish failed
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":   workspaceID,
+					"environment_id": environmentID,
+					"custom_libraries": map[string]any{
+						`"sample.jar"`: map[string]any{
+							"source": jarPath,
+						},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorEnvironmentPublishHeader),
+		},
+	}))
+}
+
+func TestAcc_SparkEnvironmentLibrariesResource_CRUD(t *testing.T) {
+	capacity := testhelp.WellKnown()["Capacity"].(map[string]any)
+	capacityID := capacity["id"].(string)
+
+	workspaceResourceHCL, workspaceResourceFQN := testhelp.TestAccWorkspaceResource(t, capacityID)
+	environmentResourceHCL, environmentResourceFQN := environmentResource(t, testhelp.RefByFQN(workspaceResourceFQN, "id"))
+
+	wheelPath := testhelp.GetFixturesDirPath("spark_environment_libraries", "tfsample-0.1.0-py3-none-any.whl")
+	environmentYmlPath := testhelp.GetFixturesDirPath("spark_environment_libraries", "environment.yml")
+
+	resource.Test(t, testhelp.NewTestAccCase(t, &testResourceItemFQN, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.JoinConfigs(
+				workspaceResourceHCL,
+				environmentResourceHCL,
+				at.CompileConfig(
+					testResourceItemHeader,
+					map[string]any{
+						"workspace_id":   testhelp.RefByFQN(workspaceResourceFQN, "id"),
+						"environment_id": testhelp.RefByFQN(environmentResourceFQN, "id"),
+						"custom_libraries": map[string]any{
+							`"tfsample-0.1.0-py3-none-any.whl"`: map[string]any{
+								"source": wheelPath,
+							},
+						},
+						"environment_yml": map[string]any{
+							"source": environmentYmlPath,
+						},
+					},
+				)),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "publish_state", string(fabenvironment.PublishStateSuccess)),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "custom_libraries.%", "1"),
+				resource.TestCheckResourceAttrSet(testResourceItemFQN, "environment_yml.source_content_sha256"),
+			),
+		},
+		// Update and Read - remove environment.yml
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.JoinConfigs(
+				workspaceResourceHCL,
+				environmentResourceHCL,
+				at.CompileConfig(
+					testResourceItemHeader,
+					map[string]any{
+						"workspace_id":   testhelp.RefByFQN(workspaceResourceFQN, "id"),
+						"environment_id": testhelp.RefByFQN(environmentResourceFQN, "id"),
+						"custom_libraries": map[string]any{
+							`"tfsample-0.1.0-py3-none-any.whl"`: map[string]any{
+								"source": wheelPath,
+							},
+						},
+					},
+				)),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "publish_state", string(fabenvironment.PublishStateSuccess)),
+				resource.TestCheckNoResourceAttr(testResourceItemFQN, "environment_yml"),
+			),
+		},
+	},
+	))
+}
@@ -0,0 +1,134 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sparkenvlibraries
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema" //revive:disable-line:import-alias-naming
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	fabenvironment "github.com/microsoft/fabric-sdk-go/fabric/environment"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/planmodifiers"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func itemSchema() superschema.Schema {
+	markdownDescriptionR := fabricitem.NewResourceMarkdownDescription(ItemTypeInfo, false) +
+		"\n\n-> The declared libraries are authoritative: custom libraries in the staging area that are not declared are removed, " +
+		"and the public libraries are removed when `environment_yml` is not declared. " +
+		"Any change to the libraries publishes the Environment, and the apply waits for the publish to finish. " +
+		"Destroying the resource removes the declared libraries from the Environment and publishes it."
+
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: markdownDescriptionR,
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The " + ItemTypeInfo.Name + " ID.",
+					CustomType:          customtypes.UUIDType{},
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"workspace_id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The Workspace ID.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"environment_id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The Environment ID.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"custom_libraries": superschema.SuperMapNestedAttributeOf[libraryFileModel]{
+				Resource: &schemaR.MapNestedAttribute{
+					MarkdownDescription: fmt.Sprintf(
+						"The custom libraries of the Environment, keyed by the library file name. Accepted file extensions: %s.",
+						utils.ConvertStringSlicesToString(CustomLibraryExtensions, true, false),
+					),
+					Optional: true,
+					Validators: []validator.Map{
+						mapvalidator.SizeAtLeast(1),
+						mapvalidator.KeysAre(
+							stringvalidator.RegexMatches(
+								regexp.MustCompile(`^[^/\\]+\.(whl|jar|tar\.gz)$`),
+								"must be a file name with one of the accepted extensions, without any directory",
+							),
+						),
+					},
+				},
+				Attributes: libraryFileAttributes("library"),
+			},
+			"environment_yml": superschema.SuperSingleNestedAttributeOf[libraryFileModel]{
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The `environment.yml` file declaring the public libraries of the Environment.",
+					Optional:            true,
+				},
+				Attributes: libraryFileAttributes("`environment.yml`"),
+			},
+			"publish_state": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The state of the last publish of the Environment. Possible values: " +
+						utils.ConvertStringSlicesToString(fabenvironment.PossibleEnvironmentPublishStateValues(), true, true) + ".",
+					Computed: true,
+				},
+			},
+			"timeouts": superschema.TimeoutAttribute{
+				Resource: &superschema.ResourceTimeoutAttribute{
+					Create: true,
+					Read:   true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		},
+	}
+}
+
+func libraryFileAttributes(name string) map[string]superschema.Attribute {
+	return map[string]superschema.Attribute{
+		"source": superschema.StringAttribute{
+			Resource: &schemaR.StringAttribute{
+				MarkdownDescription: "The path to the " + name + " file on the local filesystem.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		"source_content_sha256": superschema.StringAttribute{
+			Resource: &schemaR.StringAttribute{
+				MarkdownDescription: "SHA256 of the " + name + " file content.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					planmodifiers.FileContentSha256(path.MatchRelative().AtParent().AtName("source")),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sqlendpoint
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/microsoft/fabric-sdk-go/fabric"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	fabsqlendpoint "github.com/microsoft/fabric-sdk-go/fabric/sqlendpoint"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+)
+
+func NewDataSourceSQLEndpoint() datasource.DataSource {
+	propertiesSetter := func(ctx context.Context, from *sqlEndpointProperties, to *fabricitem.DataSourceFabricItemPropertiesModel[sqlEndpointPropertiesModel, sqlEndpointProperties]) diag.Diagnostics {
+		properties := supertypes.NewSingleNestedObjectValueOfNull[sqlEndpointPropertiesModel](ctx)
+
+		if from != nil {
+			propertiesModel := &sqlEndpointPropertiesModel{}
+			propertiesModel.set(*from)
+
+			if diags := properties.Set(ctx, propertiesModel); diags.HasError() {
+				return diags
+			}
+		}
+
+		to.Properties = properties
+
+		return nil
+	}
+
+	itemGetter := func(ctx context.Context, fabricClient fabric.Client, model fabricitem.DataSourceFabricItemPropertiesModel[sqlEndpointPropertiesModel, sqlEndpointProperties], fabricItem *fabricitem.FabricItemProperties[sqlEndpointProperties]) error {
+		client := fabcore.NewClientFactoryWithClient(fabricClient).NewItemsClient()
+
+		respGet, err := client.GetItem(ctx, model.WorkspaceID.ValueString(), model.ID.ValueString(), nil)
+		if err != nil {
+			return err
+		}
+
+		return getSQLEndpointProperties(ctx, fabricClient, respGet.Item, fabricItem)
+	}
+
+	itemListGetter := func(ctx context.Context, fabricClient fabric.Client, model fabricitem.DataSourceFabricItemPropertiesModel[sqlEndpointPropertiesModel, sqlEndpointProperties], errNotFound fabcore.ResponseError, fabricItem *fabricitem.FabricItemProperties[sqlEndpointProperties]) error {
+		client := fabcore.NewClientFactoryWithClient(fabricClient).NewItemsClient()
+
+		pager := client.NewListItemsPager(model.WorkspaceID.ValueString(), &fabcore.ItemsClientListItemsOptions{
+			Type: new(string(FabricItemType)),
+		})
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				return err
+			}
+
+			for _, entity := range page.Value {
+				if *entity.DisplayName == model.DisplayName.ValueString() {
+					return getSQLEndpointProperties(ctx, fabricClient, entity, fabricItem)
+				}
+			}
+		}
+
+		return &errNotFound
+	}
+
+	config := fabricitem.DataSourceFabricItemProperties[sqlEndpointPropertiesModel, sqlEndpointProperties]{
+		DataSourceFabricItem: fabricitem.DataSourceFabricItem{
+			TypeInfo:            ItemTypeInfo,
+			FabricItemType:      FabricItemType,
+			IsDisplayNameUnique: true,
+		},
+		PropertiesAttributes: getDataSourceSQLEndpointPropertiesAttributes(),
+		PropertiesSetter:     propertiesSetter,
+		ItemGetter:           itemGetter,
+		ItemListGetter:       itemListGetter,
+	}
+
+	return fabricitem.NewDataSourceFabricItemProperties(config)
+}
+
+func getSQLEndpointProperties(ctx context.Context, fabricClient fabric.Client, item fabcore.Item, fabricItem *fabricitem.FabricItemProperties[sqlEndpointProperties]) error {
+	client := fabsqlendpoint.NewClientFactoryWithClient(fabricClient).NewItemsClient()
+
+	respConnectionString, err := client.GetConnectionString(ctx, *item.WorkspaceID, *item.ID, nil)
+	if err != nil {
+		return err
+	}
+
+	fabricItem.Set(item)
+	fabricItem.Properties = &sqlEndpointProperties{
+		ConnectionString: respConnectionString.ConnectionString,
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sqlendpoint_test
+
+import (
+	"context"
+	"net/http"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	fabsqlendpoint "github.com/microsoft/fabric-sdk-go/fabric/sqlendpoint"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+func configureFakes() {
+	fakes.FakeServer.ServerFactory.SQLEndpoint.ItemsServer.GetConnectionString = fakeGetConnectionString()
+}
+
+func fakeGetConnectionString() func(ctx context.Context, workspaceID, sqlEndpointID string, options *fabsqlendpoint.ItemsClientGetConnectionStringOptions) (resp azfake.Responder[fabsqlendpoint.ItemsClientGetConnectionStringResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _, _ string, _ *fabsqlendpoint.ItemsClientGetConnectionStringOptions) (resp azfake.Responder[fabsqlendpoint.ItemsClientGetConnectionStringResponse], errResp azfake.ErrorResponder) {
+		resp.SetResponse(http.StatusOK, fabsqlendpoint.ItemsClientGetConnectionStringResponse{
+			ConnectionStringResponse: fabsqlendpoint.ConnectionStringResponse{
+				ConnectionString: new(testhelp.RandomURI()),
+			},
+		}, nil)
+
+		return resp, errResp
+	}
+}
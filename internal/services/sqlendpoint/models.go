@@ -0,0 +1,21 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sqlendpoint
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// sqlEndpointProperties are the properties of a SQL Endpoint. The Fabric API has no SQL Endpoint properties, they are gathered from separate calls.
+type sqlEndpointProperties struct {
+	ConnectionString *string
+}
+
+type sqlEndpointPropertiesModel struct {
+	ConnectionString types.String `tfsdk:"connection_string"`
+}
+
+func (to *sqlEndpointPropertiesModel) set(from sqlEndpointProperties) {
+	to.ConnectionString = types.StringPointerValue(from.ConnectionString)
+}
@@ -0,0 +1,17 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sqlendpoint
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func getDataSourceSQLEndpointPropertiesAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"connection_string": schema.StringAttribute{
+			MarkdownDescription: "The SQL connection string connected to the workspace containing this SQL Endpoint.",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sqlendpointrefresh
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabsqlendpoint "github.com/microsoft/fabric-sdk-go/fabric/sqlendpoint"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+var _ action.ActionWithConfigure = (*actionSQLEndpointMetadataRefresh)(nil)
+
+type actionSQLEndpointMetadataRefresh struct {
+	pConfigData *pconfig.ProviderData
+	client      *fabsqlendpoint.ItemsClient
+	TypeInfo    tftypeinfo.TFTypeInfo
+}
+
+func NewActionSQLEndpointMetadataRefresh() action.Action {
+	return &actionSQLEndpointMetadataRefresh{
+		TypeInfo: ItemTypeInfo,
+	}
+}
+
+func (a *actionSQLEndpointMetadataRefresh) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = a.TypeInfo.FullTypeName(false)
+}
+
+func (a *actionSQLEndpointMetadataRefresh) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = itemSchema()
+}
+
+func (a *actionSQLEndpointMetadataRefresh) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorActionConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	a.pConfigData = pConfigData
+	a.client = fabsqlendpoint.NewClientFactoryWithClient(*pConfigData.FabricClient).NewItemsClient()
+
+	if resp.Diagnostics.Append(fabricitem.IsPreviewMode(a.TypeInfo.Name, a.TypeInfo.IsPreview, a.pConfigData.Preview)...); resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (a *actionSQLEndpointMetadataRefresh) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Debug(ctx, "INVOKE", map[string]any{
+		"action": "start",
+	})
+
+	var config actionSQLEndpointMetadataRefreshModel
+
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &config)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, a.pConfigData.Timeout)
+	defer cancel()
+
+	sqlEndpointID := config.SQLEndpointID.ValueString()
+
+	var reqRefresh requestRefreshSQLEndpointMetadata
+
+	reqRefresh.set(config, a.pConfigData.Timeout)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Refreshing the metadata of SQL Endpoint %s", sqlEndpointID),
+	})
+
+	respRefresh, err := a.client.RefreshSQLEndpointMetadata(
+		ctx,
+		config.WorkspaceID.ValueString(),
+		sqlEndpointID,
+		&fabsqlendpoint.ItemsClientBeginRefreshSQLEndpointMetadataOptions{
+			SQLEndpointRefreshMetadataRequest: &reqRefresh.RefreshMetadataRequest,
+		},
+	)
+	if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationUpdate, nil)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if failures := getSyncFailures(respRefresh.Value); len(failures) > 0 {
+		resp.Diagnostics.AddError(
+			common.ErrorSQLEndpointRefreshHeader,
+			fmt.Sprintf(common.ErrorSQLEndpointRefreshDetails, sqlEndpointID, len(failures), strings.Join(failures, "; ")),
+		)
+
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("SQL Endpoint %s synced %d table(s)", sqlEndpointID, len(respRefresh.Value)),
+	})
+
+	tflog.Debug(ctx, "INVOKE", map[string]any{
+		"action": "end",
+	})
+}
+
+// getSyncFailures returns a description of each table that failed to sync.
+func getSyncFailures(tables []fabsqlendpoint.TableSyncStatus) []string {
+	var failures []string
+
+	for _, table := range tables {
+		if table.Status == nil || *table.Status != fabsqlendpoint.SyncStatusFailure {
+			continue
+		}
+
+		reason := "no error was reported"
+
+		if table.Error != nil && table.Error.Message != nil {
+			reason = *table.Error.Message
+
+			if table.Error.ErrorCode != nil {
+				reason = fmt.Sprintf("%s: %s", *table.Error.ErrorCode, *table.Error.Message)
+			}
+		}
+
+		failures = append(failures, fmt.Sprintf("'%s' (%s)", utils.ValueOrZero(table.TableName), reason))
+	}
+
+	return failures
+}
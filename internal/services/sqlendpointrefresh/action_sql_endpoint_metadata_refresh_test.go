@@ -0,0 +1,139 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sqlendpointrefresh_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	fabsqlendpoint "github.com/microsoft/fabric-sdk-go/fabric/sqlendpoint"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testActionItemFQN, testActionItemHeader = testhelp.TFAction(common.ProviderTypeName, itemTypeInfo.Type, "test")
+
+func TestUnit_SQLEndpointMetadataRefreshAction_Attributes(t *testing.T) {
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testActionItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no required attributes - workspace_id
+		{
+			Config: testhelp.TFActionConfig(
+				testActionItemHeader,
+				map[string]any{
+					"sql_endpoint_id": "00000000-0000-0000-0000-000000000000",
+				},
+			),
+			ExpectError: regexp.MustCompile(`The argument "workspace_id" is required, but no definition was found.`),
+		},
+		// error - no required attributes - sql_endpoint_id
+		{
+			Config: testhelp.TFActionConfig(
+				testActionItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+				},
+			),
+			ExpectError: regexp.MustCompile(`The argument "sql_endpoint_id" is required, but no definition was found.`),
+		},
+		// error - invalid UUID - sql_endpoint_id
+		{
+			Config: testhelp.TFActionConfig(
+				testActionItemHeader,
+				map[string]any{
+					"workspace_id":    "00000000-0000-0000-0000-000000000000",
+					"sql_endpoint_id": "invalid uuid",
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - unexpected attribute
+		{
+			Config: testhelp.TFActionConfig(
+				testActionItemHeader,
+				map[string]any{
+					"workspace_id":    "00000000-0000-0000-0000-000000000000",
+					"sql_endpoint_id": "11111111-1111-1111-1111-111111111111",
+					"unexpected_attr": "test",
+				},
+			),
+			ExpectError: regexp.MustCompile(`An argument named "unexpected_attr" is not expected here`),
+		},
+	}))
+}
+
+func TestUnit_SQLEndpointMetadataRefreshAction_Invoke(t *testing.T) {
+	tables := []fabsqlendpoint.TableSyncStatus{
+		NewRandomTableSyncStatus(fabsqlendpoint.SyncStatusSuccess),
+		NewRandomTableSyncStatus(fabsqlendpoint.SyncStatusNotRun),
+	}
+
+	var received fabsqlendpoint.RefreshMetadataRequest
+
+	fakes.FakeServer.ServerFactory.SQLEndpoint.ItemsServer.BeginRefreshSQLEndpointMetadata = fakeRefreshSQLEndpointMetadata(tables, &received)
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testActionItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		{
+			Config: at.JoinConfigs(
+				testhelp.TFActionConfig(
+					testActionItemHeader,
+					map[string]any{
+						"workspace_id":    testhelp.RandomUUID(),
+						"sql_endpoint_id": testhelp.RandomUUID(),
+						"recreate_tables": true,
+					},
+				),
+				testhelp.TFActionTrigger(testActionItemFQN),
+			),
+			Check: func(_ *terraform.State) error {
+				if received.RecreateTables == nil || !*received.RecreateTables {
+					return errors.New("expected the refresh to be started with recreate_tables")
+				}
+
+				if received.Timeout == nil || received.Timeout.Value == nil || *received.Timeout.Value <= 0 {
+					return errors.New("expected the refresh to be started with the provider timeout")
+				}
+
+				return nil
+			},
+		},
+	}))
+}
+
+func TestUnit_SQLEndpointMetadataRefreshAction_Invoke_Failed(t *testing.T) {
+	unnamed := NewRandomTableSyncStatus(fabsqlendpoint.SyncStatusFailure)
+	unnamed.TableName = nil
+
+	tables := []fabsqlendpoint.TableSyncStatus{
+		NewRandomTableSyncStatus(fabsqlendpoint.SyncStatusSuccess),
+		NewRandomTableSyncStatus(fabsqlendpoint.SyncStatusFailure),
+		unnamed,
+	}
+
+	var received fabsqlendpoint.RefreshMetadataRequest
+
+	fakes.FakeServer.ServerFactory.SQLEndpoint.ItemsServer.BeginRefreshSQLEndpointMetadata = fakeRefreshSQLEndpointMetadata(tables, &received)
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testActionItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		{
+			Config: at.JoinConfigs(
+				testhelp.TFActionConfig(
+					testActionItemHeader,
+					map[string]any{
+						"workspace_id":    testhelp.RandomUUID(),
+						"sql_endpoint_id": testhelp.RandomUUID(),
+					},
+				),
+				testhelp.TFActionTrigger(testActionItemFQN),
+			),
+			ExpectError: regexp.MustCompile(`failed to sync 2 table\(s\): '` + *tables[1].TableName + `'`),
+		},
+	}))
+}
@@ -0,0 +1,10 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sqlendpointrefresh_test
+
+import (
+	"github.com/microsoft/terraform-provider-fabric/internal/services/sqlendpointrefresh"
+)
+
+var itemTypeInfo = sqlendpointrefresh.ItemTypeInfo
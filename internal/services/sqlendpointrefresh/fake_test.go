@@ -0,0 +1,60 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sqlendpointrefresh_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	azto "github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	fabsqlendpoint "github.com/microsoft/fabric-sdk-go/fabric/sqlendpoint"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+)
+
+// Returns a fake function that refreshes the SQL Endpoint metadata with the provided table statuses, and records the received request.
+func fakeRefreshSQLEndpointMetadata(
+	tables []fabsqlendpoint.TableSyncStatus,
+	received *fabsqlendpoint.RefreshMetadataRequest,
+) func(ctx context.Context, workspaceID, sqlEndpointID string, options *fabsqlendpoint.ItemsClientBeginRefreshSQLEndpointMetadataOptions) (resp azfake.PollerResponder[fabsqlendpoint.ItemsClientRefreshSQLEndpointMetadataResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _, _ string, options *fabsqlendpoint.ItemsClientBeginRefreshSQLEndpointMetadataOptions) (resp azfake.PollerResponder[fabsqlendpoint.ItemsClientRefreshSQLEndpointMetadataResponse], errResp azfake.ErrorResponder) {
+		if options != nil && options.SQLEndpointRefreshMetadataRequest != nil {
+			*received = *options.SQLEndpointRefreshMetadataRequest
+		}
+
+		resp = azfake.PollerResponder[fabsqlendpoint.ItemsClientRefreshSQLEndpointMetadataResponse]{}
+		resp.SetTerminalResponse(http.StatusOK, fabsqlendpoint.ItemsClientRefreshSQLEndpointMetadataResponse{
+			TableSyncStatuses: fabsqlendpoint.TableSyncStatuses{
+				Value: tables,
+			},
+		}, nil)
+
+		return resp, errResp
+	}
+}
+
+func NewRandomTableSyncStatus(status fabsqlendpoint.SyncStatus) fabsqlendpoint.TableSyncStatus {
+	end := time.Now().UTC().Truncate(time.Second)
+	start := end.Add(-time.Minute)
+
+	entity := fabsqlendpoint.TableSyncStatus{
+		TableName:                  new(testhelp.RandomName()),
+		Status:                     azto.Ptr(status),
+		StartDateTime:              new(start),
+		EndDateTime:                new(end),
+		LastSuccessfulSyncDateTime: new(end),
+	}
+
+	if status == fabsqlendpoint.SyncStatusFailure {
+		entity.LastSuccessfulSyncDateTime = new(start.Add(-time.Hour))
+		entity.Error = &fabsqlendpoint.ErrorResponseDetails{
+			ErrorCode: new("TableSyncFailed"),
+			Message:   new("The table schema is not supported"),
+		}
+	}
+
+	return entity
+}
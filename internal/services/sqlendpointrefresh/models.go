@@ -0,0 +1,37 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sqlendpointrefresh
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fabsqlendpoint "github.com/microsoft/fabric-sdk-go/fabric/sqlendpoint"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+)
+
+/*
+ACTION
+*/
+
+type actionSQLEndpointMetadataRefreshModel struct {
+	WorkspaceID    customtypes.UUID `tfsdk:"workspace_id"`
+	SQLEndpointID  customtypes.UUID `tfsdk:"sql_endpoint_id"`
+	RecreateTables types.Bool       `tfsdk:"recreate_tables"`
+}
+
+type requestRefreshSQLEndpointMetadata struct {
+	fabsqlendpoint.RefreshMetadataRequest
+}
+
+func (to *requestRefreshSQLEndpointMetadata) set(from actionSQLEndpointMetadataRefreshModel, timeout time.Duration) {
+	to.RecreateTables = from.RecreateTables.ValueBoolPointer()
+
+	// the service stops the refresh after 15 minutes by default, align it with the provider timeout
+	to.Timeout = &fabsqlendpoint.Duration{
+		TimeUnit: new(fabsqlendpoint.TimeUnitSeconds),
+		Value:    new(float32(timeout.Seconds())),
+	}
+}
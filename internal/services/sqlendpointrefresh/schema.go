@@ -0,0 +1,36 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sqlendpointrefresh
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+)
+
+func itemSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "The " + ItemTypeInfo.Name + " action allows you to [refresh the metadata](" + ItemTypeInfo.DocsURL + ") of a Fabric SQL Endpoint, to sync its tables with the source item." +
+			"\n\nThe action waits for the SQL Endpoint to sync its tables, and fails with the table errors when any table fails to sync. " +
+			"Trigger it before the resources that read from the SQL Endpoint, such as Semantic Models, are deployed, to use up-to-date table metadata." +
+			"\n\n-> This action supports Service Principal authentication." +
+			"\n\n~> This action is in **preview**. To use it, you must explicitly enable the `preview` mode in the provider level configuration.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "The Workspace ID.",
+				CustomType:          customtypes.UUIDType{},
+				Required:            true,
+			},
+			"sql_endpoint_id": schema.StringAttribute{
+				MarkdownDescription: "The SQL Endpoint ID.",
+				CustomType:          customtypes.UUIDType{},
+				Required:            true,
+			},
+			"recreate_tables": schema.BoolAttribute{
+				MarkdownDescription: "Drop and recreate all tables of the SQL Endpoint during the refresh, to fully rebuild them from their source definitions. Defaults to `false`.",
+				Optional:            true,
+			},
+		},
+	}
+}
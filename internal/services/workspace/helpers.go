@@ -75,3 +75,58 @@ func validateCapacityState(ctx context.Context, client *fabcore.CapacitiesClient
 
 	return diags
 }
+
+func checkWorkspaceItems(ctx context.Context, client *fabcore.ItemsClient, workspaceID string) diag.Diagnostics {
+	items, err := client.ListItems(ctx, workspaceID, nil)
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationDelete, nil); diags.HasError() {
+		return diags
+	}
+
+	unmanagedItems := make([]string, 0, len(items))
+
+	for _, item := range items {
+		unmanagedItems = append(unmanagedItems, fmt.Sprintf("%s (%s, %s)", utils.ValueOrZero(item.DisplayName), utils.ValueOrZero(item.Type), utils.ValueOrZero(item.ID)))
+	}
+
+	return utils.CheckDeleteSafeguard(ItemTypeInfo.Name, workspaceID, "items", unmanagedItems)
+}
+
+func checkWorkspaceGitStatus(ctx context.Context, client *fabcore.GitClient, workspaceID string) diag.Diagnostics {
+	respConnection, err := client.GetConnection(ctx, workspaceID, nil)
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationDelete, nil); diags.HasError() {
+		return diags
+	}
+
+	// The Git status is only available once the connection is initialized.
+	if respConnection.GitConnectionState == nil || *respConnection.GitConnectionState != fabcore.GitConnectionStateConnectedAndInitialized {
+		return nil
+	}
+
+	respStatus, err := client.GetStatus(ctx, workspaceID, nil)
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationDelete, nil); diags.HasError() {
+		return diags
+	}
+
+	uncommittedChanges := make([]string, 0, len(respStatus.Changes))
+
+	for _, change := range respStatus.Changes {
+		if change.WorkspaceChange == nil || change.ItemMetadata == nil {
+			continue
+		}
+
+		uncommittedChanges = append(uncommittedChanges, fmt.Sprintf("%s (%s, %s)", utils.ValueOrZero(change.ItemMetadata.DisplayName), utils.ValueOrZero(change.ItemMetadata.ItemType), utils.ValueOrZero(change.WorkspaceChange)))
+	}
+
+	if len(uncommittedChanges) == 0 {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+
+	diags.AddError(
+		common.ErrorDeleteSafeguardHeader,
+		fmt.Sprintf(common.ErrorDeleteSafeguardGitDetails, workspaceID, strings.Join(uncommittedChanges, ", ")),
+	)
+
+	return diags
+}
@@ -0,0 +1,139 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResourceWithConfigure = (*listResourceWorkspace)(nil)
+)
+
+type listResourceWorkspace struct {
+	pConfigData *pconfig.ProviderData
+	client      *fabcore.WorkspacesClient
+	TypeInfo    tftypeinfo.TFTypeInfo
+}
+
+func NewListResourceWorkspace() list.ListResource {
+	return &listResourceWorkspace{
+		TypeInfo: ItemTypeInfo,
+	}
+}
+
+func (l *listResourceWorkspace) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = l.TypeInfo.FullTypeName(false)
+}
+
+func (l *listResourceWorkspace) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fabricitem.NewListResourceMarkdownDescription(l.TypeInfo) + "\n\n-> Personal and Admin Workspaces are not listed.",
+		Attributes:          map[string]schema.Attribute{},
+	}
+}
+
+func (l *listResourceWorkspace) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorListResourceConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	l.pConfigData = pConfigData
+	l.client = fabcore.NewClientFactoryWithClient(*pConfigData.FabricClient).NewWorkspacesClient()
+}
+
+func (l *listResourceWorkspace) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Debug(ctx, "LIST", map[string]any{
+		"action": "start",
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, l.pConfigData.Timeout)
+
+	respList, err := l.client.ListWorkspaces(ctx, nil)
+
+	cancel()
+
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationList, nil); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+
+		for _, entity := range respList {
+			if entity.Type == nil || *entity.Type != fabcore.WorkspaceTypeWorkspace {
+				continue
+			}
+
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = utils.ValueOrZero(entity.DisplayName)
+
+			result.Diagnostics.Append(setIdentity(ctx, result.Identity, customtypes.NewUUIDPointerValue(entity.ID))...)
+
+			if req.IncludeResource {
+				result.Diagnostics.Append(setListResultResource(ctx, result.Resource, entity)...)
+			}
+
+			if !push(result) {
+				return
+			}
+
+			count++
+		}
+
+		tflog.Debug(ctx, "LIST", map[string]any{
+			"action": "end",
+		})
+	}
+}
+
+func setListResultResource(ctx context.Context, to *tfsdk.Resource, from fabcore.Workspace) diag.Diagnostics {
+	var model baseWorkspaceModel
+
+	model.set(from)
+
+	var diags diag.Diagnostics
+
+	diags.Append(to.SetAttribute(ctx, path.Root("id"), model.ID)...)
+	diags.Append(to.SetAttribute(ctx, path.Root("display_name"), model.DisplayName)...)
+	diags.Append(to.SetAttribute(ctx, path.Root("description"), model.Description)...)
+	diags.Append(to.SetAttribute(ctx, path.Root("type"), model.Type)...)
+	diags.Append(to.SetAttribute(ctx, path.Root("capacity_id"), model.CapacityID)...)
+
+	return diags
+}
@@ -0,0 +1,91 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspace_test
+
+import (
+	"regexp"
+	"testing"
+
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testListItemFQN, testListItemHeader = testhelp.TFList(common.ProviderTypeName, itemTypeInfo.Type, "test")
+
+func TestUnit_WorkspaceListResource(t *testing.T) {
+	capacity := fakes.NewRandomCapacity()
+	entity := fakes.NewRandomWorkspaceInfoWithType(fabcore.WorkspaceTypeWorkspace, capacity.ID)
+
+	fakes.FakeServer.Upsert(capacity)
+	fakes.FakeServer.Upsert(entity)
+	fakes.FakeServer.Upsert(fakes.NewRandomWorkspaceInfoWithType(fabcore.WorkspaceTypePersonal, capacity.ID))
+	fakes.FakeServer.Upsert(fakes.NewRandomWorkspaceInfoWithType(fabcore.WorkspaceTypeAdminWorkspace, capacity.ID))
+
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, nil, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// init working directory
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemsHeader,
+				map[string]any{},
+			),
+		},
+		// error - unexpected_attr
+		{
+			Query: true,
+			Config: testhelp.TFListConfig(
+				common.ProviderTypeName,
+				testListItemHeader,
+				map[string]any{
+					"unexpected_attr": "test",
+				},
+			),
+			ExpectError: regexp.MustCompile(`An argument named "unexpected_attr" is not expected here`),
+		},
+		// list
+		{
+			Query: true,
+			Config: testhelp.TFListConfig(
+				common.ProviderTypeName,
+				testListItemHeader,
+				map[string]any{},
+			),
+			QueryResultChecks: []querycheck.QueryResultCheck{
+				querycheck.ExpectIdentity(testListItemFQN, map[string]knownvalue.Check{
+					"id": knownvalue.StringExact(*entity.ID),
+				}),
+			},
+		},
+	}))
+}
+
+func TestAcc_WorkspaceListResource(t *testing.T) {
+	resource.ParallelTest(t, testhelp.NewTestAccCase(t, nil, nil, []resource.TestStep{
+		// init working directory
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemsHeader,
+				map[string]any{},
+			),
+		},
+		// list
+		{
+			Query: true,
+			Config: testhelp.TFListConfig(
+				common.ProviderTypeName,
+				testListItemHeader,
+				map[string]any{},
+			),
+			QueryResultChecks: []querycheck.QueryResultCheck{
+				querycheck.ExpectLengthAtLeast(testListItemFQN, 1),
+			},
+		},
+	}))
+}
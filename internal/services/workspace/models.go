@@ -9,6 +9,7 @@ import (
 	timeoutsD "github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts" //revive:disable-line:import-alias-naming
 	timeoutsR "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"   //revive:disable-line:import-alias-naming
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
@@ -122,7 +123,20 @@ RESOURCE
 type resourceWorkspaceModel struct {
 	baseWorkspaceInfoModel
 
-	Timeouts timeoutsR.Value `tfsdk:"timeouts"`
+	DeleteSafeguards supertypes.SingleNestedObjectValueOf[deleteSafeguardsModel] `tfsdk:"delete_safeguards"`
+	Timeouts         timeoutsR.Value                                             `tfsdk:"timeouts"`
+}
+
+type resourceWorkspaceIdentityModel struct {
+	ID customtypes.UUID `tfsdk:"id"`
+}
+
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id customtypes.UUID) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, resourceWorkspaceIdentityModel{ID: id})
 }
 
 type requestCreateWorkspace struct {
@@ -168,6 +182,11 @@ func (to *workspaceIdentityModel) set(from fabcore.WorkspaceIdentity) {
 	to.ServicePrincipalID = customtypes.NewUUIDPointerValue(from.ServicePrincipalID)
 }
 
+type deleteSafeguardsModel struct {
+	Force          types.Bool `tfsdk:"force"`
+	CheckGitStatus types.Bool `tfsdk:"check_git_status"`
+}
+
 type oneLakeEndpointsModel struct {
 	BlobEndpoint customtypes.URL `tfsdk:"blob_endpoint"`
 	DfsEndpoint  customtypes.URL `tfsdk:"dfs_endpoint"`
@@ -5,18 +5,20 @@ package workspace
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
 
 	"github.com/microsoft/terraform-provider-fabric/internal/common"
 	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
 	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
 	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
 	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
@@ -26,12 +28,15 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = (*resourceWorkspace)(nil)
 	_ resource.ResourceWithImportState = (*resourceWorkspace)(nil)
+	_ resource.ResourceWithIdentity    = (*resourceWorkspace)(nil)
 )
 
 type resourceWorkspace struct {
 	pConfigData    *pconfig.ProviderData
 	client         *fabcore.WorkspacesClient
 	clientCapacity *fabcore.CapacitiesClient
+	clientItems    *fabcore.ItemsClient
+	clientGit      *fabcore.GitClient
 	TypeInfo       tftypeinfo.TFTypeInfo
 }
 
@@ -49,6 +54,18 @@ func (r *resourceWorkspace) Schema(ctx context.Context, _ resource.SchemaRequest
 	resp.Schema = itemSchema(false).GetResource(ctx)
 }
 
+func (r *resourceWorkspace) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The Workspace ID.",
+				RequiredForImport: true,
+				CustomType:        customtypes.UUIDType{},
+			},
+		},
+	}
+}
+
 func (r *resourceWorkspace) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -67,6 +84,8 @@ func (r *resourceWorkspace) Configure(_ context.Context, req resource.ConfigureR
 	r.pConfigData = pConfigData
 	r.client = fabcore.NewClientFactoryWithClient(*pConfigData.FabricClient).NewWorkspacesClient()
 	r.clientCapacity = fabcore.NewClientFactoryWithClient(*pConfigData.FabricClient).NewCapacitiesClient()
+	r.clientItems = fabcore.NewClientFactoryWithClient(*pConfigData.FabricClient).NewItemsClient()
+	r.clientGit = fabcore.NewClientFactoryWithClient(*pConfigData.FabricClient).NewGitClient()
 }
 
 func (r *resourceWorkspace) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -90,6 +109,7 @@ func (r *resourceWorkspace) Create(ctx context.Context, req resource.CreateReque
 
 	state.Timeouts = plan.Timeouts
 	state.SkipCapacityStateValidation = plan.SkipCapacityStateValidation
+	state.DeleteSafeguards = plan.DeleteSafeguards
 
 	var reqCreate requestCreateWorkspace
 
@@ -142,6 +162,7 @@ func (r *resourceWorkspace) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "CREATE", map[string]any{
 		"action": "end",
@@ -185,6 +206,7 @@ func (r *resourceWorkspace) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "READ", map[string]any{
 		"action": "end",
@@ -218,6 +240,7 @@ func (r *resourceWorkspace) Update(ctx context.Context, req resource.UpdateReque
 
 	intermediary.Timeouts = plan.Timeouts
 	intermediary.SkipCapacityStateValidation = plan.SkipCapacityStateValidation
+	intermediary.DeleteSafeguards = plan.DeleteSafeguards
 
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
@@ -347,6 +370,7 @@ func (r *resourceWorkspace) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIdentityFromState(ctx, resp.Identity, resp.State)...)
 
 	tflog.Debug(ctx, "UPDATE", map[string]any{
 		"action": "end",
@@ -376,6 +400,10 @@ func (r *resourceWorkspace) Delete(ctx context.Context, req resource.DeleteReque
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	if resp.Diagnostics.Append(r.checkDeleteSafeguards(ctx, state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
 	if !state.Identity.IsNull() && !state.Identity.IsUnknown() {
 		identityState, diags := state.Identity.Get(ctx)
 		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
@@ -418,12 +446,14 @@ func (r *resourceWorkspace) ImportState(ctx context.Context, req resource.Import
 		"id": req.ID,
 	})
 
-	_, diags := customtypes.NewUUIDValueMust(req.ID)
-	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
-		return
+	if req.ID != "" {
+		_, diags := customtypes.NewUUIDValueMust(req.ID)
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 
 	tflog.Debug(ctx, "IMPORT", map[string]any{
 		"action": "end",
@@ -441,43 +471,77 @@ func (r *resourceWorkspace) get(ctx context.Context, model *resourceWorkspaceMod
 
 	var diags diag.Diagnostics
 
-	for {
-		respGet, err := r.client.GetWorkspace(ctx, model.ID.ValueString(), nil)
-		if diags := utils.GetDiagsFromError(ctx, err, utils.OperationRead, fabcore.ErrWorkspace.WorkspaceNotFound); diags.HasError() {
-			return diags
-		}
+	workspace, err := fabricitem.WaitForCapacityAssignment(ctx, r.client, model.ID.ValueString())
+	if errors.Is(err, fabricitem.ErrCapacityAssignmentFailed) {
+		diags.AddError(
+			"capacity assignment operation",
+			"Workspace capacity assignment failed",
+		)
 
-		if diags := checkWorkspaceType(respGet.WorkspaceInfo); diags.HasError() {
-			return diags
-		}
+		return diags
+	}
 
-		switch *respGet.CapacityAssignmentProgress {
-		case fabcore.CapacityAssignmentProgressFailed:
-			diags.AddError(
-				"capacity assignment operation",
-				"Workspace capacity assignment failed",
-			)
+	if diags := utils.GetDiagsFromError(ctx, err, utils.OperationRead, fabcore.ErrWorkspace.WorkspaceNotFound); diags.HasError() {
+		return diags
+	}
 
-			return diags
+	if diags := checkWorkspaceType(workspace); diags.HasError() {
+		return diags
+	}
 
-		case fabcore.CapacityAssignmentProgressCompleted:
-			skipValidation := model.SkipCapacityStateValidation
+	skipValidation := model.SkipCapacityStateValidation
 
-			diags = model.set(ctx, respGet.WorkspaceInfo)
-			if diags.HasError() {
-				return diags
-			}
+	diags = model.set(ctx, workspace)
+	if diags.HasError() {
+		return diags
+	}
 
-			model.SkipCapacityStateValidation = skipValidation
+	model.SkipCapacityStateValidation = skipValidation
 
-			if !model.SkipCapacityStateValidation.ValueBool() {
-				return validateCapacityState(ctx, r.clientCapacity, model.CapacityID.ValueStringPointer())
-			}
+	if !model.SkipCapacityStateValidation.ValueBool() {
+		return validateCapacityState(ctx, r.clientCapacity, model.CapacityID.ValueStringPointer())
+	}
+
+	return nil
+}
+
+func (r *resourceWorkspace) checkDeleteSafeguards(ctx context.Context, model resourceWorkspaceModel) diag.Diagnostics {
+	if model.DeleteSafeguards.IsNull() || model.DeleteSafeguards.IsUnknown() {
+		return nil
+	}
+
+	safeguards, diags := model.DeleteSafeguards.Get(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	if safeguards.Force.ValueBool() {
+		tflog.Info(ctx, "Delete safeguards are forced, the Workspace is deleted with all its contents", map[string]any{
+			"id": model.ID.ValueString(),
+		})
+
+		return nil
+	}
+
+	tflog.Debug(ctx, "CHECK DELETE SAFEGUARDS", map[string]any{
+		"action": "start",
+		"id":     model.ID.ValueString(),
+	})
+
+	if diags := checkWorkspaceItems(ctx, r.clientItems, model.ID.ValueString()); diags.HasError() {
+		return diags
+	}
 
-			return nil
-		default:
-			tflog.Info(ctx, "Workspace capacity assignment in progress, waiting 30 seconds before retrying")
-			time.Sleep(30 * time.Second) // lintignore:R018
+	if safeguards.CheckGitStatus.ValueBool() {
+		if diags := checkWorkspaceGitStatus(ctx, r.clientGit, model.ID.ValueString()); diags.HasError() {
+			return diags
 		}
 	}
+
+	tflog.Debug(ctx, "CHECK DELETE SAFEGUARDS", map[string]any{
+		"action": "end",
+		"id":     model.ID.ValueString(),
+	})
+
+	return nil
 }
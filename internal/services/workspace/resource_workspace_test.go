@@ -11,6 +11,7 @@ import (
 	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
 
 	"github.com/microsoft/terraform-provider-fabric/internal/common"
 	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
@@ -227,6 +228,19 @@ func TestUnit_WorkspaceResource_NoCapacity_CRUD(t *testing.T) {
 				resource.TestCheckResourceAttrPtr(testResourceItemFQN, "description", entityAfter.Description),
 			),
 		},
+		// Import with the resource identity
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"display_name": *entityAfter.DisplayName,
+					"description":  *entityAfter.Description,
+				},
+			),
+			ImportState:     true,
+			ImportStateKind: resource.ImportBlockWithResourceIdentity,
+		},
 		// Delete testing automatically occurs in TestCase
 	}))
 }
@@ -512,3 +526,57 @@ func TestAcc_WorkspaceResource_Identity_CRUD(t *testing.T) {
 	},
 	))
 }
+
+func TestUnit_WorkspaceResource_DeleteSafeguards(t *testing.T) {
+	entity := fakes.NewRandomWorkspaceInfo(nil)
+
+	var workspaceID string
+
+	config := func(force bool) string {
+		return at.CompileConfig(
+			testResourceItemHeader,
+			map[string]any{
+				"display_name": *entity.DisplayName,
+				"delete_safeguards": map[string]any{
+					"force": force,
+				},
+			},
+		)
+	}
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config:       config(false),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "delete_safeguards.force", "false"),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "delete_safeguards.check_git_status", "false"),
+				func(s *terraform.State) error {
+					workspaceID = s.RootModule().Resources[testResourceItemFQN].Primary.ID
+
+					return nil
+				},
+			),
+		},
+		// error - delete - unmanaged item in the Workspace
+		{
+			ResourceName: testResourceItemFQN,
+			PreConfig: func() {
+				fakes.FakeServer.Upsert(fakes.NewRandomItemWithWorkspace(fabcore.ItemTypeLakehouse, workspaceID))
+			},
+			Config:      config(false),
+			Destroy:     true,
+			ExpectError: regexp.MustCompile(common.ErrorDeleteSafeguardHeader),
+		},
+		// Update and Read - force the deletion
+		{
+			ResourceName: testResourceItemFQN,
+			Config:       config(true),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "delete_safeguards.force", "true"),
+			),
+		},
+		// Delete testing automatically occurs in TestCase
+	}))
+}
@@ -249,6 +249,32 @@ func itemSchema(isList bool) superschema.Schema { //revive:disable-line:flag-par
 					MarkdownDescription: "Value defaults to `false`.",
 				},
 			},
+			"delete_safeguards": superschema.SuperSingleNestedAttributeOf[deleteSafeguardsModel]{
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "Checks run before the " + ItemTypeInfo.Name + " is deleted. When set, the deletion is refused while the " + ItemTypeInfo.Name + " still contains items. " +
+						"Items managed by Terraform are destroyed before the " + ItemTypeInfo.Name + ", so the remaining ones are not managed by Terraform. " +
+						"Changes to this attribute must be applied before the " + ItemTypeInfo.Name + " is deleted.",
+					Optional: true,
+				},
+				Attributes: map[string]superschema.Attribute{
+					"force": superschema.BoolAttribute{
+						Resource: &schemaR.BoolAttribute{
+							MarkdownDescription: "Whether to delete the " + ItemTypeInfo.Name + " even if the checks fail.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+					"check_git_status": superschema.BoolAttribute{
+						Resource: &schemaR.BoolAttribute{
+							MarkdownDescription: "Whether to also refuse the deletion when the " + ItemTypeInfo.Name + " is connected to Git and has uncommitted changes.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
 		},
 	}
 
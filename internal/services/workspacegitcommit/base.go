@@ -0,0 +1,16 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacegitcommit
+
+import (
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+)
+
+var ItemTypeInfo = tftypeinfo.TFTypeInfo{ //nolint:gochecknoglobals
+	Name:           "Workspace Git Commit",
+	Type:           "workspace_git_commit",
+	DocsURL:        "https://learn.microsoft.com/fabric/cicd/git-integration/git-get-started#commit-changes-to-git",
+	IsPreview:      false,
+	IsSPNSupported: true,
+}
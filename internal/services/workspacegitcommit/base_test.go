@@ -0,0 +1,10 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacegitcommit_test
+
+import (
+	"github.com/microsoft/terraform-provider-fabric/internal/services/workspacegitcommit"
+)
+
+var itemTypeInfo = workspacegitcommit.ItemTypeInfo
@@ -0,0 +1,77 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacegitcommit_test
+
+import (
+	"context"
+	"net/http"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	azto "github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+)
+
+// Returns a fake function that reports the provided status until a commit is made, and a synced status afterwards.
+func fakeGitGetStatus(
+	exampleResp fabcore.GitStatusResponse,
+	committed *bool,
+) func(ctx context.Context, workspaceID string, options *fabcore.GitClientBeginGetStatusOptions) (resp azfake.PollerResponder[fabcore.GitClientGetStatusResponse], errResp azfake.ErrorResponder) {
+	commitHash := testhelp.RandomSHA1()
+
+	return func(_ context.Context, _ string, _ *fabcore.GitClientBeginGetStatusOptions) (resp azfake.PollerResponder[fabcore.GitClientGetStatusResponse], errResp azfake.ErrorResponder) {
+		status := exampleResp
+
+		if *committed {
+			status = fabcore.GitStatusResponse{
+				RemoteCommitHash: new(commitHash),
+				WorkspaceHead:    new(commitHash),
+				Changes:          []fabcore.ItemChange{},
+			}
+		}
+
+		resp = azfake.PollerResponder[fabcore.GitClientGetStatusResponse]{}
+		resp.SetTerminalResponse(http.StatusOK, fabcore.GitClientGetStatusResponse{GitStatusResponse: status}, nil)
+
+		return resp, errResp
+	}
+}
+
+// Returns a fake function that records the received commit request.
+func fakeGitCommitToGit(
+	committed *bool,
+	received *fabcore.CommitToGitRequest,
+) func(ctx context.Context, workspaceID string, commitToGitRequest fabcore.CommitToGitRequest, options *fabcore.GitClientBeginCommitToGitOptions) (resp azfake.PollerResponder[fabcore.GitClientCommitToGitResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _ string, commitToGitRequest fabcore.CommitToGitRequest, _ *fabcore.GitClientBeginCommitToGitOptions) (resp azfake.PollerResponder[fabcore.GitClientCommitToGitResponse], errResp azfake.ErrorResponder) {
+		*committed = true
+		*received = commitToGitRequest
+
+		resp = azfake.PollerResponder[fabcore.GitClientCommitToGitResponse]{}
+		resp.SetTerminalResponse(http.StatusOK, fabcore.GitClientCommitToGitResponse{}, nil)
+
+		return resp, errResp
+	}
+}
+
+func NewRandomGitStatus() fabcore.GitStatusResponse {
+	return fabcore.GitStatusResponse{
+		RemoteCommitHash: new(testhelp.RandomSHA1()),
+		WorkspaceHead:    new(testhelp.RandomSHA1()),
+		Changes: []fabcore.ItemChange{
+			{
+				ItemMetadata: &fabcore.ItemMetadata{
+					DisplayName: new(testhelp.RandomName()),
+					ItemType:    azto.Ptr(fabcore.ItemTypeNotebook),
+					ItemIdentifier: &fabcore.ItemIdentifier{
+						LogicalID: new(testhelp.RandomUUID()),
+						ObjectID:  new(testhelp.RandomUUID()),
+					},
+				},
+				WorkspaceChange: azto.Ptr(fabcore.ChangeTypeModified),
+				ConflictType:    azto.Ptr(fabcore.ConflictTypeNone),
+			},
+		},
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacegitcommit
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+)
+
+/*
+RESOURCE
+*/
+
+type resourceWorkspaceGitCommitModel struct {
+	ID               customtypes.UUID                                       `tfsdk:"id"`
+	WorkspaceID      customtypes.UUID                                       `tfsdk:"workspace_id"`
+	Mode             types.String                                           `tfsdk:"mode"`
+	Comment          types.String                                           `tfsdk:"comment"`
+	Items            supertypes.SetNestedObjectValueOf[itemIdentifierModel] `tfsdk:"items"`
+	Triggers         supertypes.MapValueOf[types.String]                    `tfsdk:"triggers"`
+	RemoteCommitHash types.String                                           `tfsdk:"remote_commit_hash"`
+	WorkspaceHead    types.String                                           `tfsdk:"workspace_head"`
+	Timeouts         timeouts.Value                                         `tfsdk:"timeouts"`
+}
+
+func (to *resourceWorkspaceGitCommitModel) set(from fabcore.GitStatusResponse) {
+	to.ID = to.WorkspaceID
+	to.RemoteCommitHash = types.StringPointerValue(from.RemoteCommitHash)
+	to.WorkspaceHead = types.StringPointerValue(from.WorkspaceHead)
+}
+
+type requestCommitToGit struct {
+	fabcore.CommitToGitRequest
+}
+
+func (to *requestCommitToGit) set(ctx context.Context, from resourceWorkspaceGitCommitModel, workspaceHead *string) diag.Diagnostics {
+	to.Mode = (*fabcore.CommitMode)(from.Mode.ValueStringPointer())
+	to.Comment = from.Comment.ValueStringPointer()
+	to.WorkspaceHead = workspaceHead
+
+	if from.Items.IsNull() || from.Items.IsUnknown() {
+		return nil
+	}
+
+	items, diags := from.Items.Get(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	to.Items = make([]fabcore.ItemIdentifier, 0, len(items))
+
+	for _, item := range items {
+		to.Items = append(to.Items, fabcore.ItemIdentifier{
+			LogicalID: item.LogicalID.ValueStringPointer(),
+			ObjectID:  item.ObjectID.ValueStringPointer(),
+		})
+	}
+
+	return nil
+}
+
+/*
+HELPER MODELS
+*/
+
+type itemIdentifierModel struct {
+	LogicalID customtypes.UUID `tfsdk:"logical_id"`
+	ObjectID  customtypes.UUID `tfsdk:"object_id"`
+}
@@ -0,0 +1,225 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacegitcommit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.ResourceWithConfigure      = (*resourceWorkspaceGitCommit)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceWorkspaceGitCommit)(nil)
+)
+
+type resourceWorkspaceGitCommit struct {
+	pConfigData *pconfig.ProviderData
+	client      *fabcore.GitClient
+	TypeInfo    tftypeinfo.TFTypeInfo
+}
+
+func NewResourceWorkspaceGitCommit() resource.Resource {
+	return &resourceWorkspaceGitCommit{
+		TypeInfo: ItemTypeInfo,
+	}
+}
+
+func (r *resourceWorkspaceGitCommit) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeInfo.FullTypeName(false)
+}
+
+func (r *resourceWorkspaceGitCommit) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = itemSchema().GetResource(ctx)
+}
+
+func (r *resourceWorkspaceGitCommit) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config resourceWorkspaceGitCommitModel
+
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &config)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Mode.IsNull() || config.Mode.IsUnknown() || config.Items.IsUnknown() {
+		return
+	}
+
+	switch fabcore.CommitMode(config.Mode.ValueString()) {
+	case fabcore.CommitModeSelective:
+		if config.Items.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("items"),
+				common.ErrorInvalidConfig,
+				fmt.Sprintf("The items must be set when the mode is '%s'.", fabcore.CommitModeSelective),
+			)
+		}
+	case fabcore.CommitModeAll:
+		if !config.Items.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("items"),
+				common.ErrorInvalidConfig,
+				fmt.Sprintf("The items can only be set when the mode is '%s'.", fabcore.CommitModeSelective),
+			)
+		}
+	}
+}
+
+func (r *resourceWorkspaceGitCommit) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorResourceConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	r.pConfigData = pConfigData
+
+	if resp.Diagnostics.Append(fabricitem.IsPreviewMode(r.TypeInfo.Name, r.TypeInfo.IsPreview, r.pConfigData.Preview)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client = fabcore.NewClientFactoryWithClient(*pConfigData.FabricClient).NewGitClient()
+}
+
+func (r *resourceWorkspaceGitCommit) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan resourceWorkspaceGitCommitModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	respStatus, err := r.client.GetStatus(ctx, plan.WorkspaceID.ValueString(), nil)
+	if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationCreate, nil)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if hasWorkspaceChanges(respStatus.Changes) {
+		var reqCommit requestCommitToGit
+
+		if resp.Diagnostics.Append(reqCommit.set(ctx, plan, respStatus.WorkspaceHead)...); resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err = r.client.CommitToGit(ctx, plan.WorkspaceID.ValueString(), reqCommit.CommitToGitRequest, nil)
+		if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationCreate, nil)...); resp.Diagnostics.HasError() {
+			return
+		}
+
+		respStatus, err = r.client.GetStatus(ctx, plan.WorkspaceID.ValueString(), nil)
+		if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationCreate, nil)...); resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		resp.Diagnostics.AddWarning(
+			common.WarningGitNothingToCommitHeader,
+			fmt.Sprintf(common.WarningGitNothingToCommitDetails, plan.WorkspaceID.ValueString()),
+		)
+	}
+
+	plan.set(respStatus.GitStatusResponse)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceWorkspaceGitCommit) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "start",
+	})
+
+	// the commit is a completed operation, the state is kept as is
+
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "end",
+	})
+}
+
+func (r *resourceWorkspaceGitCommit) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan, state resourceWorkspaceGitCommitModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only the timeouts can be updated in place, every other argument requires a new commit
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceWorkspaceGitCommit) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "start",
+	})
+
+	// commits cannot be reverted, the resource is only removed from the state
+
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "end",
+	})
+}
+
+// hasWorkspaceChanges returns true when at least one item was changed on the Workspace side.
+func hasWorkspaceChanges(changes []fabcore.ItemChange) bool {
+	for _, change := range changes {
+		if change.WorkspaceChange != nil {
+			return true
+		}
+	}
+
+	return false
+}
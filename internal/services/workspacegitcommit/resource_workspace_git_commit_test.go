@@ -0,0 +1,282 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacegitcommit_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testResourceItemFQN, testResourceItemHeader = testhelp.TFResource(common.ProviderTypeName, itemTypeInfo.Type, "test")
+
+func TestUnit_WorkspaceGitCommitResource_Attributes(t *testing.T) {
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no required attributes - workspace_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"mode": string(fabcore.CommitModeAll),
+				},
+			),
+			ExpectError: regexp.MustCompile(`The argument "workspace_id" is required, but no definition was found.`),
+		},
+		// error - no required attributes - mode
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+				},
+			),
+			ExpectError: regexp.MustCompile(`The argument "mode" is required, but no definition was found.`),
+		},
+		// error - invalid UUID - workspace_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "invalid uuid",
+					"mode":         string(fabcore.CommitModeAll),
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - invalid mode
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"mode":         "Invalid",
+				},
+			),
+			ExpectError: regexp.MustCompile(`Attribute mode value must be one of`),
+		},
+		// error - selective mode without items
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"mode":         string(fabcore.CommitModeSelective),
+				},
+			),
+			ExpectError: regexp.MustCompile(`The items must be set when the mode is 'Selective'.`),
+		},
+		// error - all mode with items
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"mode":         string(fabcore.CommitModeAll),
+					"items": []map[string]any{
+						{
+							"object_id": "11111111-1111-1111-1111-111111111111",
+						},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(`The items can only be set when the mode is 'Selective'.`),
+		},
+		// error - item without identifier
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"mode":         string(fabcore.CommitModeSelective),
+					"items": []map[string]any{
+						{},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(`At least one attribute out of`),
+		},
+	}))
+}
+
+func TestUnit_WorkspaceGitCommitResource_CRUD(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	entity := NewRandomGitStatus()
+	committed := false
+
+	var received fabcore.CommitToGitRequest
+
+	fakes.FakeServer.ServerFactory.Core.GitServer.BeginGetStatus = fakeGitGetStatus(entity, &committed)
+	fakes.FakeServer.ServerFactory.Core.GitServer.BeginCommitToGit = fakeGitCommitToGit(&committed, &received)
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+					"mode":         string(fabcore.CommitModeSelective),
+					"comment":      "Release 1.0",
+					"items": []map[string]any{
+						{
+							"object_id": *entity.Changes[0].ItemMetadata.ItemIdentifier.ObjectID,
+						},
+					},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "id", workspaceID),
+				resource.TestCheckResourceAttrPair(testResourceItemFQN, "remote_commit_hash", testResourceItemFQN, "workspace_head"),
+				func(_ *terraform.State) error {
+					if !committed {
+						return errors.New("expected a commit to be made")
+					}
+
+					if received.WorkspaceHead == nil || *received.WorkspaceHead != *entity.WorkspaceHead {
+						return errors.New("expected the commit to use the workspace head from the status")
+					}
+
+					if len(received.Items) != 1 || *received.Items[0].ObjectID != *entity.Changes[0].ItemMetadata.ItemIdentifier.ObjectID {
+						return errors.New("expected the commit to include the selected item")
+					}
+
+					return nil
+				},
+			),
+		},
+	}))
+}
+
+func TestUnit_WorkspaceGitCommitResource_NoChanges(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	commitHash := testhelp.RandomSHA1()
+	entity := fabcore.GitStatusResponse{
+		RemoteCommitHash: new(commitHash),
+		WorkspaceHead:    new(commitHash),
+		Changes:          []fabcore.ItemChange{},
+	}
+	committed := false
+
+	var received fabcore.CommitToGitRequest
+
+	fakes.FakeServer.ServerFactory.Core.GitServer.BeginGetStatus = fakeGitGetStatus(entity, &committed)
+	fakes.FakeServer.ServerFactory.Core.GitServer.BeginCommitToGit = fakeGitCommitToGit(&committed, &received)
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+					"mode":         string(fabcore.CommitModeAll),
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "remote_commit_hash", commitHash),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "workspace_head", commitHash),
+				func(_ *terraform.State) error {
+					if committed {
+						return errors.New("expected no commit to be made")
+					}
+
+					return nil
+				},
+			),
+		},
+	}))
+}
+
+func TestAcc_WorkspaceGitCommitResource_CRUD(t *testing.T) {
+	capacity := testhelp.WellKnown()["Capacity"].(map[string]any)
+	capacityID := capacity["id"].(string)
+
+	doPlatform := testhelp.WellKnown()["AzDO"].(map[string]any)
+	azdoOrganization := doPlatform["organizationName"].(string)
+	azdoProject := doPlatform["projectName"].(string)
+	azdoRepository := doPlatform["repositoryName"].(string)
+	adoConnectionID := doPlatform["connectionId"].(string)
+
+	workspaceResourceHCL, workspaceResourceFQN := testhelp.TestAccWorkspaceResource(t, capacityID)
+
+	workspaceGitResourceFQN, workspaceGitResourceHeader := testhelp.TFResource(common.ProviderTypeName, "workspace_git", "test")
+
+	workspaceGitResourceHCL := at.CompileConfig(
+		workspaceGitResourceHeader,
+		map[string]any{
+			"workspace_id":            testhelp.RefByFQN(workspaceResourceFQN, "id"),
+			"initialization_strategy": "PreferWorkspace",
+			"git_provider_details": map[string]any{
+				"git_provider_type": "AzureDevOps",
+				"organization_name": azdoOrganization,
+				"project_name":      azdoProject,
+				"repository_name":   azdoRepository,
+				"branch_name":       "main",
+				"directory_name":    "/",
+			},
+			"git_credentials": map[string]any{
+				"source":        string(fabcore.GitCredentialsSourceConfiguredConnection),
+				"connection_id": adoConnectionID,
+			},
+		},
+	)
+
+	lakehouseResourceFQN := testhelp.ResourceFQN(common.ProviderTypeName, "lakehouse", "test")
+	lakehouseResourceHCL := at.CompileConfig(
+		at.ResourceHeader(testhelp.TypeName(common.ProviderTypeName, "lakehouse"), "test"),
+		map[string]any{
+			"display_name": testhelp.RandomName(),
+			"workspace_id": testhelp.RefByFQN(workspaceGitResourceFQN, "workspace_id"),
+		},
+	)
+
+	resource.Test(t, testhelp.NewTestAccCase(t, &testResourceItemFQN, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.JoinConfigs(
+				workspaceResourceHCL,
+				workspaceGitResourceHCL,
+				lakehouseResourceHCL,
+				at.CompileConfig(
+					testResourceItemHeader,
+					map[string]any{
+						"workspace_id": testhelp.RefByFQN(workspaceGitResourceFQN, "workspace_id"),
+						"mode":         string(fabcore.CommitModeSelective),
+						"comment":      "testacc",
+						"items": []map[string]any{
+							{
+								"object_id": testhelp.RefByFQN(lakehouseResourceFQN, "id"),
+							},
+						},
+					},
+				),
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrPair(testResourceItemFQN, "id", workspaceResourceFQN, "id"),
+				resource.TestCheckResourceAttrSet(testResourceItemFQN, "remote_commit_hash"),
+				resource.TestCheckResourceAttrPair(testResourceItemFQN, "remote_commit_hash", testResourceItemFQN, "workspace_head"),
+			),
+		},
+	}))
+}
@@ -0,0 +1,156 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacegitcommit
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema" //revive:disable-line:import-alias-naming
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func itemSchema() superschema.Schema {
+	markdownDescriptionR := fabricitem.NewResourceMarkdownDescription(ItemTypeInfo, false) +
+		"\n\n-> The commit runs once, when the resource is created. Any change to the arguments, including `triggers`, runs a new commit. " +
+		"When the Workspace has no uncommitted changes, no commit is made. Destroying the resource only removes it from the Terraform state."
+
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: markdownDescriptionR,
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The " + ItemTypeInfo.Name + " ID.",
+					CustomType:          customtypes.UUIDType{},
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"workspace_id": superschema.SuperStringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The Workspace ID. The Workspace must be connected to Git and the connection initialized.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"mode": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The mode of the commit. `All` commits all the uncommitted changes of the Workspace, `Selective` commits only the changes of the specified `items`.",
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.String{
+						stringvalidator.OneOf(utils.ConvertEnumsToStringSlices(fabcore.PossibleCommitModeValues(), true)...),
+					},
+				},
+			},
+			"comment": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The comment of the commit. If not set, the default comment of the Git provider is used.",
+					Optional:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.String{
+						stringvalidator.LengthAtMost(300),
+					},
+				},
+			},
+			"items": superschema.SuperSetNestedAttributeOf[itemIdentifierModel]{
+				Resource: &schemaR.SetNestedAttribute{
+					MarkdownDescription: "The set of items to commit. Required when `mode` is `Selective`, not allowed otherwise.",
+					Optional:            true,
+					PlanModifiers: []planmodifier.Set{
+						setplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+					},
+				},
+				Attributes: superschema.Attributes{
+					"logical_id": superschema.SuperStringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The logical ID of the item. Use it when the item was deleted from the Workspace.",
+							CustomType:          customtypes.UUIDType{},
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.AtLeastOneOf(
+									path.MatchRelative().AtParent().AtName("object_id"),
+								),
+							},
+						},
+					},
+					"object_id": superschema.SuperStringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The object ID of the item in the Workspace.",
+							CustomType:          customtypes.UUIDType{},
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"triggers": superschema.SuperMapAttribute{
+				Resource: &schemaR.MapAttribute{
+					MarkdownDescription: "A map of arbitrary values that, when changed, run a new commit.",
+					CustomType:          supertypes.MapTypeOf[types.String]{MapType: types.MapType{ElemType: types.StringType}},
+					ElementType:         types.StringType,
+					Optional:            true,
+					PlanModifiers: []planmodifier.Map{
+						mapplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.Map{
+						mapvalidator.NoNullValues(),
+					},
+				},
+			},
+			"remote_commit_hash": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The full SHA hash of the latest commit in the remote branch, after the commit.",
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"workspace_head": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The full SHA hash of the commit the Workspace is synced to, after the commit.",
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"timeouts": superschema.TimeoutAttribute{
+				Resource: &superschema.ResourceTimeoutAttribute{
+					Create: true,
+					Read:   true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		},
+	}
+}
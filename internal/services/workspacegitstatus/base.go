@@ -0,0 +1,16 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacegitstatus
+
+import (
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+)
+
+var ItemTypeInfo = tftypeinfo.TFTypeInfo{ //nolint:gochecknoglobals
+	Name:           "Workspace Git Status",
+	Type:           "workspace_git_status",
+	DocsURL:        "https://learn.microsoft.com/rest/api/fabric/core/git/get-status",
+	IsPreview:      false,
+	IsSPNSupported: true,
+}
@@ -0,0 +1,199 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacegitstatus_test
+
+import (
+	"regexp"
+	"testing"
+
+	azto "github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testDataSourceItemFQN, testDataSourceItemHeader = testhelp.TFDataSource(common.ProviderTypeName, itemTypeInfo.Type, "test")
+
+func TestUnit_WorkspaceGitStatusDataSource(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	entity := NewRandomGitStatus()
+
+	fakes.FakeServer.ServerFactory.Core.GitServer.BeginGetStatus = fakeGitGetStatus(entity)
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, nil, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no attributes
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{},
+			),
+			ExpectError: regexp.MustCompile(`The argument "workspace_id" is required, but no definition was found`),
+		},
+		// error - workspace_id - invalid UUID
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"workspace_id": "invalid uuid",
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - unexpected_attr
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"workspace_id":    workspaceID,
+					"unexpected_attr": "test",
+				},
+			),
+			ExpectError: regexp.MustCompile(`An argument named "unexpected_attr" is not expected here`),
+		},
+		// read
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "id", workspaceID),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "workspace_id", workspaceID),
+				resource.TestCheckResourceAttrPtr(testDataSourceItemFQN, "remote_commit_hash", entity.RemoteCommitHash),
+				resource.TestCheckResourceAttrPtr(testDataSourceItemFQN, "workspace_head", entity.WorkspaceHead),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "is_synced", "false"),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "has_conflicts", "false"),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "changes.#", "2"),
+				resource.TestCheckResourceAttrPtr(testDataSourceItemFQN, "changes.0.display_name", entity.Changes[0].ItemMetadata.DisplayName),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "changes.0.item_type", string(fabcore.ItemTypeNotebook)),
+				resource.TestCheckResourceAttrPtr(testDataSourceItemFQN, "changes.0.logical_id", entity.Changes[0].ItemMetadata.ItemIdentifier.LogicalID),
+				resource.TestCheckResourceAttrPtr(testDataSourceItemFQN, "changes.0.object_id", entity.Changes[0].ItemMetadata.ItemIdentifier.ObjectID),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "changes.0.workspace_change", string(fabcore.ChangeTypeModified)),
+				resource.TestCheckNoResourceAttr(testDataSourceItemFQN, "changes.0.remote_change"),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "changes.1.remote_change", string(fabcore.ChangeTypeAdded)),
+				resource.TestCheckNoResourceAttr(testDataSourceItemFQN, "changes.1.object_id"),
+			),
+		},
+	}))
+}
+
+func TestUnit_WorkspaceGitStatusDataSource_Conflicts(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	entity := NewRandomGitStatus()
+	entity.Changes[0].RemoteChange = azto.Ptr(fabcore.ChangeTypeModified)
+	entity.Changes[0].ConflictType = azto.Ptr(fabcore.ConflictTypeConflict)
+
+	fakes.FakeServer.ServerFactory.Core.GitServer.BeginGetStatus = fakeGitGetStatus(entity)
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, nil, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// read
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "is_synced", "false"),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "has_conflicts", "true"),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "changes.0.conflict_type", string(fabcore.ConflictTypeConflict)),
+			),
+		},
+	}))
+}
+
+func TestUnit_WorkspaceGitStatusDataSource_Synced(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	commitHash := testhelp.RandomSHA1()
+	entity := fabcore.GitStatusResponse{
+		RemoteCommitHash: new(commitHash),
+		WorkspaceHead:    new(commitHash),
+		Changes:          []fabcore.ItemChange{},
+	}
+
+	fakes.FakeServer.ServerFactory.Core.GitServer.BeginGetStatus = fakeGitGetStatus(entity)
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, nil, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// read
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "is_synced", "true"),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "has_conflicts", "false"),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "changes.#", "0"),
+			),
+		},
+	}))
+}
+
+func TestAcc_WorkspaceGitStatusDataSource(t *testing.T) {
+	capacity := testhelp.WellKnown()["Capacity"].(map[string]any)
+	capacityID := capacity["id"].(string)
+
+	doPlatform := testhelp.WellKnown()["AzDO"].(map[string]any)
+	azdoOrganization := doPlatform["organizationName"].(string)
+	azdoProject := doPlatform["projectName"].(string)
+	azdoRepository := doPlatform["repositoryName"].(string)
+	adoConnectionID := doPlatform["connectionId"].(string)
+
+	workspaceResourceHCL, workspaceResourceFQN := testhelp.TestAccWorkspaceResource(t, capacityID)
+
+	workspaceGitResourceFQN, workspaceGitResourceHeader := testhelp.TFResource(common.ProviderTypeName, "workspace_git", "test")
+
+	workspaceGitResourceHCL := at.CompileConfig(
+		workspaceGitResourceHeader,
+		map[string]any{
+			"workspace_id":            testhelp.RefByFQN(workspaceResourceFQN, "id"),
+			"initialization_strategy": "PreferWorkspace",
+			"git_provider_details": map[string]any{
+				"git_provider_type": "AzureDevOps",
+				"organization_name": azdoOrganization,
+				"project_name":      azdoProject,
+				"repository_name":   azdoRepository,
+				"branch_name":       "main",
+				"directory_name":    "/",
+			},
+			"git_credentials": map[string]any{
+				"source":        string(fabcore.GitCredentialsSourceConfiguredConnection),
+				"connection_id": adoConnectionID,
+			},
+		},
+	)
+
+	resource.ParallelTest(t, testhelp.NewTestAccCase(t, nil, nil, []resource.TestStep{
+		// read
+		{
+			Config: at.JoinConfigs(
+				workspaceResourceHCL,
+				workspaceGitResourceHCL,
+				at.CompileConfig(
+					testDataSourceItemHeader,
+					map[string]any{
+						"workspace_id": testhelp.RefByFQN(workspaceGitResourceFQN, "workspace_id"),
+					},
+				),
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrPair(testDataSourceItemFQN, "workspace_id", workspaceResourceFQN, "id"),
+				resource.TestCheckResourceAttrSet(testDataSourceItemFQN, "remote_commit_hash"),
+				resource.TestCheckResourceAttrSet(testDataSourceItemFQN, "workspace_head"),
+				resource.TestCheckResourceAttrSet(testDataSourceItemFQN, "is_synced"),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "has_conflicts", "false"),
+			),
+		},
+	}))
+}
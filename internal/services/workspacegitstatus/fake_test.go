@@ -0,0 +1,58 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacegitstatus_test
+
+import (
+	"context"
+	"net/http"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	azto "github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+)
+
+func fakeGitGetStatus(
+	exampleResp fabcore.GitStatusResponse,
+) func(ctx context.Context, workspaceID string, options *fabcore.GitClientBeginGetStatusOptions) (resp azfake.PollerResponder[fabcore.GitClientGetStatusResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _ string, _ *fabcore.GitClientBeginGetStatusOptions) (resp azfake.PollerResponder[fabcore.GitClientGetStatusResponse], errResp azfake.ErrorResponder) {
+		resp = azfake.PollerResponder[fabcore.GitClientGetStatusResponse]{}
+		resp.SetTerminalResponse(http.StatusOK, fabcore.GitClientGetStatusResponse{GitStatusResponse: exampleResp}, nil)
+
+		return resp, errResp
+	}
+}
+
+func NewRandomGitStatus() fabcore.GitStatusResponse {
+	return fabcore.GitStatusResponse{
+		RemoteCommitHash: new(testhelp.RandomSHA1()),
+		WorkspaceHead:    new(testhelp.RandomSHA1()),
+		Changes: []fabcore.ItemChange{
+			{
+				ItemMetadata: &fabcore.ItemMetadata{
+					DisplayName: new(testhelp.RandomName()),
+					ItemType:    azto.Ptr(fabcore.ItemTypeNotebook),
+					ItemIdentifier: &fabcore.ItemIdentifier{
+						LogicalID: new(testhelp.RandomUUID()),
+						ObjectID:  new(testhelp.RandomUUID()),
+					},
+				},
+				WorkspaceChange: azto.Ptr(fabcore.ChangeTypeModified),
+				ConflictType:    azto.Ptr(fabcore.ConflictTypeNone),
+			},
+			{
+				ItemMetadata: &fabcore.ItemMetadata{
+					DisplayName: new(testhelp.RandomName()),
+					ItemType:    azto.Ptr(fabcore.ItemTypeLakehouse),
+					ItemIdentifier: &fabcore.ItemIdentifier{
+						LogicalID: new(testhelp.RandomUUID()),
+					},
+				},
+				RemoteChange: azto.Ptr(fabcore.ChangeTypeAdded),
+				ConflictType: azto.Ptr(fabcore.ConflictTypeNone),
+			},
+		},
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacegitstatus
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+)
+
+/*
+DATA-SOURCE
+*/
+
+type dataSourceWorkspaceGitStatusModel struct {
+	ID               customtypes.UUID                                    `tfsdk:"id"`
+	WorkspaceID      customtypes.UUID                                    `tfsdk:"workspace_id"`
+	RemoteCommitHash types.String                                        `tfsdk:"remote_commit_hash"`
+	WorkspaceHead    types.String                                        `tfsdk:"workspace_head"`
+	IsSynced         types.Bool                                          `tfsdk:"is_synced"`
+	HasConflicts     types.Bool                                          `tfsdk:"has_conflicts"`
+	Changes          supertypes.ListNestedObjectValueOf[itemChangeModel] `tfsdk:"changes"`
+	Timeouts         timeouts.Value                                      `tfsdk:"timeouts"`
+}
+
+func (to *dataSourceWorkspaceGitStatusModel) set(ctx context.Context, from fabcore.GitStatusResponse) diag.Diagnostics {
+	to.RemoteCommitHash = types.StringPointerValue(from.RemoteCommitHash)
+	to.WorkspaceHead = types.StringPointerValue(from.WorkspaceHead)
+
+	hasConflicts := false
+	slice := make([]*itemChangeModel, 0, len(from.Changes))
+
+	for _, entity := range from.Changes {
+		var entityModel itemChangeModel
+
+		entityModel.set(entity)
+
+		if entity.ConflictType != nil && *entity.ConflictType == fabcore.ConflictTypeConflict {
+			hasConflicts = true
+		}
+
+		slice = append(slice, &entityModel)
+	}
+
+	to.IsSynced = types.BoolValue(len(from.Changes) == 0 && to.RemoteCommitHash.Equal(to.WorkspaceHead))
+	to.HasConflicts = types.BoolValue(hasConflicts)
+
+	return to.Changes.Set(ctx, slice)
+}
+
+/*
+HELPER MODELS
+*/
+
+type itemChangeModel struct {
+	DisplayName     types.String     `tfsdk:"display_name"`
+	ItemType        types.String     `tfsdk:"item_type"`
+	LogicalID       customtypes.UUID `tfsdk:"logical_id"`
+	ObjectID        customtypes.UUID `tfsdk:"object_id"`
+	WorkspaceChange types.String     `tfsdk:"workspace_change"`
+	RemoteChange    types.String     `tfsdk:"remote_change"`
+	ConflictType    types.String     `tfsdk:"conflict_type"`
+}
+
+func (to *itemChangeModel) set(from fabcore.ItemChange) {
+	to.DisplayName = types.StringNull()
+	to.ItemType = types.StringNull()
+	to.LogicalID = customtypes.NewUUIDNull()
+	to.ObjectID = customtypes.NewUUIDNull()
+	to.WorkspaceChange = types.StringPointerValue((*string)(from.WorkspaceChange))
+	to.RemoteChange = types.StringPointerValue((*string)(from.RemoteChange))
+	to.ConflictType = types.StringPointerValue((*string)(from.ConflictType))
+
+	if from.ItemMetadata != nil {
+		to.DisplayName = types.StringPointerValue(from.ItemMetadata.DisplayName)
+		to.ItemType = types.StringPointerValue((*string)(from.ItemMetadata.ItemType))
+
+		if from.ItemMetadata.ItemIdentifier != nil {
+			to.LogicalID = customtypes.NewUUIDPointerValue(from.ItemMetadata.ItemIdentifier.LogicalID)
+			to.ObjectID = customtypes.NewUUIDPointerValue(from.ItemMetadata.ItemIdentifier.ObjectID)
+		}
+	}
+}
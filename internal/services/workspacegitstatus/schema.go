@@ -0,0 +1,118 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacegitstatus
+
+import (
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema" //revive:disable-line:import-alias-naming
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+)
+
+func itemSchema() superschema.Schema {
+	return superschema.Schema{
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: fabricitem.NewDataSourceMarkdownDescription(ItemTypeInfo, false) +
+				"\n\n-> The Workspace must be connected to Git and the connection initialized.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.SuperStringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The " + ItemTypeInfo.Name + " ID.",
+					CustomType:          customtypes.UUIDType{},
+					Computed:            true,
+				},
+			},
+			"workspace_id": superschema.SuperStringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The Workspace ID.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+				},
+			},
+			"remote_commit_hash": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The full SHA hash of the latest commit in the remote branch.",
+					Computed:            true,
+				},
+			},
+			"workspace_head": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The full SHA hash of the commit the Workspace is synced to.",
+					Computed:            true,
+				},
+			},
+			"is_synced": superschema.BoolAttribute{
+				DataSource: &schemaD.BoolAttribute{
+					MarkdownDescription: "Whether the Workspace is synced with the remote branch, with no changes on either side.",
+					Computed:            true,
+				},
+			},
+			"has_conflicts": superschema.BoolAttribute{
+				DataSource: &schemaD.BoolAttribute{
+					MarkdownDescription: "Whether any item was changed both in the Workspace and in the remote branch.",
+					Computed:            true,
+				},
+			},
+			"changes": superschema.SuperListNestedAttributeOf[itemChangeModel]{
+				DataSource: &schemaD.ListNestedAttribute{
+					MarkdownDescription: "The list of items changed in the Workspace or in the remote branch.",
+					Computed:            true,
+				},
+				Attributes: superschema.Attributes{
+					"display_name": superschema.StringAttribute{
+						DataSource: &schemaD.StringAttribute{
+							MarkdownDescription: "The display name of the item.",
+							Computed:            true,
+						},
+					},
+					"item_type": superschema.StringAttribute{
+						DataSource: &schemaD.StringAttribute{
+							MarkdownDescription: "The type of the item.",
+							Computed:            true,
+						},
+					},
+					"logical_id": superschema.SuperStringAttribute{
+						DataSource: &schemaD.StringAttribute{
+							MarkdownDescription: "The logical ID of the item.",
+							CustomType:          customtypes.UUIDType{},
+							Computed:            true,
+						},
+					},
+					"object_id": superschema.SuperStringAttribute{
+						DataSource: &schemaD.StringAttribute{
+							MarkdownDescription: "The object ID of the item in the Workspace.",
+							CustomType:          customtypes.UUIDType{},
+							Computed:            true,
+						},
+					},
+					"workspace_change": superschema.StringAttribute{
+						DataSource: &schemaD.StringAttribute{
+							MarkdownDescription: "The change on the Workspace side.",
+							Computed:            true,
+						},
+					},
+					"remote_change": superschema.StringAttribute{
+						DataSource: &schemaD.StringAttribute{
+							MarkdownDescription: "The change on the remote Git side.",
+							Computed:            true,
+						},
+					},
+					"conflict_type": superschema.StringAttribute{
+						DataSource: &schemaD.StringAttribute{
+							MarkdownDescription: "The conflict type, when there are changes on both the Workspace and the remote Git side.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"timeouts": superschema.TimeoutAttribute{
+				DataSource: &superschema.DatasourceTimeoutAttribute{
+					Read: true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacegitupdate
+
+import (
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+)
+
+var ItemTypeInfo = tftypeinfo.TFTypeInfo{ //nolint:gochecknoglobals
+	Name:           "Workspace Git Update",
+	Type:           "workspace_git_update",
+	DocsURL:        "https://learn.microsoft.com/fabric/cicd/git-integration/git-get-started#update-workspace-from-git",
+	IsPreview:      false,
+	IsSPNSupported: true,
+}
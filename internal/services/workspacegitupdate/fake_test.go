@@ -0,0 +1,76 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacegitupdate_test
+
+import (
+	"context"
+	"net/http"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	azto "github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+)
+
+// Returns a fake function that reports the provided status until an update is made, and a synced status afterwards.
+func fakeGitGetStatus(
+	exampleResp fabcore.GitStatusResponse,
+	updated *bool,
+) func(ctx context.Context, workspaceID string, options *fabcore.GitClientBeginGetStatusOptions) (resp azfake.PollerResponder[fabcore.GitClientGetStatusResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _ string, _ *fabcore.GitClientBeginGetStatusOptions) (resp azfake.PollerResponder[fabcore.GitClientGetStatusResponse], errResp azfake.ErrorResponder) {
+		status := exampleResp
+
+		if *updated {
+			status = fabcore.GitStatusResponse{
+				RemoteCommitHash: exampleResp.RemoteCommitHash,
+				WorkspaceHead:    exampleResp.RemoteCommitHash,
+				Changes:          []fabcore.ItemChange{},
+			}
+		}
+
+		resp = azfake.PollerResponder[fabcore.GitClientGetStatusResponse]{}
+		resp.SetTerminalResponse(http.StatusOK, fabcore.GitClientGetStatusResponse{GitStatusResponse: status}, nil)
+
+		return resp, errResp
+	}
+}
+
+// Returns a fake function that records the received update request.
+func fakeGitUpdateFromGit(
+	updated *bool,
+	received *fabcore.UpdateFromGitRequest,
+) func(ctx context.Context, workspaceID string, updateFromGitRequest fabcore.UpdateFromGitRequest, options *fabcore.GitClientBeginUpdateFromGitOptions) (resp azfake.PollerResponder[fabcore.GitClientUpdateFromGitResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _ string, updateFromGitRequest fabcore.UpdateFromGitRequest, _ *fabcore.GitClientBeginUpdateFromGitOptions) (resp azfake.PollerResponder[fabcore.GitClientUpdateFromGitResponse], errResp azfake.ErrorResponder) {
+		*updated = true
+		*received = updateFromGitRequest
+
+		resp = azfake.PollerResponder[fabcore.GitClientUpdateFromGitResponse]{}
+		resp.SetTerminalResponse(http.StatusOK, fabcore.GitClientUpdateFromGitResponse{}, nil)
+
+		return resp, errResp
+	}
+}
+
+func NewRandomGitStatus(conflictType fabcore.ConflictType) fabcore.GitStatusResponse {
+	return fabcore.GitStatusResponse{
+		RemoteCommitHash: new(testhelp.RandomSHA1()),
+		WorkspaceHead:    new(testhelp.RandomSHA1()),
+		Changes: []fabcore.ItemChange{
+			{
+				ItemMetadata: &fabcore.ItemMetadata{
+					DisplayName: new(testhelp.RandomName()),
+					ItemType:    azto.Ptr(fabcore.ItemTypeNotebook),
+					ItemIdentifier: &fabcore.ItemIdentifier{
+						LogicalID: new(testhelp.RandomUUID()),
+						ObjectID:  new(testhelp.RandomUUID()),
+					},
+				},
+				RemoteChange:    azto.Ptr(fabcore.ChangeTypeModified),
+				WorkspaceChange: azto.Ptr(fabcore.ChangeTypeModified),
+				ConflictType:    azto.Ptr(conflictType),
+			},
+		},
+	}
+}
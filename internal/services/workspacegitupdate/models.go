@@ -0,0 +1,75 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacegitupdate
+
+import (
+	"context"
+
+	azto "github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+)
+
+/*
+RESOURCE
+*/
+
+type resourceWorkspaceGitUpdateModel struct {
+	ID                       customtypes.UUID                                   `tfsdk:"id"`
+	WorkspaceID              customtypes.UUID                                   `tfsdk:"workspace_id"`
+	ConflictResolutionPolicy types.String                                       `tfsdk:"conflict_resolution_policy"`
+	Options                  supertypes.SingleNestedObjectValueOf[optionsModel] `tfsdk:"options"`
+	Triggers                 supertypes.MapValueOf[types.String]                `tfsdk:"triggers"`
+	RemoteCommitHash         types.String                                       `tfsdk:"remote_commit_hash"`
+	WorkspaceHead            types.String                                       `tfsdk:"workspace_head"`
+	Timeouts                 timeouts.Value                                     `tfsdk:"timeouts"`
+}
+
+func (to *resourceWorkspaceGitUpdateModel) set(from fabcore.GitStatusResponse) {
+	to.ID = to.WorkspaceID
+	to.RemoteCommitHash = types.StringPointerValue(from.RemoteCommitHash)
+	to.WorkspaceHead = types.StringPointerValue(from.WorkspaceHead)
+}
+
+type requestUpdateFromGit struct {
+	fabcore.UpdateFromGitRequest
+}
+
+func (to *requestUpdateFromGit) set(ctx context.Context, from resourceWorkspaceGitUpdateModel, status fabcore.GitStatusResponse) diag.Diagnostics {
+	to.RemoteCommitHash = status.RemoteCommitHash
+	to.WorkspaceHead = status.WorkspaceHead
+
+	if !from.ConflictResolutionPolicy.IsNull() && !from.ConflictResolutionPolicy.IsUnknown() {
+		to.ConflictResolution = &fabcore.WorkspaceConflictResolution{
+			ConflictResolutionType:   azto.Ptr(fabcore.ConflictResolutionTypeWorkspace),
+			ConflictResolutionPolicy: (*fabcore.ConflictResolutionPolicy)(from.ConflictResolutionPolicy.ValueStringPointer()),
+		}
+	}
+
+	if !from.Options.IsNull() && !from.Options.IsUnknown() {
+		options, diags := from.Options.Get(ctx)
+		if diags.HasError() {
+			return diags
+		}
+
+		to.Options = &fabcore.UpdateOptions{
+			AllowOverrideItems: options.AllowOverrideItems.ValueBoolPointer(),
+		}
+	}
+
+	return nil
+}
+
+/*
+HELPER MODELS
+*/
+
+type optionsModel struct {
+	AllowOverrideItems types.Bool `tfsdk:"allow_override_items"`
+}
@@ -0,0 +1,237 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacegitupdate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+	pconfig "github.com/microsoft/terraform-provider-fabric/internal/provider/config"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.ResourceWithConfigure = (*resourceWorkspaceGitUpdate)(nil)
+)
+
+type resourceWorkspaceGitUpdate struct {
+	pConfigData *pconfig.ProviderData
+	client      *fabcore.GitClient
+	TypeInfo    tftypeinfo.TFTypeInfo
+}
+
+func NewResourceWorkspaceGitUpdate() resource.Resource {
+	return &resourceWorkspaceGitUpdate{
+		TypeInfo: ItemTypeInfo,
+	}
+}
+
+func (r *resourceWorkspaceGitUpdate) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeInfo.FullTypeName(false)
+}
+
+func (r *resourceWorkspaceGitUpdate) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = itemSchema().GetResource(ctx)
+}
+
+func (r *resourceWorkspaceGitUpdate) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pConfigData, ok := req.ProviderData.(*pconfig.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			common.ErrorResourceConfigType,
+			fmt.Sprintf(common.ErrorFabricClientType, req.ProviderData),
+		)
+
+		return
+	}
+
+	r.pConfigData = pConfigData
+
+	if resp.Diagnostics.Append(fabricitem.IsPreviewMode(r.TypeInfo.Name, r.TypeInfo.IsPreview, r.pConfigData.Preview)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client = fabcore.NewClientFactoryWithClient(*pConfigData.FabricClient).NewGitClient()
+}
+
+func (r *resourceWorkspaceGitUpdate) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan resourceWorkspaceGitUpdateModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, r.pConfigData.Timeout)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	respStatus, err := r.client.GetStatus(ctx, plan.WorkspaceID.ValueString(), nil)
+	if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationCreate, nil)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isSynced(respStatus.GitStatusResponse) {
+		resp.Diagnostics.AddWarning(
+			common.WarningGitNothingToUpdateHeader,
+			fmt.Sprintf(common.WarningGitNothingToUpdateDetails, plan.WorkspaceID.ValueString()),
+		)
+	} else {
+		if conflicts := conflictingItems(respStatus.Changes); len(conflicts) > 0 && plan.ConflictResolutionPolicy.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("conflict_resolution_policy"),
+				common.ErrorGitConflictsHeader,
+				fmt.Sprintf(common.ErrorGitConflictsDetails, strings.Join(conflicts, ", ")),
+			)
+
+			return
+		}
+
+		var reqUpdate requestUpdateFromGit
+
+		if resp.Diagnostics.Append(reqUpdate.set(ctx, plan, respStatus.GitStatusResponse)...); resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err = r.client.UpdateFromGit(ctx, plan.WorkspaceID.ValueString(), reqUpdate.UpdateFromGitRequest, nil)
+		if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationCreate, nil)...); resp.Diagnostics.HasError() {
+			return
+		}
+
+		respStatus, err = r.client.GetStatus(ctx, plan.WorkspaceID.ValueString(), nil)
+		if resp.Diagnostics.Append(utils.GetDiagsFromError(ctx, err, utils.OperationCreate, nil)...); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.set(respStatus.GitStatusResponse)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	tflog.Debug(ctx, "CREATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceWorkspaceGitUpdate) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "start",
+	})
+
+	// the update is a completed operation, the state is kept as is
+
+	tflog.Debug(ctx, "READ", map[string]any{
+		"action": "end",
+	})
+}
+
+func (r *resourceWorkspaceGitUpdate) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "start",
+	})
+
+	var plan, state resourceWorkspaceGitUpdateModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only the timeouts can be updated in place, every other argument requires a new update
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+
+	tflog.Debug(ctx, "UPDATE", map[string]any{
+		"action": "end",
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceWorkspaceGitUpdate) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "start",
+	})
+
+	// updated content cannot be reverted, the resource is only removed from the state
+
+	tflog.Debug(ctx, "DELETE", map[string]any{
+		"action": "end",
+	})
+}
+
+// isSynced returns true when the Workspace is synced to the latest remote commit and has no incoming changes.
+func isSynced(status fabcore.GitStatusResponse) bool {
+	if status.RemoteCommitHash == nil || status.WorkspaceHead == nil || *status.RemoteCommitHash != *status.WorkspaceHead {
+		return false
+	}
+
+	for _, change := range status.Changes {
+		if change.RemoteChange != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// conflictingItems returns a description of each item in conflict between the Workspace and the remote branch.
+func conflictingItems(changes []fabcore.ItemChange) []string {
+	conflicts := make([]string, 0)
+
+	for _, change := range changes {
+		if change.ConflictType == nil || *change.ConflictType != fabcore.ConflictTypeConflict || change.ItemMetadata == nil {
+			continue
+		}
+
+		var displayName, itemType, logicalID string
+
+		if change.ItemMetadata.DisplayName != nil {
+			displayName = *change.ItemMetadata.DisplayName
+		}
+
+		if change.ItemMetadata.ItemType != nil {
+			itemType = string(*change.ItemMetadata.ItemType)
+		}
+
+		if change.ItemMetadata.ItemIdentifier != nil && change.ItemMetadata.ItemIdentifier.LogicalID != nil {
+			logicalID = *change.ItemMetadata.ItemIdentifier.LogicalID
+		}
+
+		conflicts = append(conflicts, fmt.Sprintf("'%s' (%s %s)", displayName, itemType, logicalID))
+	}
+
+	return conflicts
+}
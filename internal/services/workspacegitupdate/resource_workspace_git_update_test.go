@@ -0,0 +1,220 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacegitupdate_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testResourceItemFQN, testResourceItemHeader = testhelp.TFResource(common.ProviderTypeName, itemTypeInfo.Type, "test")
+
+func TestUnit_WorkspaceGitUpdateResource_Attributes(t *testing.T) {
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no required attributes - workspace_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{},
+			),
+			ExpectError: regexp.MustCompile(`The argument "workspace_id" is required, but no definition was found.`),
+		},
+		// error - invalid UUID - workspace_id
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": "invalid uuid",
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - invalid conflict_resolution_policy
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":               "00000000-0000-0000-0000-000000000000",
+					"conflict_resolution_policy": "Invalid",
+				},
+			),
+			ExpectError: regexp.MustCompile(`Attribute conflict_resolution_policy value must be one of`),
+		},
+	}))
+}
+
+func TestUnit_WorkspaceGitUpdateResource_CRUD(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	entity := NewRandomGitStatus(fabcore.ConflictTypeConflict)
+	updated := false
+
+	var received fabcore.UpdateFromGitRequest
+
+	fakes.FakeServer.ServerFactory.Core.GitServer.BeginGetStatus = fakeGitGetStatus(entity, &updated)
+	fakes.FakeServer.ServerFactory.Core.GitServer.BeginUpdateFromGit = fakeGitUpdateFromGit(&updated, &received)
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - conflicts without a resolution policy
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+				},
+			),
+			ExpectError: regexp.MustCompile(common.ErrorGitConflictsHeader),
+		},
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id":               workspaceID,
+					"conflict_resolution_policy": string(fabcore.ConflictResolutionPolicyPreferRemote),
+					"options": map[string]any{
+						"allow_override_items": true,
+					},
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "id", workspaceID),
+				resource.TestCheckResourceAttrPtr(testResourceItemFQN, "remote_commit_hash", entity.RemoteCommitHash),
+				resource.TestCheckResourceAttrPtr(testResourceItemFQN, "workspace_head", entity.RemoteCommitHash),
+				func(_ *terraform.State) error {
+					if !updated {
+						return errors.New("expected an update to be made")
+					}
+
+					if *received.RemoteCommitHash != *entity.RemoteCommitHash || *received.WorkspaceHead != *entity.WorkspaceHead {
+						return errors.New("expected the update to use the commit hashes from the status")
+					}
+
+					if received.ConflictResolution == nil || *received.ConflictResolution.ConflictResolutionPolicy != fabcore.ConflictResolutionPolicyPreferRemote {
+						return errors.New("expected the update to use the conflict resolution policy")
+					}
+
+					if received.Options == nil || !*received.Options.AllowOverrideItems {
+						return errors.New("expected the update to allow overriding items")
+					}
+
+					return nil
+				},
+			),
+		},
+	}))
+}
+
+func TestUnit_WorkspaceGitUpdateResource_Synced(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	commitHash := testhelp.RandomSHA1()
+	entity := fabcore.GitStatusResponse{
+		RemoteCommitHash: new(commitHash),
+		WorkspaceHead:    new(commitHash),
+		Changes:          []fabcore.ItemChange{},
+	}
+	updated := false
+
+	var received fabcore.UpdateFromGitRequest
+
+	fakes.FakeServer.ServerFactory.Core.GitServer.BeginGetStatus = fakeGitGetStatus(entity, &updated)
+	fakes.FakeServer.ServerFactory.Core.GitServer.BeginUpdateFromGit = fakeGitUpdateFromGit(&updated, &received)
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.CompileConfig(
+				testResourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemFQN, "remote_commit_hash", commitHash),
+				resource.TestCheckResourceAttr(testResourceItemFQN, "workspace_head", commitHash),
+				func(_ *terraform.State) error {
+					if updated {
+						return errors.New("expected no update to be made")
+					}
+
+					return nil
+				},
+			),
+		},
+	}))
+}
+
+func TestAcc_WorkspaceGitUpdateResource_CRUD(t *testing.T) {
+	capacity := testhelp.WellKnown()["Capacity"].(map[string]any)
+	capacityID := capacity["id"].(string)
+
+	doPlatform := testhelp.WellKnown()["AzDO"].(map[string]any)
+	azdoOrganization := doPlatform["organizationName"].(string)
+	azdoProject := doPlatform["projectName"].(string)
+	azdoRepository := doPlatform["repositoryName"].(string)
+	adoConnectionID := doPlatform["connectionId"].(string)
+
+	workspaceResourceHCL, workspaceResourceFQN := testhelp.TestAccWorkspaceResource(t, capacityID)
+
+	workspaceGitResourceFQN, workspaceGitResourceHeader := testhelp.TFResource(common.ProviderTypeName, "workspace_git", "test")
+
+	workspaceGitResourceHCL := at.CompileConfig(
+		workspaceGitResourceHeader,
+		map[string]any{
+			"workspace_id":            testhelp.RefByFQN(workspaceResourceFQN, "id"),
+			"initialization_strategy": "PreferWorkspace",
+			"git_provider_details": map[string]any{
+				"git_provider_type": "AzureDevOps",
+				"organization_name": azdoOrganization,
+				"project_name":      azdoProject,
+				"repository_name":   azdoRepository,
+				"branch_name":       "main",
+				"directory_name":    "/",
+			},
+			"git_credentials": map[string]any{
+				"source":        string(fabcore.GitCredentialsSourceConfiguredConnection),
+				"connection_id": adoConnectionID,
+			},
+		},
+	)
+
+	resource.Test(t, testhelp.NewTestAccCase(t, &testResourceItemFQN, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemFQN,
+			Config: at.JoinConfigs(
+				workspaceResourceHCL,
+				workspaceGitResourceHCL,
+				at.CompileConfig(
+					testResourceItemHeader,
+					map[string]any{
+						"workspace_id":               testhelp.RefByFQN(workspaceGitResourceFQN, "workspace_id"),
+						"conflict_resolution_policy": string(fabcore.ConflictResolutionPolicyPreferRemote),
+					},
+				),
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrPair(testResourceItemFQN, "id", workspaceResourceFQN, "id"),
+				resource.TestCheckResourceAttrSet(testResourceItemFQN, "remote_commit_hash"),
+				resource.TestCheckResourceAttrPair(testResourceItemFQN, "remote_commit_hash", testResourceItemFQN, "workspace_head"),
+			),
+		},
+	}))
+}
@@ -0,0 +1,34 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspaceitemimports
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+)
+
+var ItemTypeInfo = tftypeinfo.TFTypeInfo{ //nolint:gochecknoglobals
+	Name:           "Workspace Item Imports",
+	Type:           "workspace_item_imports",
+	DocsURL:        "https://developer.hashicorp.com/terraform/language/import",
+	IsPreview:      false,
+	IsSPNSupported: true,
+}
+
+// getImportableItemTypes maps the Fabric item types to the provider resource managing them, from the registered Fabric item list resources.
+// Item types without a resource (e.g. Dashboard, Datamart, Paginated Report, SQL Endpoint) are flagged as not supported.
+func getImportableItemTypes(listResources []func() list.ListResource) map[fabcore.ItemType]tftypeinfo.TFTypeInfo {
+	itemTypes := make(map[fabcore.ItemType]tftypeinfo.TFTypeInfo, len(listResources))
+
+	for _, newListResource := range listResources {
+		if listResource, ok := newListResource().(*fabricitem.ListResourceFabricItems); ok {
+			itemTypes[listResource.FabricItemType] = listResource.TypeInfo
+		}
+	}
+
+	return itemTypes
+}
@@ -0,0 +1,125 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspaceitemimports_test
+
+import (
+	"regexp"
+	"testing"
+
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testDataSourceItemFQN, testDataSourceItemHeader = testhelp.TFDataSource(common.ProviderTypeName, itemTypeInfo.Type, "test")
+
+func TestUnit_WorkspaceItemImportsDataSource(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+
+	lakehouse := fakes.NewRandomItemWithWorkspace(fabcore.ItemTypeLakehouse, workspaceID)
+	lakehouse.DisplayName = new("Sales Lakehouse (EU)")
+
+	lakehouseDuplicate := fakes.NewRandomItemWithWorkspace(fabcore.ItemTypeLakehouse, workspaceID)
+	lakehouseDuplicate.DisplayName = new("sales-lakehouse-eu")
+
+	dashboard := fakes.NewRandomItemWithWorkspace(fabcore.ItemTypeDashboard, workspaceID)
+
+	fakes.FakeServer.Upsert(fakes.NewRandomItemWithWorkspace(fabcore.ItemTypeNotebook, testhelp.RandomUUID()))
+	fakes.FakeServer.Upsert(lakehouse)
+	fakes.FakeServer.Upsert(lakehouseDuplicate)
+	fakes.FakeServer.Upsert(dashboard)
+
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, nil, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no attributes
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{},
+			),
+			ExpectError: regexp.MustCompile(`The argument "workspace_id" is required, but no definition was found`),
+		},
+		// error - workspace_id - invalid UUID
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"workspace_id": "invalid uuid",
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - unexpected_attr
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"workspace_id":    workspaceID,
+					"unexpected_attr": "test",
+				},
+			),
+			ExpectError: regexp.MustCompile(`An argument named "unexpected_attr" is not expected here`),
+		},
+		// read
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "id", workspaceID),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "workspace_id", workspaceID),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "values.#", "3"),
+				// Dashboard sorts first and has no resource
+				resource.TestCheckResourceAttrPtr(testDataSourceItemFQN, "values.0.id", dashboard.ID),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "values.0.type", string(fabcore.ItemTypeDashboard)),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "values.0.supported", "false"),
+				resource.TestCheckNoResourceAttr(testDataSourceItemFQN, "values.0.resource_type"),
+				resource.TestCheckNoResourceAttr(testDataSourceItemFQN, "values.0.resource_address"),
+				resource.TestCheckNoResourceAttr(testDataSourceItemFQN, "values.0.import_id"),
+				// Lakehouses get unique addresses
+				resource.TestCheckResourceAttrPtr(testDataSourceItemFQN, "values.1.id", lakehouse.ID),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "values.1.supported", "true"),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "values.1.preview", "false"),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "values.1.resource_type", "fabric_lakehouse"),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "values.1.resource_name", "sales_lakehouse_eu"),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "values.1.resource_address", "fabric_lakehouse.sales_lakehouse_eu"),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "values.1.import_id", workspaceID+"/"+*lakehouse.ID),
+				resource.TestCheckResourceAttrPtr(testDataSourceItemFQN, "values.2.id", lakehouseDuplicate.ID),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "values.2.resource_name", "sales_lakehouse_eu_2"),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "values.2.resource_address", "fabric_lakehouse.sales_lakehouse_eu_2"),
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "values.2.import_id", workspaceID+"/"+*lakehouseDuplicate.ID),
+			),
+		},
+	}))
+}
+
+func TestAcc_WorkspaceItemImportsDataSource(t *testing.T) {
+	workspace := testhelp.WellKnown()["WorkspaceDS"].(map[string]any)
+	workspaceID := workspace["id"].(string)
+
+	resource.ParallelTest(t, testhelp.NewTestAccCase(t, nil, nil, []resource.TestStep{
+		// read
+		{
+			Config: at.CompileConfig(
+				testDataSourceItemHeader,
+				map[string]any{
+					"workspace_id": workspaceID,
+				},
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testDataSourceItemFQN, "workspace_id", workspaceID),
+				resource.TestCheckResourceAttrSet(testDataSourceItemFQN, "values.0.id"),
+				resource.TestCheckResourceAttrSet(testDataSourceItemFQN, "values.0.type"),
+				resource.TestCheckResourceAttrSet(testDataSourceItemFQN, "values.0.supported"),
+			),
+		},
+	}))
+}
@@ -0,0 +1,128 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspaceitemimports
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/tftypeinfo"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+var invalidResourceNameChars = regexp.MustCompile(`[^a-z0-9]+`) //nolint:gochecknoglobals
+
+/*
+DATA-SOURCE
+*/
+
+type dataSourceWorkspaceItemImportsModel struct {
+	ID          customtypes.UUID                                    `tfsdk:"id"`
+	WorkspaceID customtypes.UUID                                    `tfsdk:"workspace_id"`
+	Values      supertypes.ListNestedObjectValueOf[itemImportModel] `tfsdk:"values"`
+	Timeouts    timeouts.Value                                      `tfsdk:"timeouts"`
+}
+
+func (to *dataSourceWorkspaceItemImportsModel) setValues(ctx context.Context, from []fabcore.Item, importableItemTypes map[fabcore.ItemType]tftypeinfo.TFTypeInfo) diag.Diagnostics {
+	items := slices.Clone(from)
+
+	slices.SortFunc(items, func(a, b fabcore.Item) int {
+		return cmp.Or(
+			cmp.Compare(utils.ValueOrZero(a.Type), utils.ValueOrZero(b.Type)),
+			cmp.Compare(strings.ToLower(utils.ValueOrZero(a.DisplayName)), strings.ToLower(utils.ValueOrZero(b.DisplayName))),
+			cmp.Compare(utils.ValueOrZero(a.ID), utils.ValueOrZero(b.ID)),
+		)
+	})
+
+	usedAddresses := make(map[string]bool, len(items))
+	slice := make([]*itemImportModel, 0, len(items))
+
+	for _, entity := range items {
+		var entityModel itemImportModel
+
+		entityModel.set(entity, importableItemTypes, usedAddresses)
+
+		slice = append(slice, &entityModel)
+	}
+
+	return to.Values.Set(ctx, slice)
+}
+
+/*
+HELPER MODELS
+*/
+
+type itemImportModel struct {
+	ID              customtypes.UUID `tfsdk:"id"`
+	DisplayName     types.String     `tfsdk:"display_name"`
+	Type            types.String     `tfsdk:"type"`
+	Supported       types.Bool       `tfsdk:"supported"`
+	Preview         types.Bool       `tfsdk:"preview"`
+	ResourceType    types.String     `tfsdk:"resource_type"`
+	ResourceName    types.String     `tfsdk:"resource_name"`
+	ResourceAddress types.String     `tfsdk:"resource_address"`
+	ImportID        types.String     `tfsdk:"import_id"`
+}
+
+func (to *itemImportModel) set(from fabcore.Item, importableItemTypes map[fabcore.ItemType]tftypeinfo.TFTypeInfo, usedAddresses map[string]bool) {
+	to.ID = customtypes.NewUUIDPointerValue(from.ID)
+	to.DisplayName = types.StringPointerValue(from.DisplayName)
+	to.Type = types.StringPointerValue((*string)(from.Type))
+	to.Supported = types.BoolValue(false)
+	to.Preview = types.BoolNull()
+	to.ResourceType = types.StringNull()
+	to.ResourceName = types.StringNull()
+	to.ResourceAddress = types.StringNull()
+	to.ImportID = types.StringNull()
+
+	typeInfo, ok := importableItemTypes[utils.ValueOrZero(from.Type)]
+	if !ok || from.ID == nil || from.WorkspaceID == nil {
+		return
+	}
+
+	resourceType := typeInfo.FullTypeName(false)
+	resourceName := newResourceName(utils.ValueOrZero(from.DisplayName))
+
+	// keep the address unique when several items of the same type have similar display names
+	address := resourceType + "." + resourceName
+
+	for i := 2; usedAddresses[address]; i++ {
+		address = fmt.Sprintf("%s.%s_%d", resourceType, resourceName, i)
+	}
+
+	usedAddresses[address] = true
+
+	to.Supported = types.BoolValue(true)
+	to.Preview = types.BoolValue(typeInfo.IsPreview)
+	to.ResourceType = types.StringValue(resourceType)
+	to.ResourceName = types.StringValue(strings.TrimPrefix(address, resourceType+"."))
+	to.ResourceAddress = types.StringValue(address)
+	to.ImportID = types.StringValue(*from.WorkspaceID + "/" + *from.ID)
+}
+
+// newResourceName converts a display name into a valid Terraform resource name, e.g. "Sales Lakehouse (EU)" becomes "sales_lakehouse_eu".
+func newResourceName(displayName string) string {
+	name := strings.Trim(invalidResourceNameChars.ReplaceAllString(strings.ToLower(displayName), "_"), "_")
+
+	if name == "" {
+		return "item"
+	}
+
+	if name[0] >= '0' && name[0] <= '9' {
+		return "item_" + name
+	}
+
+	return name
+}
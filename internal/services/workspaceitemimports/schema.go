@@ -0,0 +1,106 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspaceitemimports
+
+import (
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema" //revive:disable-line:import-alias-naming
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+)
+
+func itemSchema() superschema.Schema {
+	return superschema.Schema{
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: fabricitem.NewDataSourceMarkdownDescription(ItemTypeInfo, false) +
+				"\n\nLists every item of a Workspace with the provider resource type, a suggested resource address and the import ID, to generate `import` blocks when bringing an existing Workspace under Terraform management." +
+				"\n\n-> Items whose type has no provider resource (e.g. Dashboard, Datamart, Paginated Report, SQL Endpoint) are listed with `supported` set to `false` and no import details.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.SuperStringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The " + ItemTypeInfo.Name + " ID.",
+					CustomType:          customtypes.UUIDType{},
+					Computed:            true,
+				},
+			},
+			"workspace_id": superschema.SuperStringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The Workspace ID.",
+					CustomType:          customtypes.UUIDType{},
+					Required:            true,
+				},
+			},
+			"values": superschema.SuperListNestedAttributeOf[itemImportModel]{
+				DataSource: &schemaD.ListNestedAttribute{
+					MarkdownDescription: "The list of items in the Workspace, sorted by item type and display name.",
+					Computed:            true,
+				},
+				Attributes: superschema.Attributes{
+					"id": superschema.SuperStringAttribute{
+						DataSource: &schemaD.StringAttribute{
+							MarkdownDescription: "The item ID.",
+							CustomType:          customtypes.UUIDType{},
+							Computed:            true,
+						},
+					},
+					"display_name": superschema.StringAttribute{
+						DataSource: &schemaD.StringAttribute{
+							MarkdownDescription: "The item display name.",
+							Computed:            true,
+						},
+					},
+					"type": superschema.StringAttribute{
+						DataSource: &schemaD.StringAttribute{
+							MarkdownDescription: "The Fabric item type.",
+							Computed:            true,
+						},
+					},
+					"supported": superschema.BoolAttribute{
+						DataSource: &schemaD.BoolAttribute{
+							MarkdownDescription: "Whether the provider has a resource for the item type.",
+							Computed:            true,
+						},
+					},
+					"preview": superschema.BoolAttribute{
+						DataSource: &schemaD.BoolAttribute{
+							MarkdownDescription: "Whether the resource is only available with the provider preview mode enabled.",
+							Computed:            true,
+						},
+					},
+					"resource_type": superschema.StringAttribute{
+						DataSource: &schemaD.StringAttribute{
+							MarkdownDescription: "The provider resource type managing the item, e.g. `fabric_lakehouse`. Null when not supported.",
+							Computed:            true,
+						},
+					},
+					"resource_name": superschema.StringAttribute{
+						DataSource: &schemaD.StringAttribute{
+							MarkdownDescription: "A suggested resource name derived from the item display name, unique per resource type. Null when not supported.",
+							Computed:            true,
+						},
+					},
+					"resource_address": superschema.StringAttribute{
+						DataSource: &schemaD.StringAttribute{
+							MarkdownDescription: "The suggested resource address, e.g. `fabric_lakehouse.sales`. Null when not supported.",
+							Computed:            true,
+						},
+					},
+					"import_id": superschema.StringAttribute{
+						DataSource: &schemaD.StringAttribute{
+							MarkdownDescription: "The import ID expected by the resource, in the `WorkspaceID/ItemID` format. Null when not supported.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"timeouts": superschema.TimeoutAttribute{
+				DataSource: &superschema.DatasourceTimeoutAttribute{
+					Read: true,
+				},
+			},
+		},
+	}
+}
@@ -6,10 +6,13 @@ package workspacera_test
 import (
 	"context"
 	"net/http"
+	"slices"
+	"sync"
 
 	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	azto "github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+	fabfake "github.com/microsoft/fabric-sdk-go/fabric/fake"
 
 	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
 )
@@ -117,3 +120,92 @@ func NewRandomWorkspaceRoleAssignments() fabcore.WorkspaceRoleAssignments {
 		},
 	}
 }
+
+// fakeWorkspaceRoleAssignmentsStore keeps the Workspace Role Assignments in memory so that the authoritative resource
+// can list, add, update and delete them across test steps.
+type fakeWorkspaceRoleAssignmentsStore struct {
+	mu     sync.Mutex
+	values []fabcore.WorkspaceRoleAssignment
+}
+
+func (s *fakeWorkspaceRoleAssignmentsStore) list() func(workspaceID string, options *fabcore.WorkspacesClientListWorkspaceRoleAssignmentsOptions) (resp azfake.PagerResponder[fabcore.WorkspacesClientListWorkspaceRoleAssignmentsResponse]) {
+	return func(_ string, _ *fabcore.WorkspacesClientListWorkspaceRoleAssignmentsOptions) (resp azfake.PagerResponder[fabcore.WorkspacesClientListWorkspaceRoleAssignmentsResponse]) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		resp = azfake.PagerResponder[fabcore.WorkspacesClientListWorkspaceRoleAssignmentsResponse]{}
+		resp.AddPage(http.StatusOK, fabcore.WorkspacesClientListWorkspaceRoleAssignmentsResponse{
+			WorkspaceRoleAssignments: fabcore.WorkspaceRoleAssignments{Value: slices.Clone(s.values)},
+		}, nil)
+
+		return resp
+	}
+}
+
+func (s *fakeWorkspaceRoleAssignmentsStore) add() func(ctx context.Context, workspaceID string, workspaceRoleAssignmentRequest fabcore.AddWorkspaceRoleAssignmentRequest, options *fabcore.WorkspacesClientAddWorkspaceRoleAssignmentOptions) (resp azfake.Responder[fabcore.WorkspacesClientAddWorkspaceRoleAssignmentResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _ string, workspaceRoleAssignmentRequest fabcore.AddWorkspaceRoleAssignmentRequest, _ *fabcore.WorkspacesClientAddWorkspaceRoleAssignmentOptions) (resp azfake.Responder[fabcore.WorkspacesClientAddWorkspaceRoleAssignmentResponse], errResp azfake.ErrorResponder) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		principal := workspaceRoleAssignmentRequest.Principal.GetPrincipal()
+		entity := fabcore.WorkspaceRoleAssignment{
+			ID:        principal.ID,
+			Principal: principal,
+			Role:      workspaceRoleAssignmentRequest.Role,
+		}
+
+		s.values = append(s.values, entity)
+
+		resp = azfake.Responder[fabcore.WorkspacesClientAddWorkspaceRoleAssignmentResponse]{}
+		resp.SetResponse(http.StatusCreated, fabcore.WorkspacesClientAddWorkspaceRoleAssignmentResponse{WorkspaceRoleAssignment: entity}, nil)
+
+		return resp, errResp
+	}
+}
+
+func (s *fakeWorkspaceRoleAssignmentsStore) update() func(ctx context.Context, workspaceID, workspaceRoleAssignmentID string, updateWorkspaceRoleAssignmentRequest fabcore.UpdateWorkspaceRoleAssignmentRequest, options *fabcore.WorkspacesClientUpdateWorkspaceRoleAssignmentOptions) (resp azfake.Responder[fabcore.WorkspacesClientUpdateWorkspaceRoleAssignmentResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _, workspaceRoleAssignmentID string, updateWorkspaceRoleAssignmentRequest fabcore.UpdateWorkspaceRoleAssignmentRequest, _ *fabcore.WorkspacesClientUpdateWorkspaceRoleAssignmentOptions) (resp azfake.Responder[fabcore.WorkspacesClientUpdateWorkspaceRoleAssignmentResponse], errResp azfake.ErrorResponder) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		resp = azfake.Responder[fabcore.WorkspacesClientUpdateWorkspaceRoleAssignmentResponse]{}
+
+		for i, entity := range s.values {
+			if *entity.ID == workspaceRoleAssignmentID {
+				s.values[i].Role = updateWorkspaceRoleAssignmentRequest.Role
+
+				resp.SetResponse(http.StatusOK, fabcore.WorkspacesClientUpdateWorkspaceRoleAssignmentResponse{WorkspaceRoleAssignment: s.values[i]}, nil)
+
+				return resp, errResp
+			}
+		}
+
+		errResp.SetError(fabfake.SetResponseError(http.StatusNotFound, fabcore.ErrCommon.EntityNotFound.Error(), "Entity not found"))
+
+		return resp, errResp
+	}
+}
+
+func (s *fakeWorkspaceRoleAssignmentsStore) delete() func(ctx context.Context, workspaceID, workspaceRoleAssignmentID string, options *fabcore.WorkspacesClientDeleteWorkspaceRoleAssignmentOptions) (resp azfake.Responder[fabcore.WorkspacesClientDeleteWorkspaceRoleAssignmentResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, _, workspaceRoleAssignmentID string, _ *fabcore.WorkspacesClientDeleteWorkspaceRoleAssignmentOptions) (resp azfake.Responder[fabcore.WorkspacesClientDeleteWorkspaceRoleAssignmentResponse], errResp azfake.ErrorResponder) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.values = slices.DeleteFunc(s.values, func(entity fabcore.WorkspaceRoleAssignment) bool {
+			return *entity.ID == workspaceRoleAssignmentID
+		})
+
+		resp = azfake.Responder[fabcore.WorkspacesClientDeleteWorkspaceRoleAssignmentResponse]{}
+		resp.SetResponse(http.StatusOK, fabcore.WorkspacesClientDeleteWorkspaceRoleAssignmentResponse{}, nil)
+
+		return resp, errResp
+	}
+}
+
+// setUnmanaged adds a role assignment out of band, as if it had been granted in the portal.
+func (s *fakeWorkspaceRoleAssignmentsStore) setUnmanaged(entity fabcore.WorkspaceRoleAssignment) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.values = append(s.values, entity)
+}
@@ -15,6 +15,8 @@ import (
 
 	"github.com/microsoft/terraform-provider-fabric/internal/common"
 	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/roleassignments"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
 )
 
 /*
@@ -115,6 +117,14 @@ func (to *requestCreateWorkspaceRoleAssignment) set(ctx context.Context, from re
 	return nil
 }
 
+func (to *requestCreateWorkspaceRoleAssignment) setRoleAssignment(from roleassignments.RoleAssignment) {
+	to.Principal = &fabcore.Principal{
+		ID:   &from.PrincipalID,
+		Type: (*fabcore.PrincipalType)(&from.PrincipalType),
+	}
+	to.Role = (*fabcore.WorkspaceRole)(&from.Role)
+}
+
 type requestUpdateWorkspaceRoleAssignment struct {
 	fabcore.UpdateWorkspaceRoleAssignmentRequest
 }
@@ -122,3 +132,31 @@ type requestUpdateWorkspaceRoleAssignment struct {
 func (to *requestUpdateWorkspaceRoleAssignment) set(from resourceWorkspaceRoleAssignmentModel) {
 	to.Role = (*fabcore.WorkspaceRole)(from.Role.ValueStringPointer())
 }
+
+/*
+RESOURCE (authoritative set)
+*/
+
+func toRoleAssignments(from []fabcore.WorkspaceRoleAssignment) []roleassignments.RoleAssignment {
+	result := make([]roleassignments.RoleAssignment, 0, len(from))
+
+	for _, entity := range from {
+		if entity.Principal == nil {
+			continue
+		}
+
+		principal := entity.Principal.GetPrincipal()
+		if principal == nil || principal.ID == nil {
+			continue
+		}
+
+		result = append(result, roleassignments.RoleAssignment{
+			ID:            utils.ValueOrZero(entity.ID),
+			PrincipalID:   *principal.ID,
+			PrincipalType: string(utils.ValueOrZero(principal.Type)),
+			Role:          string(utils.ValueOrZero(entity.Role)),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,75 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacera
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/microsoft/fabric-sdk-go/fabric"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/fabricitem"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/roleassignments"
+	"github.com/microsoft/terraform-provider-fabric/internal/pkg/utils"
+)
+
+func NewResourceWorkspaceRoleAssignments() resource.Resource {
+	return roleassignments.NewResourceRoleAssignments(roleassignments.RoleAssignmentsResourceConfig{
+		TypeInfo:            ItemTypeInfo,
+		MarkdownDescription: fabricitem.NewResourceMarkdownDescription(ItemTypeInfo, true),
+		ObjectName:          "Workspace",
+		ObjectIDAttribute:   "workspace_id",
+		PrincipalTypes: utils.ConvertEnumsToStringSlices(utils.RemoveSlicesByValues(
+			fabcore.PossiblePrincipalTypeValues(),
+			[]fabcore.PrincipalType{fabcore.PrincipalTypeEntireTenant},
+		), true),
+		Roles: utils.ConvertEnumsToStringSlices(fabcore.PossibleWorkspaceRoleValues(), true),
+		NewClient: func(client *fabric.Client) roleassignments.RoleAssignmentsClient {
+			return &roleAssignmentsClient{
+				client: fabcore.NewClientFactoryWithClient(*client).NewWorkspacesClient(),
+			}
+		},
+	})
+}
+
+// roleAssignmentsClient adapts the Workspace role assignments API to roleassignments.RoleAssignmentsClient.
+type roleAssignmentsClient struct {
+	client *fabcore.WorkspacesClient
+}
+
+func (c *roleAssignmentsClient) List(ctx context.Context, workspaceID string) ([]roleassignments.RoleAssignment, error) {
+	respList, err := c.client.ListWorkspaceRoleAssignments(ctx, workspaceID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRoleAssignments(respList), nil
+}
+
+func (c *roleAssignmentsClient) Create(ctx context.Context, workspaceID string, assignment roleassignments.RoleAssignment) error {
+	var reqCreate requestCreateWorkspaceRoleAssignment
+
+	reqCreate.setRoleAssignment(assignment)
+
+	_, err := c.client.AddWorkspaceRoleAssignment(ctx, workspaceID, reqCreate.AddWorkspaceRoleAssignmentRequest, nil)
+
+	return err
+}
+
+func (c *roleAssignmentsClient) Update(ctx context.Context, workspaceID string, assignment roleassignments.RoleAssignment) error {
+	var reqUpdate requestUpdateWorkspaceRoleAssignment
+
+	reqUpdate.Role = (*fabcore.WorkspaceRole)(&assignment.Role)
+
+	_, err := c.client.UpdateWorkspaceRoleAssignment(ctx, workspaceID, assignment.ID, reqUpdate.UpdateWorkspaceRoleAssignmentRequest, nil)
+
+	return err
+}
+
+func (c *roleAssignmentsClient) Delete(ctx context.Context, workspaceID string, assignment roleassignments.RoleAssignment) error {
+	_, err := c.client.DeleteWorkspaceRoleAssignment(ctx, workspaceID, assignment.ID, nil)
+
+	return err
+}
@@ -0,0 +1,341 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacera_test
+
+import (
+	"regexp"
+	"testing"
+
+	azto "github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	at "github.com/dcarbone/terraform-plugin-framework-utils/v3/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
+
+	"github.com/microsoft/terraform-provider-fabric/internal/common"
+	"github.com/microsoft/terraform-provider-fabric/internal/framework/customtypes"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp"
+	"github.com/microsoft/terraform-provider-fabric/internal/testhelp/fakes"
+)
+
+var testResourceItemsFQN, testResourceItemsHeader = testhelp.TFResource(common.ProviderTypeName, itemTypeInfo.Types, "test")
+
+func TestUnit_WorkspaceRoleAssignmentsResource_Attributes(t *testing.T) {
+	principalID := testhelp.RandomUUID()
+
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemsFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// error - no required attributes - workspace_id
+		{
+			ResourceName: testResourceItemsFQN,
+			Config: at.CompileConfig(
+				testResourceItemsHeader,
+				map[string]any{
+					"assignments": []map[string]any{
+						{
+							"principal": map[string]any{
+								"id":   principalID,
+								"type": "User",
+							},
+							"role": "Member",
+						},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(`The argument "workspace_id" is required, but no definition was found.`),
+		},
+		// error - no required attributes - assignments
+		{
+			ResourceName: testResourceItemsFQN,
+			Config: at.CompileConfig(
+				testResourceItemsHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+				},
+			),
+			ExpectError: regexp.MustCompile(`The argument "assignments" is required, but no definition was found.`),
+		},
+		// error - invalid UUID - workspace_id
+		{
+			ResourceName: testResourceItemsFQN,
+			Config: at.CompileConfig(
+				testResourceItemsHeader,
+				map[string]any{
+					"workspace_id": "invalid uuid",
+					"assignments": []map[string]any{
+						{
+							"principal": map[string]any{
+								"id":   principalID,
+								"type": "User",
+							},
+							"role": "Member",
+						},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+		// error - invalid value - role
+		{
+			ResourceName: testResourceItemsFQN,
+			Config: at.CompileConfig(
+				testResourceItemsHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"assignments": []map[string]any{
+						{
+							"principal": map[string]any{
+								"id":   principalID,
+								"type": "User",
+							},
+							"role": "Owner",
+						},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+		},
+		// error - duplicated principal
+		{
+			ResourceName: testResourceItemsFQN,
+			Config: at.CompileConfig(
+				testResourceItemsHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"assignments": []map[string]any{
+						{
+							"principal": map[string]any{
+								"id":   principalID,
+								"type": "User",
+							},
+							"role": "Member",
+						},
+						{
+							"principal": map[string]any{
+								"id":   principalID,
+								"type": "User",
+							},
+							"role": "Viewer",
+						},
+					},
+				},
+			),
+			ExpectError: regexp.MustCompile(`is declared more than once`),
+		},
+		// error - declared and ignored principal
+		{
+			ResourceName: testResourceItemsFQN,
+			Config: at.CompileConfig(
+				testResourceItemsHeader,
+				map[string]any{
+					"workspace_id": "00000000-0000-0000-0000-000000000000",
+					"assignments": []map[string]any{
+						{
+							"principal": map[string]any{
+								"id":   principalID,
+								"type": "User",
+							},
+							"role": "Member",
+						},
+					},
+					"ignored_principal_ids": []string{principalID},
+				},
+			),
+			ExpectError: regexp.MustCompile(`is both declared in assignments and ignored`),
+		},
+	}))
+}
+
+func TestUnit_WorkspaceRoleAssignmentsResource_ImportState(t *testing.T) {
+	testCase := at.CompileConfig(
+		testResourceItemsHeader,
+		map[string]any{},
+	)
+
+	resource.ParallelTest(t, testhelp.NewTestUnitCase(t, &testResourceItemsFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		{
+			ResourceName:  testResourceItemsFQN,
+			Config:        testCase,
+			ImportStateId: "not-valid",
+			ImportState:   true,
+			ExpectError:   regexp.MustCompile(customtypes.UUIDTypeErrorInvalidStringHeader),
+		},
+	}))
+}
+
+func TestUnit_WorkspaceRoleAssignmentsResource_CRUD(t *testing.T) {
+	workspaceID := testhelp.RandomUUID()
+	memberID := testhelp.RandomUUID()
+	viewerID := testhelp.RandomUUID()
+	breakGlassID := testhelp.RandomUUID()
+	unmanagedID := testhelp.RandomUUID()
+
+	store := &fakeWorkspaceRoleAssignmentsStore{}
+	store.setUnmanaged(fabcore.WorkspaceRoleAssignment{
+		ID:        new(breakGlassID),
+		Role:      azto.Ptr(fabcore.WorkspaceRoleAdmin),
+		Principal: &fabcore.Principal{ID: new(breakGlassID), Type: azto.Ptr(fabcore.PrincipalTypeUser)},
+	})
+
+	fakes.FakeServer.ServerFactory.Core.WorkspacesServer.NewListWorkspaceRoleAssignmentsPager = store.list()
+	fakes.FakeServer.ServerFactory.Core.WorkspacesServer.AddWorkspaceRoleAssignment = store.add()
+	fakes.FakeServer.ServerFactory.Core.WorkspacesServer.UpdateWorkspaceRoleAssignment = store.update()
+	fakes.FakeServer.ServerFactory.Core.WorkspacesServer.DeleteWorkspaceRoleAssignment = store.delete()
+
+	config := func(viewerRole string) string {
+		return at.CompileConfig(
+			testResourceItemsHeader,
+			map[string]any{
+				"workspace_id": workspaceID,
+				"assignments": []map[string]any{
+					{
+						"principal": map[string]any{
+							"id":   memberID,
+							"type": "Group",
+						},
+						"role": "Member",
+					},
+					{
+						"principal": map[string]any{
+							"id":   viewerID,
+							"type": "User",
+						},
+						"role": viewerRole,
+					},
+				},
+				"ignored_principal_ids": []string{breakGlassID},
+			},
+		)
+	}
+
+	resource.Test(t, testhelp.NewTestUnitCase(t, &testResourceItemsFQN, fakes.FakeServer.ServerFactory, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemsFQN,
+			Config:       config("Viewer"),
+			ConfigStateChecks: []statecheck.StateCheck{
+				statecheck.ExpectKnownValue(testResourceItemsFQN, tfjsonpath.New("assignments"), knownvalue.SetSizeExact(2)),
+			},
+		},
+		// Update and Read
+		{
+			ResourceName: testResourceItemsFQN,
+			Config:       config("Contributor"),
+			ConfigStateChecks: []statecheck.StateCheck{
+				statecheck.ExpectKnownValue(testResourceItemsFQN, tfjsonpath.New("assignments"), knownvalue.SetPartial([]knownvalue.Check{
+					knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"principal": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"id":   knownvalue.StringExact(viewerID),
+							"type": knownvalue.StringExact("User"),
+						}),
+						"role": knownvalue.StringExact("Contributor"),
+					}),
+				})),
+			},
+		},
+		// Unmanaged principal is planned for removal
+		{
+			ResourceName: testResourceItemsFQN,
+			PreConfig: func() {
+				store.setUnmanaged(fabcore.WorkspaceRoleAssignment{
+					ID:        new(unmanagedID),
+					Role:      azto.Ptr(fabcore.WorkspaceRoleAdmin),
+					Principal: &fabcore.Principal{ID: new(unmanagedID), Type: azto.Ptr(fabcore.PrincipalTypeUser)},
+				})
+			},
+			Config: config("Contributor"),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction(testResourceItemsFQN, plancheck.ResourceActionUpdate),
+				},
+			},
+			ConfigStateChecks: []statecheck.StateCheck{
+				statecheck.ExpectKnownValue(testResourceItemsFQN, tfjsonpath.New("assignments"), knownvalue.SetSizeExact(2)),
+			},
+		},
+	}))
+}
+
+func TestAcc_WorkspaceRoleAssignmentsResource_CRUD(t *testing.T) {
+	capacity := testhelp.WellKnown()["Capacity"].(map[string]any)
+	capacityID := capacity["id"].(string)
+
+	workspaceResourceHCL, workspaceResourceFQN := testhelp.TestAccWorkspaceResource(t, capacityID)
+
+	entity := testhelp.WellKnown()["Principal"].(map[string]any)
+	entityID := entity["id"].(string)
+	entityType := entity["type"].(string)
+
+	// The identity running the tests is the Admin of the new Workspace and must be ignored to keep its access.
+	workspaceRoleAssignmentsDataSourceHCL := at.CompileConfig(
+		at.DataSourceHeader(testhelp.TypeName(common.ProviderTypeName, itemTypeInfo.Types), "admins"),
+		map[string]any{
+			"workspace_id": testhelp.RefByFQN(workspaceResourceFQN, "id"),
+		},
+	)
+
+	ignoredPrincipalIDs := at.ConfigLiteral("[for v in data.fabric_workspace_role_assignments.admins.values : v.principal.id if v.role == \"Admin\"]")
+
+	resource.Test(t, testhelp.NewTestAccCase(t, &testResourceItemsFQN, nil, []resource.TestStep{
+		// Create and Read
+		{
+			ResourceName: testResourceItemsFQN,
+			Config: at.JoinConfigs(
+				workspaceResourceHCL,
+				workspaceRoleAssignmentsDataSourceHCL,
+				at.CompileConfig(
+					testResourceItemsHeader,
+					map[string]any{
+						"workspace_id": testhelp.RefByFQN(workspaceResourceFQN, "id"),
+						"assignments": []map[string]any{
+							{
+								"principal": map[string]any{
+									"id":   entityID,
+									"type": entityType,
+								},
+								"role": "Member",
+							},
+						},
+						"ignored_principal_ids": ignoredPrincipalIDs,
+					},
+				),
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemsFQN, "assignments.#", "1"),
+				resource.TestCheckResourceAttr(testResourceItemsFQN, "assignments.0.principal.id", entityID),
+				resource.TestCheckResourceAttr(testResourceItemsFQN, "assignments.0.role", "Member"),
+			),
+		},
+		// Update and Read
+		{
+			ResourceName: testResourceItemsFQN,
+			Config: at.JoinConfigs(
+				workspaceResourceHCL,
+				workspaceRoleAssignmentsDataSourceHCL,
+				at.CompileConfig(
+					testResourceItemsHeader,
+					map[string]any{
+						"workspace_id": testhelp.RefByFQN(workspaceResourceFQN, "id"),
+						"assignments": []map[string]any{
+							{
+								"principal": map[string]any{
+									"id":   entityID,
+									"type": entityType,
+								},
+								"role": "Viewer",
+							},
+						},
+						"ignored_principal_ids": ignoredPrincipalIDs,
+					},
+				),
+			),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(testResourceItemsFQN, "assignments.#", "1"),
+				resource.TestCheckResourceAttr(testResourceItemsFQN, "assignments.0.role", "Viewer"),
+			),
+		},
+	}))
+}
@@ -4,8 +4,10 @@
 package fakes
 
 import (
+	"context"
 	"net/http"
 
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	fabcore "github.com/microsoft/fabric-sdk-go/fabric/core"
 	fabfake "github.com/microsoft/fabric-sdk-go/fabric/fake"
 
@@ -116,9 +118,140 @@ func configureDeploymentPipeline(server *fakeServer) fabcore.DeploymentPipelineE
 		&handler.ServerFactory.Core.DeploymentPipelinesServer.NewListDeploymentPipelinesPager,
 		&handler.ServerFactory.Core.DeploymentPipelinesServer.DeleteDeploymentPipeline)
 
+	handler.ServerFactory.Core.DeploymentPipelinesServer.AssignWorkspaceToStage = FakeAssignWorkspaceToStage(handler)
+	handler.ServerFactory.Core.DeploymentPipelinesServer.UnassignWorkspaceFromStage = FakeUnassignWorkspaceFromStage(handler)
+	handler.ServerFactory.Core.DeploymentPipelinesServer.DeleteDeploymentPipeline = FakeDeleteDeploymentPipeline(handler)
+
 	return fabcore.DeploymentPipelineExtendedInfo{}
 }
 
+func FakeAssignWorkspaceToStage(
+	handler *typedHandler[fabcore.DeploymentPipelineExtendedInfo],
+) func(ctx context.Context, deploymentPipelineID, stageID string, deploymentPipelineAssignWorkspaceRequest fabcore.DeploymentPipelineAssignWorkspaceRequest, options *fabcore.DeploymentPipelinesClientAssignWorkspaceToStageOptions) (resp azfake.Responder[fabcore.DeploymentPipelinesClientAssignWorkspaceToStageResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, deploymentPipelineID, stageID string, req fabcore.DeploymentPipelineAssignWorkspaceRequest, _ *fabcore.DeploymentPipelinesClientAssignWorkspaceToStageOptions) (azfake.Responder[fabcore.DeploymentPipelinesClientAssignWorkspaceToStageResponse], azfake.ErrorResponder) {
+		var resp azfake.Responder[fabcore.DeploymentPipelinesClientAssignWorkspaceToStageResponse]
+		var errResp azfake.ErrorResponder
+
+		if !handler.Contains(deploymentPipelineID) {
+			errResp.SetError(fabfake.SetResponseError(http.StatusNotFound, fabcore.ErrCommon.EntityNotFound.Error(), fabcore.ErrCommon.EntityNotFound.Error()))
+
+			return resp, errResp
+		}
+
+		// a workspace can only be assigned to a single stage across all deployment pipelines
+		for _, entity := range handler.Elements() {
+			for _, stage := range entity.Stages {
+				if stage.WorkspaceID != nil && *stage.WorkspaceID == *req.WorkspaceID {
+					errResp.SetError(fabfake.SetResponseError(http.StatusConflict, fabcore.ErrCommon.EntityConflict.Error(), "The workspace is already assigned to a deployment pipeline stage"))
+
+					return resp, errResp
+				}
+			}
+		}
+
+		entity := handler.Get(deploymentPipelineID)
+
+		idx := findDeploymentPipelineStage(entity, stageID)
+		if idx < 0 {
+			errResp.SetError(fabfake.SetResponseError(http.StatusNotFound, fabcore.ErrCommon.EntityNotFound.Error(), fabcore.ErrCommon.EntityNotFound.Error()))
+
+			return resp, errResp
+		}
+
+		if entity.Stages[idx].WorkspaceID != nil {
+			errResp.SetError(fabfake.SetResponseError(http.StatusConflict, fabcore.ErrCommon.EntityConflict.Error(), "The stage already has an assigned workspace"))
+
+			return resp, errResp
+		}
+
+		entity.Stages = append([]fabcore.DeploymentPipelineStage(nil), entity.Stages...)
+		entity.Stages[idx].WorkspaceID = req.WorkspaceID
+		entity.Stages[idx].WorkspaceName = new(testhelp.RandomName())
+
+		handler.Upsert(entity)
+
+		resp.SetResponse(http.StatusOK, fabcore.DeploymentPipelinesClientAssignWorkspaceToStageResponse{}, nil)
+
+		return resp, errResp
+	}
+}
+
+func FakeUnassignWorkspaceFromStage(
+	handler *typedHandler[fabcore.DeploymentPipelineExtendedInfo],
+) func(ctx context.Context, deploymentPipelineID, stageID string, options *fabcore.DeploymentPipelinesClientUnassignWorkspaceFromStageOptions) (resp azfake.Responder[fabcore.DeploymentPipelinesClientUnassignWorkspaceFromStageResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, deploymentPipelineID, stageID string, _ *fabcore.DeploymentPipelinesClientUnassignWorkspaceFromStageOptions) (azfake.Responder[fabcore.DeploymentPipelinesClientUnassignWorkspaceFromStageResponse], azfake.ErrorResponder) {
+		var resp azfake.Responder[fabcore.DeploymentPipelinesClientUnassignWorkspaceFromStageResponse]
+		var errResp azfake.ErrorResponder
+
+		if !handler.Contains(deploymentPipelineID) {
+			errResp.SetError(fabfake.SetResponseError(http.StatusNotFound, fabcore.ErrCommon.EntityNotFound.Error(), fabcore.ErrCommon.EntityNotFound.Error()))
+
+			return resp, errResp
+		}
+
+		entity := handler.Get(deploymentPipelineID)
+
+		idx := findDeploymentPipelineStage(entity, stageID)
+		if idx < 0 {
+			errResp.SetError(fabfake.SetResponseError(http.StatusNotFound, fabcore.ErrCommon.EntityNotFound.Error(), fabcore.ErrCommon.EntityNotFound.Error()))
+
+			return resp, errResp
+		}
+
+		if entity.Stages[idx].WorkspaceID == nil {
+			errResp.SetError(fabfake.SetResponseError(
+				http.StatusBadRequest,
+				fabcore.ErrDeploymentPipeline.DeploymentPipelineStageHasNoAssignedWorkspace.Error(),
+				fabcore.ErrDeploymentPipeline.DeploymentPipelineStageHasNoAssignedWorkspace.Error(),
+			))
+
+			return resp, errResp
+		}
+
+		entity.Stages = append([]fabcore.DeploymentPipelineStage(nil), entity.Stages...)
+		entity.Stages[idx].WorkspaceID = nil
+		entity.Stages[idx].WorkspaceName = nil
+
+		handler.Upsert(entity)
+
+		resp.SetResponse(http.StatusOK, fabcore.DeploymentPipelinesClientUnassignWorkspaceFromStageResponse{}, nil)
+
+		return resp, errResp
+	}
+}
+
+func FakeDeleteDeploymentPipeline(
+	handler *typedHandler[fabcore.DeploymentPipelineExtendedInfo],
+) func(ctx context.Context, deploymentPipelineID string, options *fabcore.DeploymentPipelinesClientDeleteDeploymentPipelineOptions) (resp azfake.Responder[fabcore.DeploymentPipelinesClientDeleteDeploymentPipelineResponse], errResp azfake.ErrorResponder) {
+	return func(_ context.Context, deploymentPipelineID string, _ *fabcore.DeploymentPipelinesClientDeleteDeploymentPipelineOptions) (azfake.Responder[fabcore.DeploymentPipelinesClientDeleteDeploymentPipelineResponse], azfake.ErrorResponder) {
+		if handler.Contains(deploymentPipelineID) {
+			// a deployment pipeline with assigned workspaces cannot be deleted
+			for _, stage := range handler.Get(deploymentPipelineID).Stages {
+				if stage.WorkspaceID != nil {
+					var resp azfake.Responder[fabcore.DeploymentPipelinesClientDeleteDeploymentPipelineResponse]
+					var errResp azfake.ErrorResponder
+
+					errResp.SetError(fabfake.SetResponseError(http.StatusConflict, fabcore.ErrCommon.EntityConflict.Error(), "The deployment pipeline has assigned workspaces"))
+
+					return resp, errResp
+				}
+			}
+		}
+
+		return deleteByID[fabcore.DeploymentPipelineExtendedInfo, fabcore.DeploymentPipelinesClientDeleteDeploymentPipelineResponse](handler, deploymentPipelineID)
+	}
+}
+
+func findDeploymentPipelineStage(entity fabcore.DeploymentPipelineExtendedInfo, stageID string) int {
+	for i, stage := range entity.Stages {
+		if stage.ID != nil && *stage.ID == stageID {
+			return i
+		}
+	}
+
+	return -1
+}
+
 func NewRandomDeploymentPipeline() fabcore.DeploymentPipelineExtendedInfo {
 	return fabcore.DeploymentPipelineExtendedInfo{
 		ID:          new(testhelp.RandomUUID()),
@@ -96,6 +96,12 @@ func (s *fakeServer) Upsert(element any) {
 	s.elements = append(s.elements, element)
 }
 
+// UpsertDefinition inserts or updates the definition of an element with a parent ID in the server.
+// It can be used to simulate definition changes made outside of Terraform.
+func (s *fakeServer) UpsertDefinition(parentID, id string, definition any) {
+	s.definitions[generateID(parentID, id)] = definition
+}
+
 // SupportsType returns true if the server supports the given type.
 func (s *fakeServer) isSupportedType(t reflect.Type) bool {
 	for _, supportedType := range s.types {
@@ -90,6 +90,13 @@ func newTestAccCase(t *testing.T, testResource *string, preCheck func(*testing.T
 		}
 	}
 
+	// list specific configurations
+	if strings.Contains(t.Name(), "ListResource") {
+		testCase.TerraformVersionChecks = []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		}
+	}
+
 	// writeOnly specific configurations
 	if strings.Contains(strings.ToLower(t.Name()), "writeonly") {
 		testCase.TerraformVersionChecks = []tfversion.TerraformVersionCheck{
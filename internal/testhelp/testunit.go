@@ -96,6 +96,23 @@ func newTestUnitCase(t *testing.T, testResource *string, fakeServer *fabfake.Ser
 		}
 	}
 
+	// list specific configurations
+	if strings.Contains(t.Name(), "ListResource") {
+		testCase.TerraformVersionChecks = []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		}
+	}
+
+	// action specific configurations
+	if testResource != nil && strings.HasPrefix(*testResource, "action") {
+		testCase.TerraformVersionChecks = []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		}
+		testCase.CheckDestroy = func(_ *terraform.State) error {
+			return nil // Actions are not stored in the state
+		}
+	}
+
 	// writeOnly specific configurations
 	if strings.Contains(strings.ToLower(t.Name()), "writeonly") {
 		testCase.TerraformVersionChecks = []tfversion.TerraformVersionCheck{
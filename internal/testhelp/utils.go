@@ -140,6 +140,61 @@ func TFEphemeral(providerName, typeName, ephemeralResourceName string) (fqn, hea
 	return fqn, header
 }
 
+func TFList(providerName, typeName, listResourceName string) (fqn, header string) { //nolint:nonamedreturns
+	fqn = ResourceFQN(providerName, typeName, listResourceName)
+	header = ListResourceHeader(TypeName(providerName, typeName), listResourceName)
+
+	return fqn, header
+}
+
+func TFAction(providerName, typeName, actionName string) (fqn, header string) { //nolint:nonamedreturns
+	fqn = ActionFQN(providerName, typeName, actionName)
+	header = ActionHeader(TypeName(providerName, typeName), actionName)
+
+	return fqn, header
+}
+
+// TFListConfig is a helper function to create a query configuration with the list block and its config block.
+func TFListConfig(providerName, listResourceHeader string, configFields map[string]any) string {
+	// lintignore:AT004
+	const f = `
+provider %[1]q {}
+
+%[2]s {
+provider = %[1]s
+%[3]s
+}`
+
+	return fmt.Sprintf(f, providerName, listResourceHeader, at.CompileConfig("config", configFields))
+}
+
+// TFActionConfig is a helper function to create an action configuration with the action block and its config block.
+func TFActionConfig(actionHeader string, configFields map[string]any) string {
+	// lintignore:AT004
+	const f = `
+%[1]s {
+%[2]s
+}`
+
+	return fmt.Sprintf(f, actionHeader, at.CompileConfig("config", configFields))
+}
+
+// TFActionTrigger is a helper function to create a resource that invokes the action when it is created.
+func TFActionTrigger(actionFQN string) string {
+	// lintignore:AT004
+	const f = `
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [%[1]s]
+    }
+  }
+}`
+
+	return fmt.Sprintf(f, actionFQN)
+}
+
 func TFEphemeralEcho(ephemeralResourceFQN string) (config, fqn string) { //nolint:nonamedreturns
 	fqn = "echo.test"
 
@@ -161,6 +216,18 @@ func EphemeralResourceHeader(ephemeralResourceType, ephemeralResourceName string
 	return fmt.Sprintf(f, ephemeralResourceType, ephemeralResourceName)
 }
 
+func ListResourceHeader(listResourceType, listResourceName string) string {
+	const f = `list %q %q`
+
+	return fmt.Sprintf(f, listResourceType, listResourceName)
+}
+
+func ActionHeader(actionType, actionName string) string {
+	const f = `action %q %q`
+
+	return fmt.Sprintf(f, actionType, actionName)
+}
+
 // TypeName is a helper function to create a base type name.
 func TypeName(providerName, typeName string) string {
 	return fmt.Sprintf("%s_%s", providerName, typeName)
@@ -181,6 +248,11 @@ func EphemeralResourceFQN(providerName, typeName, ephemeralResource string) stri
 	return fmt.Sprintf("ephemeral.%s.%s", TypeName(providerName, typeName), ephemeralResource)
 }
 
+// ActionFQN is a helper function to create an action FQN.
+func ActionFQN(providerName, typeName, actionName string) string {
+	return fmt.Sprintf("action.%s.%s", TypeName(providerName, typeName), actionName)
+}
+
 // FunctionHeader is a helper function to create a function Header.
 func FunctionHeader(providerName, functionName string) string {
 	return fmt.Sprintf(`provider::%s::%s`, providerName, functionName)
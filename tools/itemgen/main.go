@@ -5,11 +5,14 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"errors"
 	"flag"
 	"fmt"
+	"go/format"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"text/template"
 )
@@ -19,9 +22,13 @@ const (
 	DefaultDisplayNameMax = 123
 	DefaultDescriptionMax = 256
 	DefaultFilePermission = 0o755
+	DefaultFixturePerm    = 0o644
 	TemplateBaseDir       = "tools/itemgen/templates"
 	FakeTestDir           = "internal/testhelp/fakes"
+	FakeServerFile        = "fake_server.go"
+	FixturesDir           = "internal/testhelp/fixtures"
 	ServicesDir           = "internal/services"
+	PlaceholderTODO       = "<TODO>"
 )
 
 // Exit codes for different error types.
@@ -43,20 +50,40 @@ type ItemConfig struct {
 	Package           string
 	ModelName         string
 	FabricItemType    string
-	DefinitionFormats []string
+	DefinitionFormats []DefinitionFormatSpec
 	DefinitionPath    string
-	DocsURL           string
-	DisplayNameMax    int
-	DescriptionMax    int
-	ItemType          ItemType
-	HasDefinition     bool
-	HasProperties     bool
-	HasConfig         bool
-	IsPreview         bool
-	IsSPNSupported    bool
+	// DefinitionAPIFormat is the API format of the format containing DefinitionPath, used by the fake definition.
+	DefinitionAPIFormat string
+	// DefinitionSDKType is the name of the definition struct in the SDK package, e.g. "Definition" or "PublicDefinition".
+	DefinitionSDKType  string
+	DefinitionDocsURL  string
+	DefinitionEmpty    string
+	DefinitionFixtures map[string]string
+	// DefinitionRequired, ConfigRequired and ConfigOrDefinitionRequired are Go expressions, a quoted placeholder when not set from a spec file.
+	DefinitionRequired         string
+	ConfigRequired             string
+	ConfigOrDefinitionRequired string
+	DocsURL                    string
+	DisplayNameMax             int
+	DescriptionMax             int
+	ItemType                   ItemType
+	HasDefinition              bool
+	HasProperties              bool
+	HasConfig                  bool
+	IsPreview                  bool
+	IsSPNSupported             bool
+	// Properties and Configuration are the fields of the properties and configuration structs, only set from a spec file.
+	Properties    []FieldSpec
+	Configuration []FieldSpec
+	// LROCreate is true when the item creation is a long-running operation.
+	LROCreate bool
+	// CreateFolderID is true when the SDK create request has a FolderID field.
+	CreateFolderID bool
 	// Internal fields for generation options
 	generateFakes    bool
 	generateExamples bool
+	generateFixtures bool
+	registerFakes    bool
 }
 
 type ItemType int
@@ -146,6 +173,7 @@ func main() {
 
 // parseFlags parses command line flags and returns configuration.
 func parseFlags() (ItemConfig, error) {
+	specPath := flag.String("spec", "", "Path of an item spec file (JSON). When set, the item flags are ignored")
 	itemName := flag.String("item-name", "", "Name of the new item (e.g. Data Pipeline)")
 	itemsName := flag.String("items-name", "", "Name of the new item in plural form (e.g. Data Pipelines)")
 	itemTypeFlag := flag.String("item-type", "", fmt.Sprintf("Type of item (%s)", strings.Join(validItemTypes(), "|")))
@@ -158,6 +186,20 @@ func parseFlags() (ItemConfig, error) {
 
 	flag.Parse()
 
+	if *specPath != "" {
+		config, err := loadSpec(*specPath)
+		if err != nil {
+			return ItemConfig{}, err
+		}
+
+		config.generateFakes = *generateFakes
+		config.registerFakes = *generateFakes
+		config.generateFixtures = true
+		config.generateExamples = *generateExamples
+
+		return config, nil
+	}
+
 	// Validate required flags
 	if *itemName == "" {
 		return ItemConfig{}, errors.New("item-name is required")
@@ -178,31 +220,88 @@ func parseFlags() (ItemConfig, error) {
 	}
 
 	// Create configuration
+	config := newItemConfig(*itemName, *itemsName, itemTypeEnum)
+	config.RenameAllowed = *renameAllowed
+	config.DefinitionFormats = []DefinitionFormatSpec{{Type: DefinitionFormatDefault, Paths: []string{*definitionPath}}}
+	config.DefinitionPath = *definitionPath
+	config.IsPreview = *isPreview
+	config.IsSPNSupported = *isSPNSupported
+	// Store flags for optional generation
+	config.generateFakes = *generateFakes
+	config.registerFakes = *generateFakes
+	config.generateFixtures = true
+	config.generateExamples = *generateExamples
+
+	return config, nil
+}
+
+// newItemConfig returns the configuration of an item with the default values.
+func newItemConfig(itemName, itemsName string, itemType ItemType) ItemConfig {
 	return ItemConfig{
-		Name:              *itemName,
-		Type:              normalizeTypeName(*itemName),
-		TypeInfo:          strings.ReplaceAll(*itemName, " ", ""),
-		Names:             *itemsName,
-		Types:             normalizeTypeName(*itemsName),
-		TypesInfo:         strings.ReplaceAll(*itemsName, " ", ""),
-		Package:           strings.ToLower(strings.ReplaceAll(*itemName, " ", "")),
-		ModelName:         toCamelCase(*itemName),
-		RenameAllowed:     *renameAllowed,
-		DefinitionFormats: []string{"<part1>", "<part2>"}, // Placeholder - should be configurable
-		DefinitionPath:    *definitionPath,
-		DocsURL:           "<docs-url>", // Placeholder - should be configurable
-		DisplayNameMax:    DefaultDisplayNameMax,
-		DescriptionMax:    DefaultDescriptionMax,
-		ItemType:          itemTypeEnum,
-		HasDefinition:     itemTypeEnum.hasDefinition(),
-		HasProperties:     itemTypeEnum.hasProperties(),
-		HasConfig:         itemTypeEnum.hasConfig(),
-		IsPreview:         *isPreview,
-		IsSPNSupported:    *isSPNSupported,
-		// Store flags for optional generation
-		generateFakes:    *generateFakes,
-		generateExamples: *generateExamples,
-	}, nil
+		Name:                       itemName,
+		Type:                       normalizeTypeName(itemName),
+		TypeInfo:                   strings.ReplaceAll(itemName, " ", ""),
+		Names:                      itemsName,
+		Types:                      normalizeTypeName(itemsName),
+		TypesInfo:                  strings.ReplaceAll(itemsName, " ", ""),
+		Package:                    strings.ToLower(strings.ReplaceAll(itemName, " ", "")),
+		ModelName:                  toCamelCase(itemName),
+		RenameAllowed:              true,
+		DefinitionDocsURL:          PlaceholderTODO,
+		DefinitionEmpty:            PlaceholderTODO,
+		DefinitionSDKType:          "Definition",
+		DefinitionRequired:         `"` + PlaceholderTODO + `"`,
+		ConfigRequired:             `"` + PlaceholderTODO + `"`,
+		ConfigOrDefinitionRequired: `"` + PlaceholderTODO + `"`,
+		DocsURL:                    PlaceholderTODO,
+		DisplayNameMax:             DefaultDisplayNameMax,
+		DescriptionMax:             DefaultDescriptionMax,
+		ItemType:                   itemType,
+		HasDefinition:              itemType.hasDefinition(),
+		HasProperties:              itemType.hasProperties(),
+		HasConfig:                  itemType.hasConfig(),
+		LROCreate:                  true,
+	}
+}
+
+// HasFieldType returns true if one of the fields of the group ("properties" or "configuration") has the given type.
+func (c ItemConfig) HasFieldType(group, fieldType string) bool {
+	fields := c.Properties
+	if group == "configuration" {
+		fields = c.Configuration
+	}
+
+	return slices.ContainsFunc(fields, func(f FieldSpec) bool {
+		return f.Type == fieldType
+	})
+}
+
+// ModelUsesTypes returns true if the generated models use the framework types package.
+func (c ItemConfig) ModelUsesTypes() bool {
+	if len(c.Properties) == 0 || (c.HasConfig && len(c.Configuration) == 0) {
+		return true
+	}
+
+	fields := slices.Concat(c.Properties, c.Configuration)
+
+	return slices.ContainsFunc(fields, func(f FieldSpec) bool {
+		return f.Type != "uuid"
+	})
+}
+
+// ConfigurationPlanModifierPackages returns the sorted plan modifier packages used by the configuration fields, e.g. ["boolplanmodifier"].
+func (c ItemConfig) ConfigurationPlanModifierPackages() []string {
+	packages := make([]string, 0, len(c.Configuration))
+
+	for _, field := range c.Configuration {
+		if pkg := field.PlanModifierPackage(); !slices.Contains(packages, pkg) {
+			packages = append(packages, pkg)
+		}
+	}
+
+	slices.Sort(packages)
+
+	return packages
 }
 
 // parseItemType converts string to ItemType enum.
@@ -317,7 +416,7 @@ func generateServiceFiles(itemDir string, config ItemConfig) error {
 	return nil
 }
 
-// generateOptionalFiles generates fake handlers and examples if requested.
+// generateOptionalFiles generates fake handlers, fixtures and examples if requested.
 func generateOptionalFiles(config ItemConfig) error {
 	if config.generateFakes {
 		if config.ItemType == TypeBasic {
@@ -326,6 +425,18 @@ func generateOptionalFiles(config ItemConfig) error {
 			if err := generateFakeHandlers(config); err != nil {
 				return fmt.Errorf("failed to generate fake handlers: %w", err)
 			}
+
+			if config.registerFakes {
+				if err := registerFakeHandlers(config); err != nil {
+					return fmt.Errorf("failed to register fake handlers: %w", err)
+				}
+			}
+		}
+	}
+
+	if config.generateFixtures && config.HasDefinition {
+		if err := generateFixtureFiles(config); err != nil {
+			return fmt.Errorf("failed to generate fixtures: %w", err)
 		}
 	}
 
@@ -352,6 +463,96 @@ func generateFakeHandlers(config ItemConfig) error {
 	return nil
 }
 
+// registerFakeHandlers adds the handleEntity registration of the item to the fake server.
+// The line is inserted after the last registration sorting before it, to keep the list alphabetical.
+func registerFakeHandlers(config ItemConfig) error {
+	serverFile := filepath.Join(FakeTestDir, FakeServerFile)
+
+	data, err := os.ReadFile(serverFile)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", serverFile, err)
+	}
+
+	const prefix = "\thandleEntity(server, configure"
+
+	registration := prefix + config.TypeInfo + ")"
+	lines := strings.Split(string(data), "\n")
+
+	if slices.Contains(lines, registration) {
+		fmt.Printf("Fake handlers already registered in %s\n", serverFile)
+
+		return nil
+	}
+
+	insertAt := -1
+	newName := strings.ToLower(config.TypeInfo)
+
+	for i, line := range lines {
+		if !strings.HasPrefix(line, prefix) {
+			continue
+		}
+
+		name := strings.ToLower(strings.TrimSuffix(strings.TrimPrefix(line, prefix), ")"))
+		if insertAt == -1 || name < newName {
+			insertAt = i + 1
+		}
+	}
+
+	if insertAt == -1 {
+		return fmt.Errorf("no handleEntity registration found in %s", serverFile)
+	}
+
+	lines = slices.Insert(lines, insertAt, registration)
+
+	if err := os.WriteFile(serverFile, []byte(strings.Join(lines, "\n")), DefaultFixturePerm); err != nil {
+		return fmt.Errorf("failed to write %s: %w", serverFile, err)
+	}
+
+	fmt.Printf("Registered configure%s in %s\n", config.TypeInfo, serverFile)
+
+	return nil
+}
+
+// generateFixtureFiles generates the definition fixtures used by the tests, one per definition path without wildcards.
+func generateFixtureFiles(config ItemConfig) error {
+	fixtureDir := filepath.Join(FixturesDir, config.Type)
+
+	if err := os.MkdirAll(fixtureDir, DefaultFilePermission); err != nil {
+		return fmt.Errorf("error creating directory %s: %w", fixtureDir, err)
+	}
+
+	written := make(map[string]bool)
+
+	for _, definitionFormat := range config.DefinitionFormats {
+		for _, definitionPath := range definitionFormat.Paths {
+			if written[definitionPath] || strings.ContainsAny(definitionPath, "*?[") {
+				continue
+			}
+
+			written[definitionPath] = true
+
+			content, ok := config.DefinitionFixtures[definitionPath]
+			if !ok {
+				content = defaultFixtureContent(definitionPath)
+			}
+
+			output := filepath.Join(fixtureDir, filepath.FromSlash(definitionPath)+".tmpl")
+
+			if err := os.MkdirAll(filepath.Dir(output), DefaultFilePermission); err != nil {
+				return fmt.Errorf("error creating directory %s: %w", filepath.Dir(output), err)
+			}
+
+			if err := os.WriteFile(output, []byte(content), DefaultFixturePerm); err != nil {
+				return fmt.Errorf("error creating fixture %s: %w", output, err)
+			}
+
+			fmt.Printf("Generated %s\n", output)
+		}
+	}
+
+	return nil
+}
+
 // printSuccessMessage displays the success message and next steps.
 func printSuccessMessage(config ItemConfig, itemDir string) {
 	fmt.Printf("\nSuccessfully generated item %s in %s\n", config.Name, itemDir)
@@ -360,6 +561,10 @@ func printSuccessMessage(config ItemConfig, itemDir string) {
 		fmt.Printf("Also generated fake test handlers in %s/\n", FakeTestDir)
 	}
 
+	if config.generateFixtures && config.HasDefinition {
+		fmt.Printf("Also generated definition fixtures in %s/\n", filepath.Join(FixturesDir, config.Type))
+	}
+
 	if config.ItemType == TypeBasic {
 		fmt.Println("Note: Basic items use the generic fabcore.Item fake handler (no typed fake needed)")
 	}
@@ -380,14 +585,18 @@ func printNextSteps(config ItemConfig) {
 	fmt.Printf("%d. Review the generated files\n", step)
 
 	step++
-	fmt.Printf("%d. Update the documentation URL if needed\n", step)
+	if config.DocsURL == PlaceholderTODO || (config.HasDefinition && config.DefinitionDocsURL == PlaceholderTODO) {
+		fmt.Printf("%d. Update the documentation URLs\n", step)
+	} else {
+		fmt.Printf("%d. Update the documentation URL if needed\n", step)
+	}
 
 	step++
 	fmt.Printf("%d. Add any service-specific logic\n", step)
 
 	step++
 
-	if config.generateFakes {
+	if config.generateFakes && !config.registerFakes && config.ItemType != TypeBasic {
 		fmt.Printf("%d. Register the fake handlers in the fake server\n", step)
 
 		step++
@@ -449,20 +658,27 @@ func generateFile(dir, tmplPath, outputFile string, config ItemConfig) error {
 		return fmt.Errorf("error creating directory %s: %w", dir, err)
 	}
 
-	// Create output file
-	output := filepath.Join(dir, outputFile)
-	f, err := os.Create(output)
-	if err != nil {
-		return fmt.Errorf("error creating output file %s: %w", output, err)
-	}
-
-	defer f.Close()
+	var buf bytes.Buffer
 
 	// Execute template
-	if err := tmpl.Execute(f, config); err != nil {
+	if err := tmpl.Execute(&buf, config); err != nil {
 		return fmt.Errorf("error executing template %s: %w", tmplPath, err)
 	}
 
+	content := buf.Bytes()
+
+	// Format Go files, the generated code may still contain placeholders so keep it as is when it cannot be parsed
+	if filepath.Ext(outputFile) == ".go" {
+		if formatted, err := format.Source(content); err == nil {
+			content = formatted
+		}
+	}
+
+	output := filepath.Join(dir, outputFile)
+	if err := os.WriteFile(output, content, DefaultFixturePerm); err != nil {
+		return fmt.Errorf("error creating output file %s: %w", output, err)
+	}
+
 	return nil
 }
 
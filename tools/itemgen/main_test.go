@@ -0,0 +1,76 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files") //nolint:gochecknoglobals
+
+// TestGenerate_SnowflakeDatabase generates the service files and the fake of specs/snowflakedatabase.json
+// and compares them with the golden files in testdata. Run with -update to refresh the golden files.
+func TestGenerate_SnowflakeDatabase(t *testing.T) {
+	goldenDir, err := filepath.Abs(filepath.Join("testdata", "snowflakedatabase"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outputDir := t.TempDir()
+
+	// The template paths are relative to the repository root, as when running the generator.
+	t.Chdir(filepath.Join("..", ".."))
+
+	config, err := loadSpec(filepath.Join("tools", "itemgen", "specs", "snowflakedatabase.json"))
+	if err != nil {
+		t.Fatalf("loadSpec() unexpected error: %v", err)
+	}
+
+	if err := generateServiceFiles(outputDir, config); err != nil {
+		t.Fatalf("generateServiceFiles() unexpected error: %v", err)
+	}
+
+	fakeFile := "fabric_" + config.Type + ".go"
+	if err := generateFile(outputDir, filepath.Join(TemplateBaseDir, "Test", "fabric_item.go.tmpl"), fakeFile, config); err != nil {
+		t.Fatalf("generateFile() unexpected error: %v", err)
+	}
+
+	files := []string{fakeFile}
+	for _, file := range getFilesForItemType(config.Type, config.Types, config.ItemType) {
+		files = append(files, file.output)
+	}
+
+	for _, file := range files {
+		got, err := os.ReadFile(filepath.Join(outputDir, file))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		goldenFile := filepath.Join(goldenDir, file+".golden")
+
+		if *update {
+			if err := os.MkdirAll(goldenDir, DefaultFilePermission); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := os.WriteFile(goldenFile, got, DefaultFixturePerm); err != nil {
+				t.Fatal(err)
+			}
+
+			continue
+		}
+
+		want, err := os.ReadFile(goldenFile)
+		if err != nil {
+			t.Fatalf("%v, run the test with -update to create the golden file", err)
+		}
+
+		if string(got) != string(want) {
+			t.Errorf("generated %s does not match %s, run the test with -update if the change is expected", file, goldenFile)
+		}
+	}
+}
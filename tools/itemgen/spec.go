@@ -0,0 +1,389 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// DefinitionFormatDefault mirrors fabricitem.DefinitionFormatDefault.
+const DefinitionFormatDefault = "Default"
+
+// ItemSpec describes a Fabric item to generate, as read from a JSON spec file.
+type ItemSpec struct {
+	Name           string `json:"name"`
+	Names          string `json:"names"`
+	DocsURL        string `json:"docsUrl"`
+	RenameAllowed  *bool  `json:"renameAllowed"`
+	IsPreview      bool   `json:"isPreview"`
+	IsSPNSupported bool   `json:"isSpnSupported"`
+	// CreateFolderID is false when the SDK create request has no FolderID field. Defaults to true.
+	CreateFolderID *bool           `json:"createFolderId"`
+	Definition     *DefinitionSpec `json:"definition"`
+	Properties     []FieldSpec     `json:"properties"`
+	Configuration  []FieldSpec     `json:"configuration"`
+	// ConfigurationRequired is used by items with configuration, ConfigurationOrDefinitionRequired only by items with both configuration and definition.
+	ConfigurationRequired             bool    `json:"configurationRequired"`
+	ConfigurationOrDefinitionRequired bool    `json:"configurationOrDefinitionRequired"`
+	LRO                               LROSpec `json:"lro"`
+}
+
+// DefinitionSpec describes the item definition.
+type DefinitionSpec struct {
+	DocsURL  string `json:"docsUrl"`
+	Required bool   `json:"required"`
+	// SDKType is the name of the definition struct in the SDK package. Defaults to "Definition".
+	SDKType  string                 `json:"sdkType"`
+	Empty    string                 `json:"empty"`
+	Formats  []DefinitionFormatSpec `json:"formats"`
+	Fixtures map[string]string      `json:"fixtures"`
+}
+
+// DefinitionFormatSpec describes one definition format supported by the item.
+type DefinitionFormatSpec struct {
+	Type  string   `json:"type"`
+	API   string   `json:"api"`
+	Paths []string `json:"paths"`
+}
+
+// LROSpec describes which operations of the item API are long-running.
+type LROSpec struct {
+	Create *bool `json:"create"`
+}
+
+// FieldSpec describes a field of the properties or configuration struct of the item.
+type FieldSpec struct {
+	// Name is the SDK struct field name, e.g. "OneLakeTablesPath".
+	Name string `json:"name"`
+	// Attribute is the Terraform attribute name. Defaults to the snake_case form of Name.
+	Attribute   string `json:"attribute"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	// Required is only used by configuration fields.
+	Required bool `json:"required"`
+}
+
+var (
+	validFieldTypes    = []string{"string", "uuid", "bool", "int32", "int64"} //nolint:gochecknoglobals
+	sdkFieldNameRegexp = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)            //nolint:gochecknoglobals
+	attributeRegexp    = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)              //nolint:gochecknoglobals
+)
+
+// Kind returns the Terraform framework kind of the field, e.g. "String" or "Bool".
+func (f FieldSpec) Kind() string {
+	switch f.Type {
+	case "bool":
+		return "Bool"
+	case "int32":
+		return "Int32"
+	case "int64":
+		return "Int64"
+	default:
+		return "String"
+	}
+}
+
+// PlanModifierPackage returns the name of the plan modifier package of the field kind, e.g. "stringplanmodifier".
+func (f FieldSpec) PlanModifierPackage() string {
+	return strings.ToLower(f.Kind()) + "planmodifier"
+}
+
+// ModelType returns the Go type of the field in the Terraform model.
+func (f FieldSpec) ModelType() string {
+	if f.Type == "uuid" {
+		return "customtypes.UUID"
+	}
+
+	return "types." + f.Kind()
+}
+
+// ModelValue returns the expression converting the SDK field of from into the Terraform model value.
+func (f FieldSpec) ModelValue(from string) string {
+	if f.Type == "uuid" {
+		return "customtypes.NewUUIDPointerValue(" + from + "." + f.Name + ")"
+	}
+
+	return "types." + f.Kind() + "PointerValue(" + from + "." + f.Name + ")"
+}
+
+// SDKValue returns the expression converting the Terraform model field of from into the SDK pointer value.
+func (f FieldSpec) SDKValue(from string) string {
+	return from + "." + f.Name + ".Value" + f.Kind() + "Pointer()"
+}
+
+// RandomValue returns the expression used by the fake to generate a random value.
+func (f FieldSpec) RandomValue() string {
+	switch f.Type {
+	case "uuid":
+		return "new(testhelp.RandomUUID())"
+	case "bool":
+		return "new(testhelp.RandomBool())"
+	case "int32":
+		return "new(testhelp.RandomIntRange[int32](1, 100))"
+	case "int64":
+		return "new(testhelp.RandomIntRange[int64](1, 100))"
+	default:
+		return "new(testhelp.RandomName())"
+	}
+}
+
+// DefinitionFormatType returns the Go expression of the format type used in base.go.
+func (f DefinitionFormatSpec) DefinitionFormatType() string {
+	if f.Type == DefinitionFormatDefault {
+		return "fabricitem.DefinitionFormatDefault"
+	}
+
+	return fmt.Sprintf("%q", f.Type)
+}
+
+// loadSpec reads and validates an item spec file and converts it into a configuration.
+func loadSpec(specPath string) (ItemConfig, error) {
+	data, err := os.ReadFile(specPath)
+	if err != nil {
+		return ItemConfig{}, fmt.Errorf("failed to read spec file %s: %w", specPath, err)
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.DisallowUnknownFields()
+
+	var spec ItemSpec
+	if err := decoder.Decode(&spec); err != nil {
+		return ItemConfig{}, fmt.Errorf("failed to parse spec file %s: %w", specPath, err)
+	}
+
+	if err := validateSpec(&spec); err != nil {
+		return ItemConfig{}, fmt.Errorf("invalid spec file %s: %w", specPath, err)
+	}
+
+	return spec.toConfig(), nil
+}
+
+// validateSpec checks the spec and fills in the defaults.
+func validateSpec(spec *ItemSpec) error {
+	if strings.TrimSpace(spec.Name) == "" {
+		return errors.New("name is required")
+	}
+
+	if strings.TrimSpace(spec.Names) == "" {
+		return errors.New("names is required")
+	}
+
+	if len(spec.Configuration) > 0 && len(spec.Properties) == 0 {
+		return errors.New("configuration requires properties, there is no item type with configuration only")
+	}
+
+	if spec.Definition != nil {
+		if err := validateDefinitionSpec(spec.Definition); err != nil {
+			return err
+		}
+	}
+
+	for group, fields := range map[string][]FieldSpec{"properties": spec.Properties, "configuration": spec.Configuration} {
+		if err := validateFieldSpecs(group, fields); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateDefinitionSpec(definition *DefinitionSpec) error {
+	if len(definition.Formats) == 0 {
+		return errors.New("definition.formats must contain at least one format")
+	}
+
+	hasDefault := false
+
+	for i, format := range definition.Formats {
+		if format.Type == "" {
+			return fmt.Errorf("definition.formats[%d].type is required", i)
+		}
+
+		if len(format.Paths) == 0 {
+			return fmt.Errorf("definition.formats[%d].paths must contain at least one path", i)
+		}
+
+		if format.Type == DefinitionFormatDefault {
+			hasDefault = true
+		}
+	}
+
+	if !hasDefault {
+		return fmt.Errorf("definition.formats must contain the %q format", DefinitionFormatDefault)
+	}
+
+	if definitionPath(definition) == "" {
+		return errors.New("definition.formats must contain at least one path without wildcards")
+	}
+
+	return nil
+}
+
+func validateFieldSpecs(group string, fields []FieldSpec) error {
+	attributes := make(map[string]bool, len(fields))
+
+	for i := range fields {
+		field := &fields[i]
+
+		if !sdkFieldNameRegexp.MatchString(field.Name) {
+			return fmt.Errorf("%s[%d].name %q must be an exported SDK field name", group, i, field.Name)
+		}
+
+		if !slices.Contains(validFieldTypes, field.Type) {
+			return fmt.Errorf("%s[%d].type %q must be one of: %s", group, i, field.Type, strings.Join(validFieldTypes, ", "))
+		}
+
+		if field.Attribute == "" {
+			field.Attribute = toSnakeCase(field.Name)
+		}
+
+		if !attributeRegexp.MatchString(field.Attribute) {
+			return fmt.Errorf("%s[%d].attribute %q is not a valid attribute name", group, i, field.Attribute)
+		}
+
+		if attributes[field.Attribute] {
+			return fmt.Errorf("%s[%d].attribute %q is duplicated", group, i, field.Attribute)
+		}
+
+		attributes[field.Attribute] = true
+
+		if field.Description == "" {
+			field.Description = "The " + strings.ReplaceAll(field.Attribute, "_", " ") + "."
+		}
+	}
+
+	return nil
+}
+
+// definitionPath returns the first path without wildcards of the default format, or of any format.
+func definitionPath(definition *DefinitionSpec) string {
+	formats := slices.Clone(definition.Formats)
+
+	slices.SortStableFunc(formats, func(a, _ DefinitionFormatSpec) int {
+		if a.Type == DefinitionFormatDefault {
+			return -1
+		}
+
+		return 0
+	})
+
+	for _, format := range formats {
+		for _, p := range format.Paths {
+			if !strings.ContainsAny(p, "*?[") {
+				return p
+			}
+		}
+	}
+
+	return ""
+}
+
+// toConfig converts the spec into the generator configuration.
+func (spec ItemSpec) toConfig() ItemConfig {
+	itemType := TypeBasic
+
+	switch {
+	case len(spec.Configuration) > 0 && spec.Definition != nil:
+		itemType = TypeConfigDefinitionProperties
+	case len(spec.Configuration) > 0:
+		itemType = TypeConfigProperties
+	case len(spec.Properties) > 0 && spec.Definition != nil:
+		itemType = TypeDefinitionProperties
+	case len(spec.Properties) > 0:
+		itemType = TypeProperties
+	case spec.Definition != nil:
+		itemType = TypeDefinition
+	}
+
+	config := newItemConfig(spec.Name, spec.Names, itemType)
+	config.IsPreview = spec.IsPreview
+	config.IsSPNSupported = spec.IsSPNSupported
+	config.Properties = spec.Properties
+	config.Configuration = spec.Configuration
+	config.LROCreate = spec.LRO.Create == nil || *spec.LRO.Create
+	config.ConfigRequired = strconv.FormatBool(spec.ConfigurationRequired)
+	config.ConfigOrDefinitionRequired = strconv.FormatBool(spec.ConfigurationOrDefinitionRequired)
+	config.DefinitionRequired = strconv.FormatBool(spec.Definition != nil && spec.Definition.Required)
+
+	if spec.CreateFolderID != nil {
+		config.CreateFolderID = *spec.CreateFolderID
+	}
+
+	if spec.RenameAllowed != nil {
+		config.RenameAllowed = *spec.RenameAllowed
+	}
+
+	if spec.DocsURL != "" {
+		config.DocsURL = spec.DocsURL
+	}
+
+	if spec.Definition != nil {
+		config.DefinitionFormats = spec.Definition.Formats
+		config.DefinitionPath = definitionPath(spec.Definition)
+		config.DefinitionFixtures = spec.Definition.Fixtures
+
+		if spec.Definition.DocsURL != "" {
+			config.DefinitionDocsURL = spec.Definition.DocsURL
+		}
+
+		if spec.Definition.SDKType != "" {
+			config.DefinitionSDKType = spec.Definition.SDKType
+		}
+
+		if spec.Definition.Empty != "" {
+			config.DefinitionEmpty = spec.Definition.Empty
+		}
+
+		for _, format := range spec.Definition.Formats {
+			if slices.Contains(format.Paths, config.DefinitionPath) {
+				config.DefinitionAPIFormat = format.API
+
+				break
+			}
+		}
+	}
+
+	return config
+}
+
+// defaultFixtureContent returns the content of a definition fixture when the spec does not provide one.
+func defaultFixtureContent(definitionPath string) string {
+	switch strings.ToLower(path.Ext(definitionPath)) {
+	case ".json", ".yaml", ".yml":
+		return "{}\n"
+	default:
+		return ""
+	}
+}
+
+// toSnakeCase converts an SDK field name to a Terraform attribute name, e.g. "OneLakeTablesPath" -> "one_lake_tables_path".
+func toSnakeCase(name string) string {
+	runes := []rune(name)
+
+	var sb strings.Builder
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prevLower := unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+			if prevLower || (unicode.IsUpper(runes[i-1]) && nextLower) {
+				sb.WriteRune('_')
+			}
+		}
+
+		sb.WriteRune(unicode.ToLower(r))
+	}
+
+	return sb.String()
+}
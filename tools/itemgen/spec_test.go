@@ -0,0 +1,271 @@
+// Copyright Microsoft Corporation 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateSpec(t *testing.T) {
+	t.Parallel()
+
+	validDefinition := func() *DefinitionSpec {
+		return &DefinitionSpec{
+			Formats: []DefinitionFormatSpec{{Type: DefinitionFormatDefault, Paths: []string{"definition.json"}}},
+		}
+	}
+
+	testCases := map[string]struct {
+		spec    ItemSpec
+		wantErr string
+	}{
+		"valid": {
+			spec: ItemSpec{Name: "Item", Names: "Items", Definition: validDefinition()},
+		},
+		"missing name": {
+			spec:    ItemSpec{Name: " ", Names: "Items"},
+			wantErr: "name is required",
+		},
+		"missing names": {
+			spec:    ItemSpec{Name: "Item"},
+			wantErr: "names is required",
+		},
+		"configuration without properties": {
+			spec: ItemSpec{
+				Name:          "Item",
+				Names:         "Items",
+				Configuration: []FieldSpec{{Name: "Source", Type: "string"}},
+			},
+			wantErr: "configuration requires properties",
+		},
+		"definition without formats": {
+			spec:    ItemSpec{Name: "Item", Names: "Items", Definition: &DefinitionSpec{}},
+			wantErr: "definition.formats must contain at least one format",
+		},
+		"definition format without type": {
+			spec: ItemSpec{
+				Name:       "Item",
+				Names:      "Items",
+				Definition: &DefinitionSpec{Formats: []DefinitionFormatSpec{{Paths: []string{"definition.json"}}}},
+			},
+			wantErr: "definition.formats[0].type is required",
+		},
+		"definition format without paths": {
+			spec: ItemSpec{
+				Name:       "Item",
+				Names:      "Items",
+				Definition: &DefinitionSpec{Formats: []DefinitionFormatSpec{{Type: DefinitionFormatDefault}}},
+			},
+			wantErr: "definition.formats[0].paths must contain at least one path",
+		},
+		"definition without default format": {
+			spec: ItemSpec{
+				Name:       "Item",
+				Names:      "Items",
+				Definition: &DefinitionSpec{Formats: []DefinitionFormatSpec{{Type: "ipynb", Paths: []string{"notebook-content.ipynb"}}}},
+			},
+			wantErr: `definition.formats must contain the "Default" format`,
+		},
+		"definition with wildcard paths only": {
+			spec: ItemSpec{
+				Name:       "Item",
+				Names:      "Items",
+				Definition: &DefinitionSpec{Formats: []DefinitionFormatSpec{{Type: DefinitionFormatDefault, Paths: []string{"*.json"}}}},
+			},
+			wantErr: "definition.formats must contain at least one path without wildcards",
+		},
+		"invalid field name": {
+			spec: ItemSpec{
+				Name:       "Item",
+				Names:      "Items",
+				Properties: []FieldSpec{{Name: "source", Type: "string"}},
+			},
+			wantErr: `properties[0].name "source" must be an exported SDK field name`,
+		},
+		"invalid field type": {
+			spec: ItemSpec{
+				Name:       "Item",
+				Names:      "Items",
+				Properties: []FieldSpec{{Name: "Source", Type: "float"}},
+			},
+			wantErr: `properties[0].type "float" must be one of: string, uuid, bool, int32, int64`,
+		},
+		"invalid attribute": {
+			spec: ItemSpec{
+				Name:       "Item",
+				Names:      "Items",
+				Properties: []FieldSpec{{Name: "Source", Attribute: "Source", Type: "string"}},
+			},
+			wantErr: `properties[0].attribute "Source" is not a valid attribute name`,
+		},
+		"duplicated attribute": {
+			spec: ItemSpec{
+				Name:  "Item",
+				Names: "Items",
+				Properties: []FieldSpec{
+					{Name: "SourceName", Type: "string"},
+					{Name: "Source", Attribute: "source_name", Type: "string"},
+				},
+			},
+			wantErr: `properties[1].attribute "source_name" is duplicated`,
+		},
+		"invalid configuration field": {
+			spec: ItemSpec{
+				Name:          "Item",
+				Names:         "Items",
+				Properties:    []FieldSpec{{Name: "Source", Type: "string"}},
+				Configuration: []FieldSpec{{Name: "Source", Type: "date"}},
+			},
+			wantErr: `configuration[0].type "date" must be one of`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateSpec(&tc.spec)
+
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateSpec() unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("validateSpec() expected error containing %q, got nil", tc.wantErr)
+			}
+
+			if !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("validateSpec() error = %q, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateSpec_FieldDefaults(t *testing.T) {
+	t.Parallel()
+
+	spec := ItemSpec{
+		Name:       "Item",
+		Names:      "Items",
+		Properties: []FieldSpec{{Name: "OneLakeTablesPath", Type: "string"}},
+	}
+
+	if err := validateSpec(&spec); err != nil {
+		t.Fatalf("validateSpec() unexpected error: %v", err)
+	}
+
+	field := spec.Properties[0]
+
+	if want := "one_lake_tables_path"; field.Attribute != want {
+		t.Errorf("Attribute = %q, want %q", field.Attribute, want)
+	}
+
+	if want := "The one lake tables path."; field.Description != want {
+		t.Errorf("Description = %q, want %q", field.Description, want)
+	}
+}
+
+func TestLoadSpec(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content string
+		wantErr string
+	}{
+		"unknown field": {
+			content: `{"name": "Item", "names": "Items", "unknown": true}`,
+			wantErr: `unknown field "unknown"`,
+		},
+		"invalid json": {
+			content: `{"name": `,
+			wantErr: "failed to parse spec file",
+		},
+		"invalid spec": {
+			content: `{"names": "Items"}`,
+			wantErr: "invalid spec file",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			specPath := filepath.Join(t.TempDir(), "spec.json")
+			if err := os.WriteFile(specPath, []byte(tc.content), DefaultFixturePerm); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := loadSpec(specPath)
+			if err == nil {
+				t.Fatalf("loadSpec() expected error containing %q, got nil", tc.wantErr)
+			}
+
+			if !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("loadSpec() error = %q, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := loadSpec(filepath.Join(t.TempDir(), "missing.json")); err == nil || !strings.Contains(err.Error(), "failed to read spec file") {
+			t.Fatalf("loadSpec() error = %v, want a read error", err)
+		}
+	})
+}
+
+func TestLoadSpec_SnowflakeDatabase(t *testing.T) {
+	t.Parallel()
+
+	config, err := loadSpec(filepath.Join("specs", "snowflakedatabase.json"))
+	if err != nil {
+		t.Fatalf("loadSpec() unexpected error: %v", err)
+	}
+
+	if config.ItemType != TypeConfigDefinitionProperties {
+		t.Errorf("ItemType = %s, want %s", config.ItemType, TypeConfigDefinitionProperties)
+	}
+
+	if want := "SnowflakeDatabaseProperties.json"; config.DefinitionPath != want {
+		t.Errorf("DefinitionPath = %q, want %q", config.DefinitionPath, want)
+	}
+
+	if want := "connection_id"; config.Properties[0].Attribute != want {
+		t.Errorf("Properties[0].Attribute = %q, want %q", config.Properties[0].Attribute, want)
+	}
+
+	if config.CreateFolderID {
+		t.Error("CreateFolderID = true, want false")
+	}
+}
+
+func TestToSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"Name":              "name",
+		"ConnectionID":      "connection_id",
+		"OneLakeTablesPath": "one_lake_tables_path",
+		"SQLEndpointName":   "sql_endpoint_name",
+		"Version2Name":      "version2_name",
+	}
+
+	for input, want := range testCases {
+		t.Run(input, func(t *testing.T) {
+			t.Parallel()
+
+			if got := toSnakeCase(input); got != want {
+				t.Errorf("toSnakeCase(%q) = %q, want %q", input, got, want)
+			}
+		})
+	}
+}
@@ -1368,6 +1368,13 @@ foreach ($itemType in $itemTypes) {
   }
 }
 
+# Get the SQL Endpoint provisioned with the Lakehouse
+$results = Invoke-FabricRest -Method 'GET' -Endpoint "workspaces/$($wellKnown['WorkspaceDS'].id)/lakehouses/$($wellKnown['Lakehouse'].id)"
+$wellKnown['SQLEndpoint'] = @{
+  id          = $results.Response.properties.sqlEndpointProperties.id
+  displayName = $wellKnown['Lakehouse'].displayName
+}
+
 # Create DigitalTwinBuilderFlow if not exists
 
 if (-not $wellKnown.ContainsKey('DigitalTwinBuilder') -or